@@ -575,16 +575,6 @@ func New(
 	)
 	serviceModule := servicemodule.NewAppModule(appCodec, app.ServiceKeeper, app.AccountKeeper, app.BankKeeper)
 
-	app.SupplierKeeper = *suppliermodulekeeper.NewKeeper(
-		appCodec,
-		keys[suppliermoduletypes.StoreKey],
-		keys[suppliermoduletypes.MemStoreKey],
-		app.GetSubspace(suppliermoduletypes.ModuleName),
-
-		app.BankKeeper,
-	)
-	supplierModule := suppliermodule.NewAppModule(appCodec, app.SupplierKeeper, app.AccountKeeper, app.BankKeeper)
-
 	app.GatewayKeeper = *gatewaymodulekeeper.NewKeeper(
 		appCodec,
 		keys[gatewaymoduletypes.StoreKey],
@@ -593,7 +583,6 @@ func New(
 
 		app.BankKeeper,
 	)
-	gatewayModule := gatewaymodule.NewAppModule(appCodec, app.GatewayKeeper, app.AccountKeeper, app.BankKeeper)
 
 	app.ApplicationKeeper = *applicationmodulekeeper.NewKeeper(
 		appCodec,
@@ -605,7 +594,13 @@ func New(
 		app.AccountKeeper,
 		app.GatewayKeeper,
 	)
-	applicationModule := applicationmodule.NewAppModule(appCodec, app.ApplicationKeeper, app.AccountKeeper, app.BankKeeper)
+	applicationModule := applicationmodule.NewAppModule(appCodec, app.ApplicationKeeper, app.AccountKeeper, app.BankKeeper, app.GatewayKeeper)
+
+	// The application module undelegates the applications delegated to gateways
+	// which unstake, so its hooks are set before the gateway keeper is copied
+	// into the gateway module.
+	app.GatewayKeeper.SetHooks(gatewaymoduletypes.NewMultiGatewayHooks(app.ApplicationKeeper.Hooks()))
+	gatewayModule := gatewaymodule.NewAppModule(appCodec, app.GatewayKeeper, app.AccountKeeper, app.BankKeeper)
 
 	app.SessionKeeper = *sessionmodulekeeper.NewKeeper(
 		appCodec,
@@ -614,10 +609,25 @@ func New(
 		app.GetSubspace(sessionmoduletypes.ModuleName),
 
 		app.ApplicationKeeper,
-		app.SupplierKeeper,
+		// The supplier keeper depends on the session keeper to validate claims, so
+		// the session keeper is given a reference to it before it is constructed.
+		&app.SupplierKeeper,
 	)
 	sessionModule := sessionmodule.NewAppModule(appCodec, app.SessionKeeper, app.AccountKeeper, app.BankKeeper)
 
+	app.SupplierKeeper = *suppliermodulekeeper.NewKeeper(
+		appCodec,
+		keys[suppliermoduletypes.StoreKey],
+		keys[suppliermoduletypes.MemStoreKey],
+		app.GetSubspace(suppliermoduletypes.ModuleName),
+
+		app.BankKeeper,
+		app.ServiceKeeper,
+		app.SessionKeeper,
+		app.ApplicationKeeper,
+	)
+	supplierModule := suppliermodule.NewAppModule(appCodec, app.SupplierKeeper, app.AccountKeeper, app.BankKeeper, app.ServiceKeeper, app.SessionKeeper)
+
 	// this line is used by starport scaffolding # stargate/app/keeperDefinition
 
 	/**** IBC Routing ****/
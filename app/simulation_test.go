@@ -56,6 +56,38 @@ func fauxMerkleModeOpt(bapp *baseapp.BaseApp) {
 	bapp.SetFauxMerkleMode()
 }
 
+// newAppStateFn returns the simulation genesis state generator of the given
+// app. It funds the simulation accounts with as much upokt as they have of the
+// bond denom, such that they can stake as applications, gateways and suppliers.
+func newAppStateFn(bApp *app.App) simulationtypes.AppStateFn {
+	moduleAccountAddrs := bApp.ModuleAccountAddrs()
+
+	return simtestutil.AppStateFnWithExtendedCbs(
+		bApp.AppCodec(),
+		bApp.SimulationManager(),
+		app.NewDefaultGenesisState(bApp.AppCodec()),
+		func(moduleName string, genesisState interface{}) {
+			if moduleName != banktypes.ModuleName {
+				return
+			}
+
+			bankState := genesisState.(*banktypes.GenesisState)
+			for i, balance := range bankState.Balances {
+				if moduleAccountAddrs[balance.Address] {
+					continue
+				}
+
+				upokt := sdk.NewCoin(app.DenomuPOKT, balance.Coins.AmountOf(sdk.DefaultBondDenom))
+				bankState.Balances[i].Coins = balance.Coins.Add(upokt)
+				if !bankState.Supply.Empty() {
+					bankState.Supply = bankState.Supply.Add(upokt)
+				}
+			}
+		},
+		nil,
+	)
+}
+
 // BenchmarkSimulation run the chain simulation
 // Running using starport command:
 // `starport chain simulate -v --numBlocks 200 --blockSize 50`
@@ -106,11 +138,7 @@ func BenchmarkSimulation(b *testing.B) {
 		b,
 		os.Stdout,
 		bApp.BaseApp,
-		simtestutil.AppStateFn(
-			bApp.AppCodec(),
-			bApp.SimulationManager(),
-			app.NewDefaultGenesisState(bApp.AppCodec()),
-		),
+		newAppStateFn(bApp),
 		simulationtypes.RandomAccounts,
 		simtestutil.SimulationOperations(bApp, bApp.AppCodec(), config),
 		bApp.ModuleAccountAddrs(),
@@ -186,11 +214,7 @@ func TestAppStateDeterminism(t *testing.T) {
 				t,
 				os.Stdout,
 				bApp.BaseApp,
-				simtestutil.AppStateFn(
-					bApp.AppCodec(),
-					bApp.SimulationManager(),
-					app.NewDefaultGenesisState(bApp.AppCodec()),
-				),
+				newAppStateFn(bApp),
 				simulationtypes.RandomAccounts,
 				simtestutil.SimulationOperations(bApp, bApp.AppCodec(), config),
 				bApp.ModuleAccountAddrs(),
@@ -260,11 +284,7 @@ func TestAppImportExport(t *testing.T) {
 		t,
 		os.Stdout,
 		bApp.BaseApp,
-		simtestutil.AppStateFn(
-			bApp.AppCodec(),
-			bApp.SimulationManager(),
-			app.NewDefaultGenesisState(bApp.AppCodec()),
-		),
+		newAppStateFn(bApp),
 		simulationtypes.RandomAccounts,
 		simtestutil.SimulationOperations(bApp, bApp.AppCodec(), config),
 		bApp.BlockedModuleAccountAddrs(),
@@ -415,11 +435,7 @@ func TestAppSimulationAfterImport(t *testing.T) {
 		t,
 		os.Stdout,
 		bApp.BaseApp,
-		simtestutil.AppStateFn(
-			bApp.AppCodec(),
-			bApp.SimulationManager(),
-			app.NewDefaultGenesisState(bApp.AppCodec()),
-		),
+		newAppStateFn(bApp),
 		simulationtypes.RandomAccounts,
 		simtestutil.SimulationOperations(bApp, bApp.AppCodec(), config),
 		bApp.BlockedModuleAccountAddrs(),
@@ -486,11 +502,7 @@ func TestAppSimulationAfterImport(t *testing.T) {
 		t,
 		os.Stdout,
 		newApp.BaseApp,
-		simtestutil.AppStateFn(
-			bApp.AppCodec(),
-			bApp.SimulationManager(),
-			app.NewDefaultGenesisState(bApp.AppCodec()),
-		),
+		newAppStateFn(bApp),
 		simulationtypes.RandomAccounts,
 		simtestutil.SimulationOperations(newApp, newApp.AppCodec(), config),
 		newApp.BlockedModuleAccountAddrs(),
@@ -0,0 +1,58 @@
+package logger
+
+import (
+	"fmt"
+
+	cosmosflags "github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/pokt-network/poktroll/pkg/polylog"
+	"github.com/pokt-network/poktroll/pkg/polylog/polyzero"
+)
+
+const (
+	// defaultLogLevel is used when the --log_level flag is not registered.
+	defaultLogLevel = "info"
+
+	// logFormatJSON and logFormatPlain are the values accepted by the cosmos-sdk
+	// --log_format flag.
+	logFormatJSON  = "json"
+	logFormatPlain = "plain"
+)
+
+// NewLoggerFromFlags constructs a polylog.Logger which is configured according
+// to the --log_level and --log_format flags. These are registered as persistent
+// flags on the root command by the cosmos-sdk (see: svrcmd.Execute), so they are
+// shared with the node's own logging configuration.
+func NewLoggerFromFlags(cmd *cobra.Command) (polylog.Logger, error) {
+	logLevelStr := defaultLogLevel
+	if flag := cmd.Flag(cosmosflags.FlagLogLevel); flag != nil {
+		logLevelStr = flag.Value.String()
+	}
+
+	logLevel, err := polyzero.ParseLevel(logLevelStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --%s %q: %w", cosmosflags.FlagLogLevel, logLevelStr, err)
+	}
+
+	opts := []polylog.LoggerOption{polyzero.WithLevel(logLevel)}
+
+	logFormat := logFormatPlain
+	if flag := cmd.Flag(cosmosflags.FlagLogFormat); flag != nil {
+		logFormat = flag.Value.String()
+	}
+
+	switch logFormat {
+	case logFormatJSON:
+		opts = append(opts, polyzero.WithOutput(cmd.ErrOrStderr()))
+	case logFormatPlain:
+		opts = append(opts, polyzero.WithConsoleOutput(cmd.ErrOrStderr(), false))
+	default:
+		return nil, fmt.Errorf(
+			"invalid --%s %q: expected %q or %q",
+			cosmosflags.FlagLogFormat, logFormat, logFormatJSON, logFormatPlain,
+		)
+	}
+
+	return polyzero.NewLogger(opts...), nil
+}
@@ -252,7 +252,7 @@ func (s *suite) TheSessionForApplicationAndServiceContainsTheSupplier(appName st
 	responseBz := []byte(strings.TrimSpace(res.Stdout))
 	s.cdc.MustUnmarshalJSON(responseBz, &resp)
 	for _, supplier := range resp.Session.Suppliers {
-		if supplier.Address == expectedSupplier.Address {
+		if supplier.OperatorAddress == expectedSupplier.OperatorAddress {
 			return
 		}
 	}
@@ -360,7 +360,7 @@ func (s *suite) buildSupplierMap() {
 	responseBz := []byte(strings.TrimSpace(res.Stdout))
 	s.cdc.MustUnmarshalJSON(responseBz, &resp)
 	for _, supplier := range resp.Supplier {
-		accNameToSupplierMap[accAddrToNameMap[supplier.Address]] = supplier
+		accNameToSupplierMap[accAddrToNameMap[supplier.OperatorAddress]] = supplier
 	}
 }
 
@@ -0,0 +1,160 @@
+package apikeys
+
+import (
+	"os"
+
+	"gopkg.in/yaml.v2"
+)
+
+// ApiKey describes a tenant of the AppGate server: the application addresses
+// and services it may relay for and the quotas it is subject to.
+type ApiKey struct {
+	// Key is the secret which the tenant includes in its requests.
+	Key string `yaml:"key"`
+	// AppAddresses is the list of application addresses which the tenant may
+	// relay for. It MUST NOT be empty.
+	AppAddresses []string `yaml:"app_addresses"`
+	// ServiceIds is the list of services which the tenant may relay to. If it
+	// is empty, the tenant may relay to any service.
+	ServiceIds []string `yaml:"service_ids"`
+	// RequestsPerSecond is the maximum sustained rate of requests which the
+	// tenant may send. A value of 0 means no limit.
+	RequestsPerSecond uint64 `yaml:"requests_per_second"`
+	// ComputeUnitsPerDay is the maximum number of compute units which the
+	// tenant may consume per (UTC) day. A value of 0 means no limit.
+	ComputeUnitsPerDay uint64 `yaml:"compute_units_per_day"`
+}
+
+// YAMLApiKeysConfig is the structure used to unmarshal an api keys file.
+type YAMLApiKeysConfig struct {
+	ApiKeys []*ApiKey `yaml:"api_keys"`
+}
+
+// Store provides the api keys which the AppGate server authenticates requests
+// against. It allows api keys to be kept in a file or any other local store.
+type Store interface {
+	// GetApiKey returns the api key matching the given key or an
+	// ErrApiKeysUnknownApiKey error if there is none.
+	GetApiKey(key string) (*ApiKey, error)
+}
+
+var _ Store = (*memoryStore)(nil)
+
+// memoryStore is a Store which holds all of its api keys in memory.
+type memoryStore struct {
+	apiKeys map[string]*ApiKey
+}
+
+// NewStore returns a Store holding the given api keys in memory or an error if
+// any of them is invalid or they are not unique.
+func NewStore(apiKeys ...*ApiKey) (Store, error) {
+	store := &memoryStore{apiKeys: make(map[string]*ApiKey, len(apiKeys))}
+
+	for _, apiKey := range apiKeys {
+		if err := apiKey.ValidateBasic(); err != nil {
+			return nil, err
+		}
+
+		if _, ok := store.apiKeys[apiKey.Key]; ok {
+			return nil, ErrApiKeysDuplicateApiKey
+		}
+		store.apiKeys[apiKey.Key] = apiKey
+	}
+
+	return store, nil
+}
+
+// LoadStoreFromFile reads and parses the api keys file at the given path and
+// returns a Store holding its api keys.
+func LoadStoreFromFile(path string) (Store, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	apiKeys, err := ParseApiKeys(content)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewStore(apiKeys...)
+}
+
+// ParseApiKeys parses the content of an api keys file into a list of api keys.
+func ParseApiKeys(content []byte) ([]*ApiKey, error) {
+	var yamlApiKeysConfig YAMLApiKeysConfig
+
+	if err := yaml.Unmarshal(content, &yamlApiKeysConfig); err != nil {
+		return nil, ErrApiKeysUnmarshalYAML.Wrapf("%s", err)
+	}
+
+	for _, apiKey := range yamlApiKeysConfig.ApiKeys {
+		if err := apiKey.ValidateBasic(); err != nil {
+			return nil, err
+		}
+	}
+
+	return yamlApiKeysConfig.ApiKeys, nil
+}
+
+// GetApiKey implements the respective Store interface method.
+func (store *memoryStore) GetApiKey(key string) (*ApiKey, error) {
+	if key == "" {
+		return nil, ErrApiKeysMissingApiKey
+	}
+
+	apiKey, ok := store.apiKeys[key]
+	if !ok {
+		return nil, ErrApiKeysUnknownApiKey
+	}
+
+	return apiKey, nil
+}
+
+// ValidateBasic checks that the api key has a key and at least one application
+// address.
+func (apiKey *ApiKey) ValidateBasic() error {
+	if apiKey == nil {
+		return ErrApiKeysInvalidApiKey.Wrap("nil api key")
+	}
+
+	if apiKey.Key == "" {
+		return ErrApiKeysInvalidApiKey.Wrap("empty key")
+	}
+
+	if len(apiKey.AppAddresses) == 0 {
+		return ErrApiKeysInvalidApiKey.Wrap("no application addresses")
+	}
+
+	for _, appAddress := range apiKey.AppAddresses {
+		if appAddress == "" {
+			return ErrApiKeysInvalidApiKey.Wrap("empty application address")
+		}
+	}
+
+	return nil
+}
+
+// Authorize returns an error if the api key may not relay for the given
+// application to the given service.
+func (apiKey *ApiKey) Authorize(appAddress, serviceId string) error {
+	if !contains(apiKey.AppAddresses, appAddress) {
+		return ErrApiKeysAppNotAllowed.Wrapf("application %s", appAddress)
+	}
+
+	if len(apiKey.ServiceIds) > 0 && !contains(apiKey.ServiceIds, serviceId) {
+		return ErrApiKeysServiceNotAllowed.Wrapf("service %s", serviceId)
+	}
+
+	return nil
+}
+
+// contains returns whether the given list contains the given value.
+func contains(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
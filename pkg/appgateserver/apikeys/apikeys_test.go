@@ -0,0 +1,140 @@
+package apikeys_test
+
+import (
+	"testing"
+
+	sdkerrors "cosmossdk.io/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/pkg/appgateserver/apikeys"
+	"github.com/pokt-network/poktroll/testutil/yaml"
+)
+
+func TestParseApiKeys(t *testing.T) {
+	tests := []struct {
+		desc string
+
+		inputConfig string
+
+		expectedError   *sdkerrors.Error
+		expectedApiKeys []*apikeys.ApiKey
+	}{
+		{
+			desc: "valid: api keys with and without quotas",
+
+			inputConfig: `
+				api_keys:
+				  - key: key1
+				    app_addresses:
+				      - pokt1mrqt5f7qh8uxs27cjm9t7v9e74a9vvdnq5jva4
+				    service_ids:
+				      - anvil
+				    requests_per_second: 10
+				    compute_units_per_day: 1000
+				  - key: key2
+				    app_addresses:
+				      - pokt1mrqt5f7qh8uxs27cjm9t7v9e74a9vvdnq5jva4
+				`,
+
+			expectedApiKeys: []*apikeys.ApiKey{
+				{
+					Key:                "key1",
+					AppAddresses:       []string{"pokt1mrqt5f7qh8uxs27cjm9t7v9e74a9vvdnq5jva4"},
+					ServiceIds:         []string{"anvil"},
+					RequestsPerSecond:  10,
+					ComputeUnitsPerDay: 1000,
+				},
+				{
+					Key:          "key2",
+					AppAddresses: []string{"pokt1mrqt5f7qh8uxs27cjm9t7v9e74a9vvdnq5jva4"},
+				},
+			},
+		},
+		{
+			desc: "invalid: empty key",
+
+			inputConfig: `
+				api_keys:
+				  - key:
+				    app_addresses:
+				      - pokt1mrqt5f7qh8uxs27cjm9t7v9e74a9vvdnq5jva4
+				`,
+
+			expectedError: apikeys.ErrApiKeysInvalidApiKey,
+		},
+		{
+			desc: "invalid: no application addresses",
+
+			inputConfig: `
+				api_keys:
+				  - key: key1
+				`,
+
+			expectedError: apikeys.ErrApiKeysInvalidApiKey,
+		},
+		{
+			desc: "invalid: malformed yaml",
+
+			inputConfig: `
+				api_keys: key1
+				`,
+
+			expectedError: apikeys.ErrApiKeysUnmarshalYAML,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			normalizedConfig := yaml.NormalizeYAMLIndentation(tt.inputConfig)
+			apiKeys, err := apikeys.ParseApiKeys([]byte(normalizedConfig))
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				require.Nil(t, apiKeys)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedApiKeys, apiKeys)
+		})
+	}
+}
+
+func TestStore_GetApiKey(t *testing.T) {
+	apiKey := &apikeys.ApiKey{
+		Key:          "key1",
+		AppAddresses: []string{"app1"},
+	}
+
+	_, err := apikeys.NewStore(apiKey, apiKey)
+	require.ErrorIs(t, err, apikeys.ErrApiKeysDuplicateApiKey)
+
+	store, err := apikeys.NewStore(apiKey)
+	require.NoError(t, err)
+
+	storedApiKey, err := store.GetApiKey("key1")
+	require.NoError(t, err)
+	require.Equal(t, apiKey, storedApiKey)
+
+	_, err = store.GetApiKey("")
+	require.ErrorIs(t, err, apikeys.ErrApiKeysMissingApiKey)
+
+	_, err = store.GetApiKey("key2")
+	require.ErrorIs(t, err, apikeys.ErrApiKeysUnknownApiKey)
+}
+
+func TestApiKey_Authorize(t *testing.T) {
+	apiKey := &apikeys.ApiKey{
+		Key:          "key1",
+		AppAddresses: []string{"app1"},
+		ServiceIds:   []string{"svc1"},
+	}
+
+	require.NoError(t, apiKey.Authorize("app1", "svc1"))
+	require.ErrorIs(t, apiKey.Authorize("app2", "svc1"), apikeys.ErrApiKeysAppNotAllowed)
+	require.ErrorIs(t, apiKey.Authorize("app1", "svc2"), apikeys.ErrApiKeysServiceNotAllowed)
+
+	// An api key without services may relay to any service.
+	apiKey.ServiceIds = nil
+	require.NoError(t, apiKey.Authorize("app1", "svc2"))
+}
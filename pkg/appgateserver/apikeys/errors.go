@@ -0,0 +1,16 @@
+package apikeys
+
+import sdkerrors "cosmossdk.io/errors"
+
+var (
+	codespace                          = "appgate_apikeys"
+	ErrApiKeysUnmarshalYAML            = sdkerrors.Register(codespace, 1, "api keys reader cannot unmarshal yaml content")
+	ErrApiKeysInvalidApiKey            = sdkerrors.Register(codespace, 2, "invalid api key configuration")
+	ErrApiKeysDuplicateApiKey          = sdkerrors.Register(codespace, 3, "duplicate api key")
+	ErrApiKeysMissingApiKey            = sdkerrors.Register(codespace, 4, "missing api key")
+	ErrApiKeysUnknownApiKey            = sdkerrors.Register(codespace, 5, "unknown api key")
+	ErrApiKeysAppNotAllowed            = sdkerrors.Register(codespace, 6, "application not allowed for api key")
+	ErrApiKeysServiceNotAllowed        = sdkerrors.Register(codespace, 7, "service not allowed for api key")
+	ErrApiKeysRateLimited              = sdkerrors.Register(codespace, 8, "api key requests per second quota exceeded")
+	ErrApiKeysComputeUnitsQuotaReached = sdkerrors.Register(codespace, 9, "api key compute units per day quota exceeded")
+)
@@ -0,0 +1,102 @@
+package apikeys
+
+import (
+	"sync"
+	"time"
+)
+
+// computeUnitsQuotaPeriod is the period over which the compute units of an api
+// key are accounted for; i.e. a (UTC) day.
+const computeUnitsQuotaPeriod = 24 * time.Hour
+
+// Limiter enforces the requests per second and compute units per day quotas of
+// api keys. It is safe for concurrent use.
+type Limiter struct {
+	// now returns the current time; it is overridable for testing.
+	now func() time.Time
+
+	usagesMu sync.Mutex
+	// usages maps api keys to their usage of their quotas.
+	usages map[string]*apiKeyUsage
+}
+
+// apiKeyUsage tracks how much of its quotas an api key has consumed.
+type apiKeyUsage struct {
+	// tokens is the number of requests which the api key may currently send;
+	// it is replenished at a rate of RequestsPerSecond, up to RequestsPerSecond.
+	tokens float64
+	// lastRefill is the time at which tokens was last replenished.
+	lastRefill time.Time
+	// period is the start of the period which usedComputeUnits accounts for.
+	period time.Time
+	// usedComputeUnits is the number of compute units consumed in period.
+	usedComputeUnits uint64
+}
+
+// NewLimiter returns a new Limiter which has not accounted for any requests.
+func NewLimiter() *Limiter {
+	return &Limiter{
+		now:    time.Now,
+		usages: make(map[string]*apiKeyUsage),
+	}
+}
+
+// Consume accounts for a request of the given compute units against the quotas
+// of the given api key. It returns an ErrApiKeysRateLimited or an
+// ErrApiKeysComputeUnitsQuotaReached error, without accounting for the request,
+// if it exceeds either of them; i.e. the request must not be served.
+func (limiter *Limiter) Consume(apiKey *ApiKey, computeUnits uint64) error {
+	limiter.usagesMu.Lock()
+	defer limiter.usagesMu.Unlock()
+
+	now := limiter.now().UTC()
+	period := now.Truncate(computeUnitsQuotaPeriod)
+
+	usage, ok := limiter.usages[apiKey.Key]
+	if !ok {
+		usage = &apiKeyUsage{
+			tokens:     float64(apiKey.RequestsPerSecond),
+			lastRefill: now,
+			period:     period,
+		}
+		limiter.usages[apiKey.Key] = usage
+	}
+
+	// Replenish the request tokens for the time elapsed since the last request.
+	if apiKey.RequestsPerSecond > 0 {
+		maxTokens := float64(apiKey.RequestsPerSecond)
+		elapsed := now.Sub(usage.lastRefill).Seconds()
+		usage.tokens += elapsed * maxTokens
+		if usage.tokens > maxTokens {
+			usage.tokens = maxTokens
+		}
+		usage.lastRefill = now
+
+		if usage.tokens < 1 {
+			return ErrApiKeysRateLimited.Wrapf(
+				"limit of %d requests per second", apiKey.RequestsPerSecond,
+			)
+		}
+	}
+
+	// Reset the compute units once a new period has started.
+	if usage.period.Before(period) {
+		usage.period = period
+		usage.usedComputeUnits = 0
+	}
+
+	if apiKey.ComputeUnitsPerDay > 0 &&
+		usage.usedComputeUnits+computeUnits > apiKey.ComputeUnitsPerDay {
+		return ErrApiKeysComputeUnitsQuotaReached.Wrapf(
+			"used %d of %d compute units per day; request requires %d",
+			usage.usedComputeUnits, apiKey.ComputeUnitsPerDay, computeUnits,
+		)
+	}
+
+	if apiKey.RequestsPerSecond > 0 {
+		usage.tokens--
+	}
+	usage.usedComputeUnits += computeUnits
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package apikeys
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimiter_Consume_RequestsPerSecond(t *testing.T) {
+	now := time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)
+	limiter := NewLimiter()
+	limiter.now = func() time.Time { return now }
+
+	apiKey := &ApiKey{Key: "key1", RequestsPerSecond: 2}
+
+	require.NoError(t, limiter.Consume(apiKey, 1))
+	require.NoError(t, limiter.Consume(apiKey, 1))
+	require.ErrorIs(t, limiter.Consume(apiKey, 1), ErrApiKeysRateLimited)
+
+	// Half a second replenishes a single request at 2 requests per second.
+	now = now.Add(500 * time.Millisecond)
+	require.NoError(t, limiter.Consume(apiKey, 1))
+	require.ErrorIs(t, limiter.Consume(apiKey, 1), ErrApiKeysRateLimited)
+
+	// Tokens don't accumulate beyond a second's worth of requests.
+	now = now.Add(time.Minute)
+	require.NoError(t, limiter.Consume(apiKey, 1))
+	require.NoError(t, limiter.Consume(apiKey, 1))
+	require.ErrorIs(t, limiter.Consume(apiKey, 1), ErrApiKeysRateLimited)
+}
+
+func TestLimiter_Consume_ComputeUnitsPerDay(t *testing.T) {
+	now := time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)
+	limiter := NewLimiter()
+	limiter.now = func() time.Time { return now }
+
+	apiKey := &ApiKey{Key: "key1", ComputeUnitsPerDay: 10}
+
+	require.NoError(t, limiter.Consume(apiKey, 6))
+	require.ErrorIs(t, limiter.Consume(apiKey, 5), ErrApiKeysComputeUnitsQuotaReached)
+	// Rejected requests are not accounted for.
+	require.NoError(t, limiter.Consume(apiKey, 4))
+	require.ErrorIs(t, limiter.Consume(apiKey, 1), ErrApiKeysComputeUnitsQuotaReached)
+
+	// The compute units are reset at the start of the next (UTC) day.
+	now = time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, limiter.Consume(apiKey, 10))
+	require.ErrorIs(t, limiter.Consume(apiKey, 1), ErrApiKeysComputeUnitsQuotaReached)
+}
@@ -0,0 +1,51 @@
+package appgateserver
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/pokt-network/poktroll/pkg/appgateserver/apikeys"
+	"github.com/pokt-network/poktroll/pkg/partials"
+)
+
+// ApiKeyHeader is the HTTP header which requests include their api key in.
+const ApiKeyHeader = "X-Api-Key"
+
+// authorizeRequest authenticates the given request by its api key and checks
+// that it may relay for the given application to the given service within the
+// api key's quotas. It returns the HTTP status code to reply with along with an
+// error if the request must not be served.
+// Requests are not authenticated if the server has no api key store.
+func (app *appGateServer) authorizeRequest(
+	request *http.Request,
+	appAddress, serviceId string,
+	payloadBz []byte,
+) (int, error) {
+	if app.apiKeyStore == nil {
+		return http.StatusOK, nil
+	}
+
+	apiKey, err := app.apiKeyStore.GetApiKey(request.Header.Get(ApiKeyHeader))
+	if err != nil {
+		return http.StatusUnauthorized, err
+	}
+
+	if err := apiKey.Authorize(appAddress, serviceId); err != nil {
+		return http.StatusUnauthorized, err
+	}
+
+	computeUnits, err := partials.GetComputeUnits(payloadBz)
+	if err != nil {
+		return http.StatusBadRequest, ErrAppGateHandleRelay.Wrapf("getting compute units: %s", err)
+	}
+
+	if err := app.apiKeyLimiter.Consume(apiKey, computeUnits); err != nil {
+		if errors.Is(err, apikeys.ErrApiKeysRateLimited) ||
+			errors.Is(err, apikeys.ErrApiKeysComputeUnitsQuotaReached) {
+			return http.StatusTooManyRequests, err
+		}
+		return http.StatusInternalServerError, err
+	}
+
+	return http.StatusOK, nil
+}
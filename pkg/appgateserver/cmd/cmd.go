@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
 	"net/http"
 	"os"
 
@@ -13,8 +12,10 @@ import (
 	cosmosflags "github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/spf13/cobra"
 
+	"github.com/pokt-network/poktroll/cmd/logger"
 	"github.com/pokt-network/poktroll/cmd/signals"
 	"github.com/pokt-network/poktroll/pkg/appgateserver"
+	"github.com/pokt-network/poktroll/pkg/appgateserver/apikeys"
 	appgateconfig "github.com/pokt-network/poktroll/pkg/appgateserver/config"
 	"github.com/pokt-network/poktroll/pkg/deps/config"
 )
@@ -52,7 +53,14 @@ it can still send relays to the AppGate server and function as an Application,
 provided that:
 1. Each request contains the '?senderAddress=[address]' query parameter
 2. The key associated with the 'signing_key' configuration directive belongs
-   to the address provided in the request, otherwise the ring signature will not be valid.`,
+   to the address provided in the request, otherwise the ring signature will not be valid.
+
+-- API Keys --
+If the 'api_keys_file' configuration directive is provided, each request must
+include one of the API keys defined in that file in its 'X-Api-Key' header. Each
+API key is restricted to a set of application addresses and services, and to
+requests per second and compute units per day quotas. Requests which fail to
+authenticate or exceed their quotas are replied to with 401 or 429 errors.`,
 		Args: cobra.NoArgs,
 		RunE: runAppGateServer,
 	}
@@ -75,6 +83,14 @@ func runAppGateServer(cmd *cobra.Command, _ []string) error {
 	// Handle interrupt and kill signals asynchronously.
 	signals.GoOnExitSignal(cancelCtx)
 
+	// Construct a logger according to the --log_level and --log_format flags and
+	// associate it with ctx such that it is available to all dependencies.
+	appGateLogger, err := logger.NewLoggerFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+	ctx = appGateLogger.WithContext(ctx)
+
 	configContent, err := os.ReadFile(flagAppGateConfig)
 	if err != nil {
 		return err
@@ -91,10 +107,20 @@ func runAppGateServer(cmd *cobra.Command, _ []string) error {
 		return fmt.Errorf("failed to setup AppGate server dependencies: %w", err)
 	}
 
-	log.Println("INFO: Creating AppGate server...")
+	appGateLogger.Info().Msg("creating AppGate server")
+
+	// Authenticate requests by the api keys in the api keys file, if any.
+	var apiKeyStore apikeys.Store
+	if appGateConfigs.ApiKeysFile != "" {
+		apiKeyStore, err = apikeys.LoadStoreFromFile(appGateConfigs.ApiKeysFile)
+		if err != nil {
+			return fmt.Errorf("failed to load api keys: %w", err)
+		}
+	}
 
 	// Create the AppGate server.
 	appGateServer, err := appgateserver.NewAppGateServer(
+		ctx,
 		appGateServerDeps,
 		appgateserver.WithSigningInformation(&appgateserver.SigningInformation{
 			// provide the name of the key to use for signing all incoming requests
@@ -104,18 +130,21 @@ func runAppGateServer(cmd *cobra.Command, _ []string) error {
 			SelfSigning: appGateConfigs.SelfSigning,
 		}),
 		appgateserver.WithListeningUrl(appGateConfigs.ListeningEndpoint),
+		appgateserver.WithApiKeyStore(apiKeyStore),
 	)
 	if err != nil {
 		return fmt.Errorf("failed to create AppGate server: %w", err)
 	}
 
-	log.Printf("INFO: Starting AppGate server, listening on %s...", appGateConfigs.ListeningEndpoint.String())
+	appGateLogger.Info().
+		Str("listening_endpoint", appGateConfigs.ListeningEndpoint.String()).
+		Msg("starting AppGate server")
 
 	// Start the AppGate server.
 	if err := appGateServer.Start(ctx); err != nil && !errors.Is(err, http.ErrServerClosed) {
 		return fmt.Errorf("failed to start app gate server: %w", err)
 	} else if errors.Is(err, http.ErrServerClosed) {
-		log.Println("INFO: AppGate server stopped")
+		appGateLogger.Info().Msg("AppGate server stopped")
 	}
 
 	return nil
@@ -129,9 +158,13 @@ func setupAppGateServerDependencies(
 	pocketNodeWebsocketUrl := fmt.Sprintf("ws://%s/websocket", appGateConfig.QueryNodeUrl.Host)
 
 	supplierFuncs := []config.SupplierFn{
+		config.SupplyLogger,
 		config.NewSupplyEventsQueryClientFn(pocketNodeWebsocketUrl),
 		config.NewSupplyBlockClientFn(pocketNodeWebsocketUrl),
 		newSupplyQueryClientContextFn(appGateConfig.QueryNodeUrl.String()),
+		config.SupplyAccountQuerier,
+		config.SupplyApplicationQuerier,
+		config.SupplySessionQuerier,
 	}
 
 	return config.SupplyConfig(ctx, cmd, supplierFuncs)
@@ -13,6 +13,7 @@ type YAMLAppGateServerConfig struct {
 	SigningKey        string `yaml:"signing_key"`
 	ListeningEndpoint string `yaml:"listening_endpoint"`
 	QueryNodeUrl      string `yaml:"query_node_url"`
+	ApiKeysFile       string `yaml:"api_keys_file"`
 }
 
 // AppGateServerConfig is the structure describing the AppGateServer config
@@ -21,10 +22,12 @@ type AppGateServerConfig struct {
 	SigningKey        string
 	ListeningEndpoint *url.URL
 	QueryNodeUrl      *url.URL
+	ApiKeysFile       string
 }
 
 // ParseAppGateServerConfigs parses the stake config file into a AppGateConfig
 // NOTE: If SelfSigning is not defined in the config file, it will default to false
+// NOTE: If ApiKeysFile is not defined in the config file, requests are not authenticated
 func ParseAppGateServerConfigs(configContent []byte) (*AppGateServerConfig, error) {
 	var yamlAppGateServerConfig YAMLAppGateServerConfig
 
@@ -61,6 +64,7 @@ func ParseAppGateServerConfigs(configContent []byte) (*AppGateServerConfig, erro
 		SigningKey:        yamlAppGateServerConfig.SigningKey,
 		ListeningEndpoint: listeningEndpoint,
 		QueryNodeUrl:      queryNodeUrl,
+		ApiKeysFile:       yamlAppGateServerConfig.ApiKeysFile,
 	}
 
 	return appGateServerConfig, nil
@@ -57,6 +57,25 @@ func Test_ParseAppGateConfigs(t *testing.T) {
 				QueryNodeUrl:      &url.URL{Scheme: "tcp", Host: "127.0.0.1:36657"},
 			},
 		},
+		{
+			desc: "valid: AppGateServer config with api keys file",
+
+			inputConfig: `
+				signing_key: gateway1
+				listening_endpoint: http://localhost:42069
+				query_node_url: tcp://127.0.0.1:36657
+				api_keys_file: /tmp/api_keys.yaml
+				`,
+
+			expectedError: nil,
+			expectedConfig: &config.AppGateServerConfig{
+				SelfSigning:       false,
+				SigningKey:        "gateway1",
+				ListeningEndpoint: &url.URL{Scheme: "http", Host: "localhost:42069"},
+				QueryNodeUrl:      &url.URL{Scheme: "tcp", Host: "127.0.0.1:36657"},
+				ApiKeysFile:       "/tmp/api_keys.yaml",
+			},
+		},
 		// Invalid Configs
 		{
 			desc: "invalid: empty AppGateServer config",
@@ -124,6 +143,7 @@ func Test_ParseAppGateConfigs(t *testing.T) {
 			require.Equal(t, tt.expectedConfig.SigningKey, config.SigningKey)
 			require.Equal(t, tt.expectedConfig.ListeningEndpoint.String(), config.ListeningEndpoint.String())
 			require.Equal(t, tt.expectedConfig.QueryNodeUrl.String(), config.QueryNodeUrl.String())
+			require.Equal(t, tt.expectedConfig.ApiKeysFile, config.ApiKeysFile)
 		})
 	}
 }
@@ -1,7 +1,6 @@
 package appgateserver
 
 import (
-	"log"
 	"net/http"
 
 	"github.com/pokt-network/poktroll/pkg/partials"
@@ -14,12 +13,35 @@ import (
 func (app *appGateServer) replyWithError(payloadBz []byte, writer http.ResponseWriter, err error) {
 	responseBz, err := partials.GetErrorReply(payloadBz, err)
 	if err != nil {
-		log.Printf("ERROR: failed getting error reply: %s", err)
+		app.logger.Error().Err(err).Msg("failed getting error reply")
 		return
 	}
 
 	if _, err = writer.Write(responseBz); err != nil {
-		log.Printf("ERROR: failed writing relay response: %s", err)
+		app.logger.Error().Err(err).Msg("failed writing relay response")
+		return
+	}
+}
+
+// replyWithStatusError replies to the application with an error response, in
+// the format of the request's RPC type, and the given HTTP status code.
+func (app *appGateServer) replyWithStatusError(
+	payloadBz []byte,
+	writer http.ResponseWriter,
+	statusCode int,
+	err error,
+) {
+	responseBz, err := partials.GetErrorReply(payloadBz, err)
+	if err != nil {
+		// The status code is still replied with if the payload is unrecognized.
+		writer.WriteHeader(statusCode)
+		app.logger.Error().Err(err).Msg("failed getting error reply")
+		return
+	}
+
+	writer.WriteHeader(statusCode)
+	if _, err = writer.Write(responseBz); err != nil {
+		app.logger.Error().Err(err).Msg("failed writing relay response")
 		return
 	}
 }
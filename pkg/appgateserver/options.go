@@ -2,6 +2,8 @@ package appgateserver
 
 import (
 	"net/url"
+
+	"github.com/pokt-network/poktroll/pkg/appgateserver/apikeys"
 )
 
 // WithSigningInformation sets the signing information for the appgate server.
@@ -17,3 +19,12 @@ func WithListeningUrl(listeningUrl *url.URL) appGateServerOption {
 		appGateServer.listeningEndpoint = listeningUrl
 	}
 }
+
+// WithApiKeyStore sets the store of the api keys which the appgate server
+// authenticates and rate limits requests by. If it is nil, requests are not
+// authenticated.
+func WithApiKeyStore(apiKeyStore apikeys.Store) appGateServerOption {
+	return func(appGateServer *appGateServer) {
+		appGateServer.apiKeyStore = apiKeyStore
+	}
+}
@@ -4,24 +4,16 @@ import (
 	"context"
 	"fmt"
 	"io"
-	"log"
 	"net/http"
 	"net/url"
 	"strings"
-	"sync"
 
 	"cosmossdk.io/depinject"
-	ring_secp256k1 "github.com/athanorlabs/go-dleq/secp256k1"
-	ringtypes "github.com/athanorlabs/go-dleq/types"
 	sdkclient "github.com/cosmos/cosmos-sdk/client"
-	"github.com/cosmos/cosmos-sdk/crypto/keyring"
-	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
-	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
-	accounttypes "github.com/cosmos/cosmos-sdk/x/auth/types"
-
-	blocktypes "github.com/pokt-network/poktroll/pkg/client"
-	apptypes "github.com/pokt-network/poktroll/x/application/types"
-	sessiontypes "github.com/pokt-network/poktroll/x/session/types"
+
+	"github.com/pokt-network/poktroll/pkg/appgateserver/apikeys"
+	"github.com/pokt-network/poktroll/pkg/polylog"
+	"github.com/pokt-network/poktroll/pkg/sdk"
 )
 
 type SigningInformation struct {
@@ -32,58 +24,38 @@ type SigningInformation struct {
 	// private key used to sign relay requests.
 	SigningKeyName string
 
-	// SigningKey is the scalar point on the appropriate curve corresponding to the
-	// signer's private key, and is used to sign relay requests via a ring signature
-	SigningKey ringtypes.Scalar
-
 	// AppAddress is the address of the application that the server is serving if
 	// If it is nil, then the application address must be included in each request via a query parameter.
 	AppAddress string
 }
 
 // appGateServer is the server that listens for application requests and relays them to the supplier.
-// It is responsible for maintaining the current session for the application, signing the requests,
-// and verifying the response signatures.
+// It relies on the POKTRollSDK for maintaining the current session for the application, signing the
+// requests, and verifying the response signatures.
 // The appGateServer is the basis for both applications and gateways, depending on whether the application
 // is running their own instance of the appGateServer or they are sending requests to a gateway running an
 // instance of the appGateServer, they will need to either include the application address in the request or not.
 type appGateServer struct {
+	logger polylog.Logger
+
 	// signing information holds the signing key and application address for the server
 	signingInformation *SigningInformation
 
-	// ringCache is a cache of the public keys used to create the ring for a given application
-	// they are stored in a map of application address to a slice of points on the secp256k1 curve
-	// TODO(@h5law): subscribe to on-chain events to update this cache as the ring changes over time
-	ringCache      map[string][]ringtypes.Point
-	ringCacheMutex *sync.RWMutex
-
 	// clientCtx is the client context for the application.
-	// It is used to query for the application's account to unmarshal the supplier's account
-	// and get the public key to verify the relay response signature.
+	// Its keyring holds the key which is used to sign relay requests.
 	clientCtx sdkclient.Context
 
-	// sessionQuerier is the querier for the session module.
-	// It used to get the current session for the application given a requested service.
-	sessionQuerier sessiontypes.QueryClient
-
-	// sessionMu is a mutex to protect currentSession map reads and and updates.
-	sessionMu sync.RWMutex
-
-	// currentSessions is the current session for the application given a block height.
-	// It is updated by the goListenForNewSessions goroutine.
-	currentSessions map[string]*sessiontypes.Session
+	// sdk is used to get the current session for the application given a
+	// requested service, select a supplier endpoint from it, sign the relay
+	// requests and verify the relay responses.
+	sdk sdk.POKTRollSDK
 
-	// accountQuerier is the querier for the account module.
-	// It is used to get the the supplier's public key to verify the relay response signature.
-	accountQuerier accounttypes.QueryClient
+	// apiKeyStore holds the api keys which requests are authenticated against.
+	// If it is nil, requests are not authenticated.
+	apiKeyStore apikeys.Store
 
-	// applicationQuerier is the querier for the application module.
-	// It is used to get the ring for a given application address.
-	applicationQuerier apptypes.QueryClient
-
-	// blockClient is the client for the block module.
-	// It is used to get the current block height to query for the current session.
-	blockClient blocktypes.BlockClient
+	// apiKeyLimiter enforces the quotas of the api keys in apiKeyStore.
+	apiKeyLimiter *apikeys.Limiter
 
 	// listeningEndpoint is the endpoint that the appGateServer will listen on.
 	listeningEndpoint *url.URL
@@ -91,27 +63,37 @@ type appGateServer struct {
 	// server is the HTTP server that will be used capture application requests
 	// so that they can be signed and relayed to the supplier.
 	server *http.Server
-
-	// accountCache is a cache of the supplier accounts that has been queried
-	// TODO_TECHDEBT: Add a size limit to the cache.
-	supplierAccountCache map[string]cryptotypes.PubKey
 }
 
+// NewAppGateServer creates a new appGateServer with the given dependencies or
+// returns an error if the dependencies fail to resolve or the options are invalid.
+// The sessions it relays for are kept up to date until the given context is done.
+//
+// Required dependencies:
+//   - polylog.Logger
+//   - sdkclient.Context
+//   - client.BlockClient
+//   - client.AccountQuerier
+//   - client.ApplicationQuerier
+//   - client.SessionQuerier
+//
+// Available options:
+//   - WithSigningInformation
+//   - WithListeningUrl
+//   - WithApiKeyStore
 func NewAppGateServer(
+	ctx context.Context,
 	deps depinject.Config,
 	opts ...appGateServerOption,
 ) (*appGateServer, error) {
 	app := &appGateServer{
-		ringCacheMutex:       &sync.RWMutex{},
-		ringCache:            make(map[string][]ringtypes.Point),
-		currentSessions:      make(map[string]*sessiontypes.Session),
-		supplierAccountCache: make(map[string]cryptotypes.PubKey),
+		apiKeyLimiter: apikeys.NewLimiter(),
 	}
 
 	if err := depinject.Inject(
 		deps,
+		&app.logger,
 		&app.clientCtx,
-		&app.blockClient,
 	); err != nil {
 		return nil, err
 	}
@@ -137,18 +119,16 @@ func NewAppGateServer(
 		app.signingInformation.AppAddress = appAddress.String()
 	}
 
-	// Convert the key record to a private key and return the scalar
-	// point on the secp256k1 curve that it corresponds to.
-	// If the key is not a secp256k1 key, this will return an error.
-	signingKey, err := recordLocalToScalar(keyRecord.GetLocal())
+	// The SDK, which relay requests are signed with, shares the server's dependencies.
+	app.sdk, err = sdk.NewPOKTRollSDK(
+		ctx,
+		deps,
+		sdk.WithSigningKey(app.clientCtx.Keyring, app.signingInformation.SigningKeyName),
+	)
 	if err != nil {
-		return nil, fmt.Errorf("failed to convert private key to scalar: %w", err)
+		return nil, fmt.Errorf("failed to create POKTRoll SDK: %w", err)
 	}
-	app.signingInformation.SigningKey = signingKey
 
-	app.sessionQuerier = sessiontypes.NewQueryClient(app.clientCtx)
-	app.accountQuerier = accounttypes.NewQueryClient(app.clientCtx)
-	app.applicationQuerier = apptypes.NewQueryClient(app.clientCtx)
 	app.server = &http.Server{Addr: app.listeningEndpoint.Host}
 
 	return app, nil
@@ -186,6 +166,9 @@ func (app *appGateServer) Stop(ctx context.Context) error {
 //
 // where the serviceId is the id of the service that the application is requesting
 // and the other (possible) path segments are the JSON RPC request path.
+// If the server has an api key store, the request must include an api key, in
+// the ApiKeyHeader header, which allows it to relay for the application to the
+// service within its quotas. Otherwise, it is replied to with a 401 or 429 error.
 // TODO_TECHDEBT: Revisit the requestPath above based on the SDK that'll be exposed in the future.
 func (app *appGateServer) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
 	ctx := request.Context()
@@ -202,10 +185,12 @@ func (app *appGateServer) ServeHTTP(writer http.ResponseWriter, request *http.Re
 			writer,
 			ErrAppGateHandleRelay.Wrapf("reading relay request body: %s", err),
 		)
-		log.Printf("ERROR: failed reading relay request body: %s", err)
+		app.logger.Error().Err(err).Msg("failed reading relay request body")
 		return
 	}
-	log.Printf("DEBUG: relay request body: %s", string(payloadBz))
+	app.logger.Debug().
+		Str("payload", string(payloadBz)).
+		Msg("relay request body")
 
 	// Determine the application address.
 	appAddress := app.signingInformation.AppAddress
@@ -214,7 +199,18 @@ func (app *appGateServer) ServeHTTP(writer http.ResponseWriter, request *http.Re
 	}
 	if appAddress == "" {
 		app.replyWithError(payloadBz, writer, ErrAppGateMissingAppAddress)
-		log.Print("ERROR: no application address provided")
+		app.logger.Error().Msg("no application address provided")
+		return
+	}
+
+	// Authenticate and rate limit the request by its api key.
+	if statusCode, err := app.authorizeRequest(request, appAddress, serviceId, payloadBz); err != nil {
+		app.replyWithStatusError(payloadBz, writer, statusCode, err)
+		app.logger.Error().
+			Err(err).
+			Int("status_code", statusCode).
+			Msg("failed authorizing relay request")
+		return
 	}
 
 	// TODO(@h5law, @red0ne): Add support for asynchronous relays, and switch on
@@ -224,11 +220,11 @@ func (app *appGateServer) ServeHTTP(writer http.ResponseWriter, request *http.Re
 	if err := app.handleSynchronousRelay(ctx, appAddress, serviceId, payloadBz, request, writer); err != nil {
 		// Reply with an error response if there was an error handling the relay.
 		app.replyWithError(payloadBz, writer, err)
-		log.Printf("ERROR: failed handling relay: %s", err)
+		app.logger.Error().Err(err).Msg("failed handling relay")
 		return
 	}
 
-	log.Print("INFO: request serviced successfully")
+	app.logger.Info().Msg("request serviced successfully")
 }
 
 // validateConfig validates the appGateServer configuration.
@@ -242,25 +238,4 @@ func (app *appGateServer) validateConfig() error {
 	return nil
 }
 
-// recordLocalToScalar converts the private key obtained from a
-// key record to a scalar point on the secp256k1 curve
-func recordLocalToScalar(local *keyring.Record_Local) (ringtypes.Scalar, error) {
-	if local == nil {
-		return nil, fmt.Errorf("cannot extract private key from key record: nil")
-	}
-	priv, ok := local.PrivKey.GetCachedValue().(cryptotypes.PrivKey)
-	if !ok {
-		return nil, fmt.Errorf("cannot extract private key from key record: %T", local.PrivKey.GetCachedValue())
-	}
-	if _, ok := priv.(*secp256k1.PrivKey); !ok {
-		return nil, fmt.Errorf("unexpected private key type: %T, want %T", priv, &secp256k1.PrivKey{})
-	}
-	crv := ring_secp256k1.NewCurve()
-	privKey, err := crv.DecodeToScalar(priv.Bytes())
-	if err != nil {
-		return nil, fmt.Errorf("failed to decode private key: %w", err)
-	}
-	return privKey, nil
-}
-
 type appGateServerOption func(*appGateServer)
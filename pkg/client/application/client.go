@@ -0,0 +1,146 @@
+package application
+
+import (
+	"context"
+
+	"cosmossdk.io/depinject"
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/pokt-network/poktroll/pkg/client"
+	"github.com/pokt-network/poktroll/pkg/client/keyring"
+	apptypes "github.com/pokt-network/poktroll/x/application/types"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+)
+
+var _ client.ApplicationClient = (*applicationClient)(nil)
+
+// applicationClient implements the ApplicationClient interface by signing and
+// broadcasting application messages, authored by the configured signing key, via
+// a TxClient.
+type applicationClient struct {
+	signingKeyName string
+	signingKeyAddr cosmostypes.AccAddress
+
+	txClient client.TxClient
+	txCtx    client.TxContext
+}
+
+// NewApplicationClient constructs a new ApplicationClient with the given
+// dependencies and options. If a signingKeyName is not configured, an error
+// will be returned.
+//
+// Required dependencies:
+//   - client.TxClient
+//   - client.TxContext
+//
+// Available options:
+//   - WithSigningKeyName
+func NewApplicationClient(
+	deps depinject.Config,
+	opts ...client.ApplicationClientOption,
+) (*applicationClient, error) {
+	aClient := &applicationClient{}
+
+	if err := depinject.Inject(
+		deps,
+		&aClient.txClient,
+		&aClient.txCtx,
+	); err != nil {
+		return nil, err
+	}
+
+	for _, opt := range opts {
+		opt(aClient)
+	}
+
+	if err := aClient.validateConfigAndSetDefaults(); err != nil {
+		return nil, err
+	}
+
+	return aClient, nil
+}
+
+// Stake constructs a stake application message then signs and broadcasts it
+// to the network via #txClient. It blocks until the transaction is included in
+// a block or times out.
+func (aClient *applicationClient) Stake(
+	ctx context.Context,
+	stake cosmostypes.Coin,
+	serviceConfigs []*sharedtypes.ApplicationServiceConfig,
+) error {
+	msg := apptypes.NewMsgStakeApplication(
+		aClient.signingKeyAddr.String(),
+		stake,
+		serviceConfigs,
+	)
+	return aClient.signAndBroadcast(ctx, msg)
+}
+
+// Unstake constructs an unstake application message then signs and broadcasts
+// it to the network via #txClient. It blocks until the transaction is included
+// in a block or times out.
+func (aClient *applicationClient) Unstake(ctx context.Context) error {
+	msg := apptypes.NewMsgUnstakeApplication(aClient.signingKeyAddr.String())
+	return aClient.signAndBroadcast(ctx, msg)
+}
+
+// DelegateToGateway constructs a delegate to gateway message then signs and
+// broadcasts it to the network via #txClient. It blocks until the transaction
+// is included in a block or times out.
+func (aClient *applicationClient) DelegateToGateway(
+	ctx context.Context,
+	gatewayAddress string,
+) error {
+	msg := apptypes.NewMsgDelegateToGateway(
+		aClient.signingKeyAddr.String(),
+		gatewayAddress,
+	)
+	return aClient.signAndBroadcast(ctx, msg)
+}
+
+// UndelegateFromGateway constructs an undelegate from gateway message then
+// signs and broadcasts it to the network via #txClient. It blocks until the
+// transaction is included in a block or times out.
+func (aClient *applicationClient) UndelegateFromGateway(
+	ctx context.Context,
+	gatewayAddress string,
+) error {
+	msg := apptypes.NewMsgUndelegateFromGateway(
+		aClient.signingKeyAddr.String(),
+		gatewayAddress,
+	)
+	return aClient.signAndBroadcast(ctx, msg)
+}
+
+// signAndBroadcast signs and broadcasts the given message via #txClient and
+// blocks until the transaction is included in a block or times out.
+func (aClient *applicationClient) signAndBroadcast(
+	ctx context.Context,
+	msg cosmostypes.Msg,
+) error {
+	eitherErr := aClient.txClient.SignAndBroadcast(ctx, msg)
+	err, errCh := eitherErr.SyncOrAsyncError()
+	if err != nil {
+		return err
+	}
+
+	return <-errCh
+}
+
+// validateConfigAndSetDefaults attempts to get the address from the keyring
+// corresponding to the key whose name matches the configured signingKeyName.
+// If signingKeyName is empty or the keyring does not contain the corresponding
+// key, an error is returned.
+func (aClient *applicationClient) validateConfigAndSetDefaults() error {
+	signingAddr, err := keyring.KeyNameToAddr(
+		aClient.signingKeyName,
+		aClient.txCtx.GetKeyring(),
+	)
+	if err != nil {
+		return err
+	}
+
+	aClient.signingKeyAddr = signingAddr
+
+	return nil
+}
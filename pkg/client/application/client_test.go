@@ -0,0 +1,156 @@
+package application_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cosmossdk.io/depinject"
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/pkg/client"
+	"github.com/pokt-network/poktroll/pkg/client/application"
+	"github.com/pokt-network/poktroll/pkg/client/keyring"
+	"github.com/pokt-network/poktroll/testutil/mockclient"
+	"github.com/pokt-network/poktroll/testutil/testclient/testkeyring"
+	"github.com/pokt-network/poktroll/testutil/testclient/testtx"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+)
+
+const (
+	testSigningKeyName = "test_signer"
+	testGatewayAddress = "pokt1f6j7u6875p2cvyrgjr0d2uecyzah0kget9vlpl"
+)
+
+func TestNewApplicationClient(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	memKeyring, _ := testkeyring.NewTestKeyringWithKey(t, testSigningKeyName)
+	txCtxMock, _ := testtx.NewAnyTimesTxTxContext(t, memKeyring)
+	txClientMock := mockclient.NewMockTxClient(ctrl)
+
+	deps := depinject.Supply(
+		txCtxMock,
+		txClientMock,
+	)
+
+	tests := []struct {
+		name           string
+		signingKeyName string
+		expectedErr    error
+	}{
+		{
+			name:           "valid signing key name",
+			signingKeyName: testSigningKeyName,
+			expectedErr:    nil,
+		},
+		{
+			name:           "empty signing key name",
+			signingKeyName: "",
+			expectedErr:    keyring.ErrEmptySigningKeyName,
+		},
+		{
+			name:           "no such signing key name",
+			signingKeyName: "nonexistent",
+			expectedErr:    keyring.ErrNoSuchSigningKey,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signingKeyOpt := application.WithSigningKeyName(tt.signingKeyName)
+
+			appClient, err := application.NewApplicationClient(deps, signingKeyOpt)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				require.Nil(t, appClient)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, appClient)
+			}
+		})
+	}
+}
+
+func TestApplicationClient_Msgs(t *testing.T) {
+	tests := []struct {
+		name string
+		send func(ctx context.Context, appClient client.ApplicationClient) error
+	}{
+		{
+			name: "Stake",
+			send: func(ctx context.Context, appClient client.ApplicationClient) error {
+				stake := cosmostypes.NewInt64Coin("upokt", 1000)
+				serviceConfigs := []*sharedtypes.ApplicationServiceConfig{
+					{Service: &sharedtypes.Service{Id: "svc1"}},
+				}
+				return appClient.Stake(ctx, stake, serviceConfigs)
+			},
+		},
+		{
+			name: "Unstake",
+			send: func(ctx context.Context, appClient client.ApplicationClient) error {
+				return appClient.Unstake(ctx)
+			},
+		},
+		{
+			name: "DelegateToGateway",
+			send: func(ctx context.Context, appClient client.ApplicationClient) error {
+				return appClient.DelegateToGateway(ctx, testGatewayAddress)
+			},
+		},
+		{
+			name: "UndelegateFromGateway",
+			send: func(ctx context.Context, appClient client.ApplicationClient) error {
+				return appClient.UndelegateFromGateway(ctx, testGatewayAddress)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				signAndBroadcastDelay = 50 * time.Millisecond
+				doneCh                = make(chan struct{}, 1)
+				ctx                   = context.Background()
+			)
+
+			keyring, testAppKey := testkeyring.NewTestKeyringWithKey(t, testSigningKeyName)
+
+			txCtxMock, _ := testtx.NewAnyTimesTxTxContext(t, keyring)
+			txClientMock := testtx.NewOneTimeDelayedSignAndBroadcastTxClient(t, signAndBroadcastDelay)
+
+			signingKeyOpt := application.WithSigningKeyName(testAppKey.Name)
+			deps := depinject.Supply(
+				txCtxMock,
+				txClientMock,
+			)
+
+			appClient, err := application.NewApplicationClient(deps, signingKeyOpt)
+			require.NoError(t, err)
+			require.NotNil(t, appClient)
+
+			go func() {
+				err = tt.send(ctx, appClient)
+				require.NoError(t, err)
+				close(doneCh)
+			}()
+
+			select {
+			case <-doneCh:
+				t.Fatalf("expected %s to block for signAndBroadcastDelay", tt.name)
+			case <-time.After(signAndBroadcastDelay * 95 / 100):
+				t.Logf("OK: %s blocked for at least 95%% of signAndBroadcastDelay", tt.name)
+			}
+
+			select {
+			case <-time.After(signAndBroadcastDelay):
+				t.Fatalf("expected %s to unblock after signAndBroadcastDelay", tt.name)
+			case <-doneCh:
+				t.Logf("OK: %s unblocked after signAndBroadcastDelay", tt.name)
+			}
+		})
+	}
+}
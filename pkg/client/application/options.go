@@ -0,0 +1,14 @@
+package application
+
+import (
+	"github.com/pokt-network/poktroll/pkg/client"
+)
+
+// WithSigningKeyName sets the name of the key which the application client
+// should retrieve from the keyring to use for authoring and signing its
+// messages.
+func WithSigningKeyName(keyName string) client.ApplicationClientOption {
+	return func(aClient client.ApplicationClient) {
+		aClient.(*applicationClient).signingKeyName = keyName
+	}
+}
@@ -3,14 +3,18 @@ package block
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"sync"
 	"time"
 
 	"cosmossdk.io/depinject"
+	comethttp "github.com/cometbft/cometbft/rpc/client/http"
 
 	"github.com/pokt-network/poktroll/pkg/client"
 	"github.com/pokt-network/poktroll/pkg/either"
 	"github.com/pokt-network/poktroll/pkg/observable"
 	"github.com/pokt-network/poktroll/pkg/observable/channel"
+	"github.com/pokt-network/poktroll/pkg/polylog"
 	"github.com/pokt-network/poktroll/pkg/retry"
 )
 
@@ -36,6 +40,15 @@ const (
 	// replay observable which is notified when block commit events are received
 	// by the events query client subscription created in goPublishBlocks.
 	latestBlockReplayBufferSize = 1
+	// blockQueryRetryInitialDelay is the delay before the first retry of a
+	// failed query for a missed block. It doubles with each subsequent retry,
+	// up to blockQueryRetryMaxDelay.
+	blockQueryRetryInitialDelay = 100 * time.Millisecond
+	// blockQueryRetryMaxDelay is the maximum delay between retries of a failed
+	// query for a missed block.
+	blockQueryRetryMaxDelay = 10 * time.Second
+	// cometWebsocketPath is the path of the cometbft RPC websocket endpoint.
+	cometWebsocketPath = "/websocket"
 )
 
 var (
@@ -63,25 +76,43 @@ type blockClient struct {
 	// It's used to set blockObsvbl initially and subsequently update it, for
 	// example, when the connection is re-established after erroring.
 	latestBlockObsvblsReplayPublishCh chan<- client.BlocksObservable
+	// blockQueryClient is used to query for blocks which were committed while
+	// the events query subscription was interrupted (i.e. missed).
+	blockQueryClient client.BlockQueryClient
+
+	// lastHeightMu protects lastHeight.
+	lastHeightMu sync.Mutex
+	// lastHeight is the height of the last block which was published. It is
+	// used to detect gaps in the committed blocks sequence and persists across
+	// events query subscriptions. It is zero until the first block is received.
+	lastHeight int64
 }
 
-// eventsBytesToBlockMapFn is a convenience type to represent the type of a
+// eventsBytesToBlocksMapFn is a convenience type to represent the type of a
 // function which maps event subscription message bytes into block event objects.
-// This is used as a transformFn in a channel.Map() call and is the type returned
-// by the newEventsBytesToBlockMapFn factory function.
-type eventBytesToBlockMapFn = func(
+// This is used as a transformFn in a channel.MapExpand() call and is the type
+// returned by the newEventsBytesToBlocksMapFn factory method.
+type eventsBytesToBlocksMapFn = func(
 	context.Context,
 	either.Bytes,
-) (client.Block, bool)
+) ([]client.Block, bool)
 
 // NewBlockClient creates a new block client from the given dependencies and cometWebsocketURL.
+// Blocks which are committed while the events query subscription is interrupted
+// are queried for, by height, such that the committed blocks sequence remains
+// contiguous. Unless otherwise configured, the cometbft RPC endpoint corresponding
+// to cometWebsocketURL is used to query for them.
 //
 // Required dependencies:
 //   - client.EventsQueryClient
+//
+// Available options:
+//   - WithBlockQueryClient
 func NewBlockClient(
 	ctx context.Context,
 	deps depinject.Config,
 	cometWebsocketURL string,
+	opts ...client.BlockClientOption,
 ) (client.BlockClient, error) {
 	// Initialize block client
 	bClient := &blockClient{endpointURL: cometWebsocketURL}
@@ -92,6 +123,18 @@ func NewBlockClient(
 		return nil, err
 	}
 
+	for _, opt := range opts {
+		opt(bClient)
+	}
+
+	if bClient.blockQueryClient == nil {
+		blockQueryClient, err := newCometBlockQueryClient(cometWebsocketURL)
+		if err != nil {
+			return nil, err
+		}
+		bClient.blockQueryClient = blockQueryClient
+	}
+
 	// Concurrently publish blocks to the observable emitted by latestBlockObsvbls.
 	go bClient.goPublishBlocks(ctx)
 
@@ -150,8 +193,8 @@ func (bClient *blockClient) goPublishBlocks(ctx context.Context) {
 
 // retryPublishBlocksFactory returns a function which is intended to be passed to
 // retry.OnError. The returned function pipes event bytes from the events query
-// client, maps them to block events, and publishes them to the latestBlockObsvbls
-// replay observable.
+// client, maps them to block events (preceded by any missed blocks), and publishes
+// them to the latestBlockObsvbls replay observable.
 func (bClient *blockClient) retryPublishBlocksFactory(ctx context.Context) func() chan error {
 	return func() chan error {
 		errCh := make(chan error, 1)
@@ -165,11 +208,14 @@ func (bClient *blockClient) retryPublishBlocksFactory(ctx context.Context) func(
 		// client.BlocksObservable cannot be an alias due to gomock's lack of
 		// support for generic types.
 		eventsBz := observable.Observable[either.Either[[]byte]](eventsBzObsvbl)
-		blocksObsvbl := channel.MapReplay(
+		blocksObsvbl := channel.ToReplayObservable(
 			ctx,
 			latestBlockReplayBufferSize,
-			eventsBz,
-			newEventsBytesToBlockMapFn(errCh),
+			channel.MapExpand(
+				ctx,
+				eventsBz,
+				bClient.newEventsBytesToBlocksMapFn(errCh),
+			),
 		)
 
 		// Initially set latestBlockObsvbls and update if after retrying on error.
@@ -179,11 +225,11 @@ func (bClient *blockClient) retryPublishBlocksFactory(ctx context.Context) func(
 	}
 }
 
-// newEventsBytesToBlockMapFn is a factory for a function which is intended
-// to be used as a transformFn in a channel.Map() call. Since the map function
+// newEventsBytesToBlocksMapFn is a factory for a function which is intended
+// to be used as a transformFn in a channel.MapExpand() call. Since the map function
 // is called asynchronously, this factory creates a closure around an error channel
 // which can be used for asynchronous error signaling from within the map function,
-// and handling from the Map call context.
+// and handling from the MapExpand call context.
 //
 // The map function itself attempts to deserialize the given byte slice as a
 // committed block event. If the events bytes observable contained an error, this value is not emitted
@@ -191,11 +237,13 @@ func (bClient *blockClient) retryPublishBlocksFactory(ctx context.Context) func(
 // If deserialization failed because the event bytes were for a different event type,
 // this value is also skipped.
 // If deserialization failed for some other reason, this function panics.
-func newEventsBytesToBlockMapFn(errCh chan<- error) eventBytesToBlockMapFn {
+// Otherwise, the block is emitted, preceded by any blocks which were missed since
+// the last one (see: withMissedBlocks).
+func (bClient *blockClient) newEventsBytesToBlocksMapFn(errCh chan<- error) eventsBytesToBlocksMapFn {
 	return func(
-		_ context.Context,
+		ctx context.Context,
 		eitherEventBz either.Bytes,
-	) (_ client.Block, skip bool) {
+	) (_ []client.Block, skip bool) {
 		eventBz, err := eitherEventBz.ValueOrError()
 		if err != nil {
 			errCh <- err
@@ -218,6 +266,109 @@ func newEventsBytesToBlockMapFn(errCh chan<- error) eventBytesToBlockMapFn {
 				err, string(eventBz),
 			))
 		}
-		return block, false
+
+		blocks := bClient.withMissedBlocks(ctx, block)
+		return blocks, len(blocks) == 0
+	}
+}
+
+// withMissedBlocks returns the given block, preceded by any blocks between the
+// last published block and it, which are queried for by height, and updates
+// lastHeight accordingly. If the given block has already been published (e.g.
+// when it is received again after re-subscribing), it returns nil.
+func (bClient *blockClient) withMissedBlocks(
+	ctx context.Context,
+	block client.Block,
+) []client.Block {
+	bClient.lastHeightMu.Lock()
+	defer bClient.lastHeightMu.Unlock()
+
+	// This is the first block received; there's nothing to backfill.
+	if bClient.lastHeight == 0 {
+		bClient.lastHeight = block.Height()
+		return []client.Block{block}
+	}
+
+	if block.Height() <= bClient.lastHeight {
+		return nil
+	}
+
+	blocks := make([]client.Block, 0, block.Height()-bClient.lastHeight)
+	if missedCount := block.Height() - bClient.lastHeight - 1; missedCount > 0 {
+		polylog.Ctx(ctx).Warn().
+			Int64("last_height", bClient.lastHeight).
+			Int64("received_height", block.Height()).
+			Msgf("backfilling %d missed block(s)", missedCount)
+	}
+
+	for height := bClient.lastHeight + 1; height < block.Height(); height++ {
+		missedBlock, err := bClient.queryBlock(ctx, height)
+		if err != nil {
+			// The context is done; nothing will consume the blocks anyway.
+			return nil
+		}
+		blocks = append(blocks, missedBlock)
 	}
+
+	bClient.lastHeight = block.Height()
+	return append(blocks, block)
+}
+
+// queryBlock queries for the block at the given height, retrying with an
+// exponential backoff until it succeeds, such that the committed blocks sequence
+// remains contiguous. It only returns an error if the context is done.
+func (bClient *blockClient) queryBlock(
+	ctx context.Context,
+	height int64,
+) (client.Block, error) {
+	retryDelay := blockQueryRetryInitialDelay
+	for {
+		blockResult, err := bClient.blockQueryClient.Block(ctx, &height)
+		if err == nil && blockResult.Block != nil {
+			return &cometBlockEvent{Block: *blockResult.Block}, nil
+		}
+		if err == nil {
+			err = fmt.Errorf("empty block result")
+		}
+
+		polylog.Ctx(ctx).Warn().
+			Err(ErrQueryBlock.Wrapf("at height %d: %s", height, err)).
+			Dur("retry_delay", retryDelay).
+			Msg("retrying block query")
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(retryDelay):
+		}
+
+		retryDelay *= 2
+		if retryDelay > blockQueryRetryMaxDelay {
+			retryDelay = blockQueryRetryMaxDelay
+		}
+	}
+}
+
+// newCometBlockQueryClient returns a cometbft RPC client for the node which
+// serves the given websocket URL (e.g. ws://localhost:36657/websocket).
+func newCometBlockQueryClient(cometWebsocketURL string) (client.BlockQueryClient, error) {
+	rpcURL, err := url.Parse(cometWebsocketURL)
+	if err != nil {
+		return nil, ErrInvalidCometURL.Wrapf("%s: %s", cometWebsocketURL, err)
+	}
+
+	switch rpcURL.Scheme {
+	case "ws":
+		rpcURL.Scheme = "http"
+	case "wss":
+		rpcURL.Scheme = "https"
+	}
+	rpcURL.Path = ""
+
+	blockQueryClient, err := comethttp.New(rpcURL.String(), cometWebsocketPath)
+	if err != nil {
+		return nil, ErrInvalidCometURL.Wrapf("%s: %s", cometWebsocketURL, err)
+	}
+
+	return blockQueryClient, nil
 }
@@ -7,11 +7,15 @@ import (
 	"time"
 
 	"cosmossdk.io/depinject"
+	cometrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 	comettypes "github.com/cometbft/cometbft/types"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/pokt-network/poktroll/pkg/client"
 	"github.com/pokt-network/poktroll/pkg/client/block"
+	"github.com/pokt-network/poktroll/pkg/either"
+	"github.com/pokt-network/poktroll/testutil/mockclient"
 	"github.com/pokt-network/poktroll/testutil/testclient"
 	"github.com/pokt-network/poktroll/testutil/testclient/testeventsquery"
 )
@@ -109,6 +113,72 @@ func TestBlockClient(t *testing.T) {
 	blockClient.Close()
 }
 
+func TestBlockClient_BackfillsMissedBlocks(t *testing.T) {
+	var (
+		eventsBzPublishCh chan<- either.Bytes
+		// receivedHeights are the heights of the blocks for which events are
+		// received; blocks 2 and 3 are committed while "disconnected".
+		receivedHeights = []int64{1, 4}
+		expectedHeights = []int64{1, 2, 3, 4}
+		ctrl            = gomock.NewController(t)
+		ctx, cancel     = context.WithCancel(context.Background())
+	)
+	t.Cleanup(cancel)
+
+	eventsQueryClient := testeventsquery.NewOneTimeEventsQuery(
+		ctx, t,
+		committedBlocksQuery,
+		&eventsBzPublishCh,
+	)
+
+	// Expect the missed blocks to be queried for, in order.
+	blockQueryClientMock := mockclient.NewMockBlockQueryClient(ctrl)
+	for _, missedHeight := range expectedHeights[1:3] {
+		missedHeight := missedHeight
+		blockQueryClientMock.EXPECT().
+			Block(gomock.Any(), gomock.Eq(&missedHeight)).
+			Return(&cometrpctypes.ResultBlock{Block: newTestBlock(missedHeight)}, nil).
+			Times(1)
+	}
+
+	deps := depinject.Supply(eventsQueryClient)
+	blockClient, err := block.NewBlockClient(
+		ctx, deps,
+		testclient.CometLocalWebsocketURL,
+		block.WithBlockQueryClient(blockQueryClientMock),
+	)
+	require.NoError(t, err)
+
+	blocksObserver := blockClient.CommittedBlocksSequence(ctx).Subscribe(ctx)
+
+	for _, height := range receivedHeights {
+		eventBz, err := json.Marshal(&testBlockEvent{Block: *newTestBlock(height)})
+		require.NoError(t, err)
+
+		eventsBzPublishCh <- either.Success(eventBz)
+	}
+
+	// Assert that the committed blocks sequence is contiguous.
+	for _, expectedHeight := range expectedHeights {
+		select {
+		case actualBlock := <-blocksObserver.Ch():
+			require.Equal(t, expectedHeight, actualBlock.Height())
+		case <-time.After(testTimeoutDuration):
+			t.Fatalf("timed out waiting for block at height %d", expectedHeight)
+		}
+	}
+}
+
+// newTestBlock returns a minimal cometbft block with the given height.
+func newTestBlock(height int64) *comettypes.Block {
+	return &comettypes.Block{
+		Header: comettypes.Header{
+			Height: height,
+			Time:   time.Now(),
+		},
+	}
+}
+
 /*
 TODO_TECHDEBT/TODO_CONSIDERATION(#XXX): this duplicates the unexported block event
 
@@ -4,5 +4,7 @@ import errorsmod "cosmossdk.io/errors"
 
 var (
 	ErrUnmarshalBlockEvent = errorsmod.Register(codespace, 1, "failed to unmarshal committed block event")
+	ErrInvalidCometURL     = errorsmod.Register(codespace, 2, "invalid cometbft URL")
+	ErrQueryBlock          = errorsmod.Register(codespace, 3, "failed to query block")
 	codespace              = "block_client"
 )
@@ -0,0 +1,12 @@
+package block
+
+import "github.com/pokt-network/poktroll/pkg/client"
+
+// WithBlockQueryClient returns a client.BlockClientOption which sets the client
+// used to query for blocks which were missed while the events query subscription
+// was interrupted.
+func WithBlockQueryClient(blockQueryClient client.BlockQueryClient) client.BlockClientOption {
+	return func(bClient client.BlockClient) {
+		bClient.(*blockClient).blockQueryClient = blockQueryClient
+	}
+}
@@ -0,0 +1,10 @@
+package events
+
+import errorsmod "cosmossdk.io/errors"
+
+var (
+	ErrUnmarshalTxEvent = errorsmod.Register(codespace, 1, "failed to unmarshal tx event")
+	ErrNoMatchingMsgs   = errorsmod.Register(codespace, 2, "no matching messages in tx")
+	ErrTxFailed         = errorsmod.Register(codespace, 3, "tx failed")
+	codespace           = "events_client"
+)
@@ -0,0 +1,6 @@
+// Package events provides a generic events replay client which maps the raw
+// event message bytes of an events query subscription into typed, replayable
+// observables, as well as constructors for such clients which are notified about
+// pocket module messages (e.g. application delegations, claims and proofs) as
+// they are committed on-chain.
+package events
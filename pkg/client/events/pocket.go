@@ -0,0 +1,122 @@
+package events
+
+import (
+	"context"
+
+	"cosmossdk.io/depinject"
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/pokt-network/poktroll/pkg/client"
+	apptypes "github.com/pokt-network/poktroll/x/application/types"
+	suppliertypes "github.com/pokt-network/poktroll/x/supplier/types"
+)
+
+// NewAppStakeReplayClient creates a new events replay client which is notified
+// when applications stake or update their stake.
+func NewAppStakeReplayClient(
+	ctx context.Context,
+	deps depinject.Config,
+	txDecoder cosmostypes.TxDecoder,
+) (client.EventsReplayClient[*TxMsgsEvent[*apptypes.MsgStakeApplication]], error) {
+	return NewTxMsgsReplayClient[*apptypes.MsgStakeApplication](ctx, deps, txDecoder)
+}
+
+// NewAppUnstakeReplayClient creates a new events replay client which is
+// notified when applications unstake.
+func NewAppUnstakeReplayClient(
+	ctx context.Context,
+	deps depinject.Config,
+	txDecoder cosmostypes.TxDecoder,
+) (client.EventsReplayClient[*TxMsgsEvent[*apptypes.MsgUnstakeApplication]], error) {
+	return NewTxMsgsReplayClient[*apptypes.MsgUnstakeApplication](ctx, deps, txDecoder)
+}
+
+// NewDelegationReplayClient creates a new events replay client which is
+// notified when applications delegate to gateways.
+func NewDelegationReplayClient(
+	ctx context.Context,
+	deps depinject.Config,
+	txDecoder cosmostypes.TxDecoder,
+) (client.EventsReplayClient[*TxMsgsEvent[*apptypes.MsgDelegateToGateway]], error) {
+	return NewTxMsgsReplayClient[*apptypes.MsgDelegateToGateway](ctx, deps, txDecoder)
+}
+
+// NewUndelegationReplayClient creates a new events replay client which is
+// notified when applications undelegate from gateways.
+func NewUndelegationReplayClient(
+	ctx context.Context,
+	deps depinject.Config,
+	txDecoder cosmostypes.TxDecoder,
+) (client.EventsReplayClient[*TxMsgsEvent[*apptypes.MsgUndelegateFromGateway]], error) {
+	return NewTxMsgsReplayClient[*apptypes.MsgUndelegateFromGateway](ctx, deps, txDecoder)
+}
+
+// NewClaimReplayClient creates a new events replay client which is notified
+// when suppliers create claims.
+func NewClaimReplayClient(
+	ctx context.Context,
+	deps depinject.Config,
+	txDecoder cosmostypes.TxDecoder,
+) (client.EventsReplayClient[*TxMsgsEvent[*suppliertypes.MsgCreateClaim]], error) {
+	return NewTxMsgsReplayClient[*suppliertypes.MsgCreateClaim](ctx, deps, txDecoder)
+}
+
+// NewProofReplayClient creates a new events replay client which is notified
+// when suppliers submit proofs.
+func NewProofReplayClient(
+	ctx context.Context,
+	deps depinject.Config,
+	txDecoder cosmostypes.TxDecoder,
+) (client.EventsReplayClient[*TxMsgsEvent[*suppliertypes.MsgSubmitProof]], error) {
+	return NewTxMsgsReplayClient[*suppliertypes.MsgSubmitProof](ctx, deps, txDecoder)
+}
+
+// NewSupplierStakeReplayClient creates a new events replay client which is
+// notified when suppliers stake or update their stake.
+func NewSupplierStakeReplayClient(
+	ctx context.Context,
+	deps depinject.Config,
+	txDecoder cosmostypes.TxDecoder,
+) (client.EventsReplayClient[*TxMsgsEvent[*suppliertypes.MsgStakeSupplier]], error) {
+	return NewTxMsgsReplayClient[*suppliertypes.MsgStakeSupplier](ctx, deps, txDecoder)
+}
+
+// NewSupplierUnstakeReplayClient creates a new events replay client which is
+// notified when suppliers unstake.
+func NewSupplierUnstakeReplayClient(
+	ctx context.Context,
+	deps depinject.Config,
+	txDecoder cosmostypes.TxDecoder,
+) (client.EventsReplayClient[*TxMsgsEvent[*suppliertypes.MsgUnstakeSupplier]], error) {
+	return NewTxMsgsReplayClient[*suppliertypes.MsgUnstakeSupplier](ctx, deps, txDecoder)
+}
+
+// NewSupplierServicesUpdateReplayClient creates a new events replay client
+// which is notified when suppliers update their services.
+func NewSupplierServicesUpdateReplayClient(
+	ctx context.Context,
+	deps depinject.Config,
+	txDecoder cosmostypes.TxDecoder,
+) (client.EventsReplayClient[*TxMsgsEvent[*suppliertypes.MsgUpdateSupplierServices]], error) {
+	return NewTxMsgsReplayClient[*suppliertypes.MsgUpdateSupplierServices](ctx, deps, txDecoder)
+}
+
+// NewSupplierStakeDecreaseReplayClient creates a new events replay client
+// which is notified when suppliers decrease their stake.
+func NewSupplierStakeDecreaseReplayClient(
+	ctx context.Context,
+	deps depinject.Config,
+	txDecoder cosmostypes.TxDecoder,
+) (client.EventsReplayClient[*TxMsgsEvent[*suppliertypes.MsgDecreaseSupplierStake]], error) {
+	return NewTxMsgsReplayClient[*suppliertypes.MsgDecreaseSupplierStake](ctx, deps, txDecoder)
+}
+
+// NewSupplierOperatorUpdateReplayClient creates a new events replay client
+// which is notified when suppliers rotate their operator.
+func NewSupplierOperatorUpdateReplayClient(
+	ctx context.Context,
+	deps depinject.Config,
+	txDecoder cosmostypes.TxDecoder,
+) (client.EventsReplayClient[*TxMsgsEvent[*suppliertypes.MsgUpdateSupplierOperator]], error) {
+	return NewTxMsgsReplayClient[*suppliertypes.MsgUpdateSupplierOperator](ctx, deps, txDecoder)
+}
@@ -0,0 +1,226 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/depinject"
+
+	"github.com/pokt-network/poktroll/pkg/client"
+	"github.com/pokt-network/poktroll/pkg/either"
+	"github.com/pokt-network/poktroll/pkg/observable"
+	"github.com/pokt-network/poktroll/pkg/observable/channel"
+	"github.com/pokt-network/poktroll/pkg/polylog"
+	"github.com/pokt-network/poktroll/pkg/retry"
+)
+
+const (
+	// eventsBytesRetryDelay is the delay between retry attempts when the events
+	// bytes observable returns an error.
+	eventsBytesRetryDelay = time.Second
+	// eventsBytesRetryLimit is the maximum number of times to attempt to
+	// re-establish the events query bytes subscription when the events bytes
+	// observable returns an error.
+	eventsBytesRetryLimit        = 10
+	eventsBytesRetryResetTimeout = 10 * time.Second
+	// replayObsCacheBufferSize is the replay buffer size of the replayObsCache
+	// replay observable which is used to cache the "active" events observable.
+	// It is updated with a new "active" observable when a new events query
+	// subscription is created, for example, after a non-persistent connection
+	// error.
+	replayObsCacheBufferSize = 1
+)
+
+var _ client.EventsReplayClient[any] = (*replayClient[any])(nil)
+
+// replayClient implements the EventsReplayClient interface for events of type T.
+type replayClient[T any] struct {
+	// queryString is the query which the events query client subscription is
+	// made with.
+	queryString string
+	// eventsClient is the events query client which is used to subscribe to
+	// events matching queryString.
+	eventsClient client.EventsQueryClient
+	// eventDecoder decodes event message bytes into events of type T.
+	eventDecoder client.NewEventsFn[T]
+	// replayBufferSize is the replay buffer size of each "active" events
+	// observable which replayObsCache emits.
+	replayBufferSize int
+	// replayObsCache is a replay observable with replay buffer size 1, which
+	// holds the "active" events observable which is notified when matching
+	// events are received by the events query client subscription created in
+	// goPublishEvents. This observable (and the one it emits) closes when the
+	// events bytes observable returns an error and is updated with a new
+	// "active" observable after a new events query subscription is created.
+	replayObsCache observable.ReplayObservable[observable.ReplayObservable[T]]
+	// replayObsCachePublishCh is the publish channel for replayObsCache.
+	replayObsCachePublishCh chan<- observable.ReplayObservable[T]
+	// cancelCtx cancels the context which the events query subscription is
+	// made with such that closing this client doesn't close the subscriptions
+	// of other clients which share the same events query client.
+	cancelCtx context.CancelFunc
+}
+
+// NewEventsReplayClient creates a new events replay client which subscribes to
+// on-chain events matching the given query string, using the given decoder to
+// map the received event message bytes into events of type T. Event message
+// bytes which fail to decode are skipped. The given replay buffer size bounds
+// the number of the latest events which are replayed to new subscribers and
+// available via LastNEvents.
+//
+// Required dependencies:
+//   - client.EventsQueryClient
+func NewEventsReplayClient[T any](
+	ctx context.Context,
+	deps depinject.Config,
+	queryString string,
+	newEventFn client.NewEventsFn[T],
+	replayBufferSize int,
+) (client.EventsReplayClient[T], error) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	// Initialize the replay client
+	rClient := &replayClient[T]{
+		queryString:      queryString,
+		eventDecoder:     newEventFn,
+		replayBufferSize: replayBufferSize,
+		cancelCtx:        cancel,
+	}
+	rClient.replayObsCache, rClient.replayObsCachePublishCh = channel.NewReplayObservable[observable.ReplayObservable[T]](ctx, replayObsCacheBufferSize)
+
+	// Inject dependencies
+	if err := depinject.Inject(deps, &rClient.eventsClient); err != nil {
+		cancel()
+		return nil, err
+	}
+
+	// Concurrently publish events to the observable emitted by replayObsCache.
+	go rClient.goPublishEvents(ctx)
+
+	return rClient, nil
+}
+
+// EventsSequence returns a ReplayObservable, with the replay buffer size the
+// client was constructed with, which is notified when matching events are
+// received by the events query subscription.
+func (rClient *replayClient[T]) EventsSequence(ctx context.Context) observable.ReplayObservable[T] {
+	// Get the active events observable from the replay observable. We only ever
+	// want the last 1 as any prior events observable values are closed.
+	// Directly accessing the zeroth index here is safe because the call to Last
+	// is guaranteed to return a slice with at least 1 element.
+	return rClient.replayObsCache.Last(ctx, 1)[0]
+}
+
+// LastNEvents returns the latest n events that have been received by the
+// corresponding events query subscription.
+// It blocks until at least one event has been received.
+func (rClient *replayClient[T]) LastNEvents(ctx context.Context, n int) []T {
+	return rClient.EventsSequence(ctx).Last(ctx, n)
+}
+
+// Close closes the corresponding events query subscription, after which no more
+// events are received.
+func (rClient *replayClient[T]) Close() {
+	// Cancelling the context unsubscribes from the events bytes observable and
+	// closes the events query subscription (see: EventsQueryClient#EventsBytes).
+	rClient.cancelCtx()
+}
+
+// goPublishEvents runs the work function returned by retryPublishEventsFactory,
+// re-invoking it according to the arguments to retry.OnError when the events bytes
+// observable returns an asynchronous error.
+// This function is intended to be called in a goroutine.
+func (rClient *replayClient[T]) goPublishEvents(ctx context.Context) {
+	// React to errors by getting a new events bytes observable, re-mapping it,
+	// and send it to replayObsCachePublishCh such that
+	// replayObsCache.Last(ctx, 1) will return it.
+	publishErr := retry.OnError(
+		ctx,
+		eventsBytesRetryLimit,
+		eventsBytesRetryDelay,
+		eventsBytesRetryResetTimeout,
+		"goPublishEvents",
+		rClient.retryPublishEventsFactory(ctx),
+	)
+
+	// If we get here, the retry limit was reached and the retry loop exited.
+	// Since this function runs in a goroutine, we can't return the error to the
+	// caller. Instead, we panic.
+	if publishErr != nil {
+		panic(fmt.Errorf("EventsReplayClient.goPublishEvents should never reach this spot: %w", publishErr))
+	}
+}
+
+// retryPublishEventsFactory returns a function which is intended to be passed to
+// retry.OnError. The returned function pipes event bytes from the events query
+// client, maps them to events of type T, and publishes them to the
+// replayObsCache replay observable.
+func (rClient *replayClient[T]) retryPublishEventsFactory(ctx context.Context) func() chan error {
+	return func() chan error {
+		errCh := make(chan error, 1)
+		eventsBzObsvbl, err := rClient.eventsClient.EventsBytes(ctx, rClient.queryString)
+		if err != nil {
+			errCh <- err
+			return errCh
+		}
+
+		// NB: must cast back to generic observable type to use with Map.
+		eventsBz := observable.Observable[either.Either[[]byte]](eventsBzObsvbl)
+		eventsObsvbl := channel.ToReplayObservable(
+			ctx,
+			rClient.replayBufferSize,
+			channel.Map(
+				ctx,
+				eventsBz,
+				rClient.newEventsBytesToEventsMapFn(errCh),
+			),
+		)
+
+		// Initially set replayObsCache and update if after retrying on error.
+		rClient.replayObsCachePublishCh <- eventsObsvbl
+
+		return errCh
+	}
+}
+
+// newEventsBytesToEventsMapFn is a factory for a function which is intended
+// to be used as a transformFn in a channel.Map() call. Since the map function
+// is called asynchronously, this factory creates a closure around an error channel
+// which can be used for asynchronous error signaling from within the map function,
+// and handling from the Map call context.
+//
+// The map function itself attempts to decode the given byte slice as an event
+// of type T. If the events bytes observable contained an error, this value is
+// not emitted (skipped) on the destination observable of the map operation.
+// If decoding failed (e.g. the bytes are the subscription response or an event
+// of a different type), this value is also skipped.
+func (rClient *replayClient[T]) newEventsBytesToEventsMapFn(errCh chan<- error) channel.MapFn[either.Bytes, T] {
+	return func(
+		ctx context.Context,
+		eitherEventBz either.Bytes,
+	) (_ T, skip bool) {
+		var zeroEvent T
+
+		eventBz, err := eitherEventBz.ValueOrError()
+		if err != nil {
+			errCh <- err
+			// Don't publish (skip) if eitherEventBz contained an error.
+			// eitherEventBz should automatically close itself in this case.
+			// (i.e. no more values should be mapped to this transformFn's respective
+			// dstObservable).
+			return zeroEvent, true
+		}
+
+		event, err := rClient.eventDecoder(eventBz)
+		if err != nil {
+			polylog.Ctx(ctx).Debug().
+				Str("query", rClient.queryString).
+				Err(err).
+				Msg("skipping events bytes which could not be decoded")
+			return zeroEvent, true
+		}
+
+		return event, false
+	}
+}
@@ -0,0 +1,239 @@
+package events_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"cosmossdk.io/depinject"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/pkg/client"
+	"github.com/pokt-network/poktroll/pkg/client/events"
+	"github.com/pokt-network/poktroll/pkg/either"
+	"github.com/pokt-network/poktroll/pkg/observable/channel"
+	testevents "github.com/pokt-network/poktroll/testutil/events"
+	"github.com/pokt-network/poktroll/testutil/mockclient"
+)
+
+const (
+	testTimeoutDuration = 100 * time.Millisecond
+	testQuery           = "tm.event='TestEvent'"
+)
+
+type testEvent struct {
+	Nonce int `json:"nonce"`
+}
+
+func TestEventsReplayClient(t *testing.T) {
+	ctx := context.Background()
+
+	eventsBzPublishCh, deps := newEventsBytesPublishChAndDeps(ctx, t, testQuery)
+
+	replayClient, err := events.NewEventsReplayClient[*testEvent](
+		ctx, deps,
+		testQuery,
+		newTestEvent,
+		2,
+	)
+	require.NoError(t, err)
+	t.Cleanup(replayClient.Close)
+
+	// Subscribe before publishing such that all events are observed.
+	eventsObserver := replayClient.EventsSequence(ctx).Subscribe(ctx)
+
+	// The subscription response doesn't decode and is skipped.
+	eventsBzPublishCh <- either.Success([]byte(`{"jsonrpc":"2.0","id":0,"result":{}}`))
+	for nonce := 1; nonce <= 3; nonce++ {
+		eventBz, err := json.Marshal(&testEvent{Nonce: nonce})
+		require.NoError(t, err)
+		eventsBzPublishCh <- either.Success(eventBz)
+	}
+
+	for expectedNonce := 1; expectedNonce <= 3; expectedNonce++ {
+		select {
+		case event := <-eventsObserver.Ch():
+			require.Equal(t, expectedNonce, event.Nonce)
+		case <-time.After(testTimeoutDuration):
+			t.Fatalf("timed out waiting for event with nonce %d", expectedNonce)
+		}
+	}
+
+	// Only the replay buffer size number of latest events are retained.
+	lastEvents := replayClient.LastNEvents(ctx, 2)
+	require.Len(t, lastEvents, 2)
+	require.Equal(t, 2, lastEvents[0].Nonce)
+	require.Equal(t, 3, lastEvents[1].Nonce)
+}
+
+func TestTxMsgsReplayClient(t *testing.T) {
+	var (
+		ctx           = context.Background()
+		expectedQuery = "tm.event='Tx' AND message.action='/cosmos.bank.v1beta1.MsgSend'"
+		fromAddr      = cosmostypes.AccAddress("from_address________").String()
+		toAddr        = cosmostypes.AccAddress("to_address__________").String()
+		expectedMsg   = &banktypes.MsgSend{
+			FromAddress: fromAddr,
+			ToAddress:   toAddr,
+			Amount:      cosmostypes.NewCoins(cosmostypes.NewInt64Coin("upokt", 1)),
+		}
+	)
+
+	registry := codectypes.NewInterfaceRegistry()
+	banktypes.RegisterInterfaces(registry)
+	txConfig := authtx.NewTxConfig(codec.NewProtoCodec(registry), authtx.DefaultSignModes)
+
+	eventsBzPublishCh, deps := newEventsBytesPublishChAndDeps(ctx, t, expectedQuery)
+
+	replayClient, err := events.NewTxMsgsReplayClient[*banktypes.MsgSend](
+		ctx, deps,
+		txConfig.TxDecoder(),
+	)
+	require.NoError(t, err)
+	t.Cleanup(replayClient.Close)
+
+	eventsObserver := replayClient.EventsSequence(ctx).Subscribe(ctx)
+
+	// Failed transactions are skipped.
+	eventsBzPublishCh <- either.Success(testevents.NewTxEventBz(t, txConfig, 1, 1, expectedMsg))
+	eventsBzPublishCh <- either.Success(testevents.NewTxEventBz(t, txConfig, 2, 0, expectedMsg))
+
+	select {
+	case event := <-eventsObserver.Ch():
+		require.Equal(t, int64(2), event.Height)
+		require.Len(t, event.Msgs, 1)
+		require.Equal(t, fromAddr, event.Msgs[0].FromAddress)
+		require.Equal(t, toAddr, event.Msgs[0].ToAddress)
+	case <-time.After(testTimeoutDuration):
+		t.Fatal("timed out waiting for tx msgs event")
+	}
+}
+
+func TestForEachMsg_Resubscribes(t *testing.T) {
+	var (
+		ctx, cancelCtx = context.WithCancel(context.Background())
+		expectedQuery  = "tm.event='Tx' AND message.action='/cosmos.bank.v1beta1.MsgSend'"
+		fromAddr       = cosmostypes.AccAddress("from_address________").String()
+		toAddr         = cosmostypes.AccAddress("to_address__________").String()
+	)
+	t.Cleanup(cancelCtx)
+
+	registry := codectypes.NewInterfaceRegistry()
+	banktypes.RegisterInterfaces(registry)
+	txConfig := authtx.NewTxConfig(codec.NewProtoCodec(registry), authtx.DefaultSignModes)
+
+	// The first events query subscription errors, after which the replay client
+	// re-subscribes and receives the second one.
+	eventsBzObsvbl1, eventsBzPublishCh1 := channel.NewObservable[either.Bytes]()
+	eventsBzObsvbl2, eventsBzPublishCh2 := channel.NewObservable[either.Bytes]()
+	resubscribedCh := make(chan struct{})
+
+	ctrl := gomock.NewController(t)
+	eventsQueryClient := mockclient.NewMockEventsQueryClient(ctrl)
+	firstCall := eventsQueryClient.EXPECT().
+		EventsBytes(gomock.Any(), gomock.Eq(expectedQuery)).
+		Return(client.EventsBytesObservable(eventsBzObsvbl1), nil).
+		Times(1)
+	eventsQueryClient.EXPECT().
+		EventsBytes(gomock.Any(), gomock.Eq(expectedQuery)).
+		DoAndReturn(func(context.Context, string) (client.EventsBytesObservable, error) {
+			close(resubscribedCh)
+			return client.EventsBytesObservable(eventsBzObsvbl2), nil
+		}).
+		After(firstCall).
+		AnyTimes()
+
+	replayClient, err := events.NewTxMsgsReplayClient[*banktypes.MsgSend](
+		ctx, depinject.Supply(eventsQueryClient),
+		txConfig.TxDecoder(),
+	)
+	require.NoError(t, err)
+	t.Cleanup(replayClient.Close)
+
+	amountsCh := make(chan int64, 2)
+	events.ForEachMsg(ctx, replayClient,
+		func(_ context.Context, msg *banktypes.MsgSend) {
+			amountsCh <- msg.Amount.AmountOf("upokt").Int64()
+		},
+	)
+
+	newMsgSend := func(amount int64) *banktypes.MsgSend {
+		return &banktypes.MsgSend{
+			FromAddress: fromAddr,
+			ToAddress:   toAddr,
+			Amount:      cosmostypes.NewCoins(cosmostypes.NewInt64Coin("upokt", amount)),
+		}
+	}
+
+	// Messages received by the first subscription are observed.
+	eventsBzPublishCh1 <- either.Success(testevents.NewTxEventBz(t, txConfig, 1, 0, newMsgSend(1)))
+	select {
+	case amount := <-amountsCh:
+		require.Equal(t, int64(1), amount)
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for the message of the first subscription")
+	}
+
+	// Messages received by the second subscription are observed as well.
+	eventsBzPublishCh1 <- either.Error[[]byte](fmt.Errorf("connection closed"))
+	select {
+	case <-resubscribedCh:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the replay client to re-subscribe")
+	}
+	// Give the replay client time to subscribe to the new events bytes
+	// observable, as values published before then are dropped.
+	time.Sleep(testTimeoutDuration)
+
+	eventsBzPublishCh2 <- either.Success(testevents.NewTxEventBz(t, txConfig, 2, 0, newMsgSend(2)))
+	select {
+	case amount := <-amountsCh:
+		require.Equal(t, int64(2), amount)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the message of the second subscription")
+	}
+}
+
+// newEventsBytesPublishChAndDeps returns a depinject.Config which is supplied
+// with a mock events query client which expects to be queried with the given
+// query, any number of times, along with the publish channel of the events bytes
+// observable which it returns.
+func newEventsBytesPublishChAndDeps(
+	ctx context.Context,
+	t *testing.T,
+	expectedQuery string,
+) (chan<- either.Bytes, depinject.Config) {
+	t.Helper()
+
+	eventsBzObsvbl, eventsBzPublishCh := channel.NewObservable[either.Bytes]()
+
+	ctrl := gomock.NewController(t)
+	eventsQueryClient := mockclient.NewMockEventsQueryClient(ctrl)
+	eventsQueryClient.EXPECT().
+		EventsBytes(gomock.Any(), gomock.Eq(expectedQuery)).
+		Return(client.EventsBytesObservable(eventsBzObsvbl), nil).
+		AnyTimes()
+
+	return eventsBzPublishCh, depinject.Supply(eventsQueryClient)
+}
+
+// newTestEvent decodes a testEvent from the given bytes, returning an error if
+// they don't represent one.
+func newTestEvent(eventBz []byte) (*testEvent, error) {
+	event := new(testEvent)
+	if err := json.Unmarshal(eventBz, event); err != nil {
+		return nil, err
+	}
+	if event.Nonce == 0 {
+		return nil, fmt.Errorf("not a test event: %s", string(eventBz))
+	}
+	return event, nil
+}
@@ -0,0 +1,201 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/depinject"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	comettypes "github.com/cometbft/cometbft/types"
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/pokt-network/poktroll/pkg/client"
+	"github.com/pokt-network/poktroll/pkg/observable"
+)
+
+const (
+	// DefaultTxMsgsReplayBufferSize is the replay buffer size of the events
+	// sequence observables of the tx messages events replay clients.
+	DefaultTxMsgsReplayBufferSize = 100
+	// txMsgActionQueryFmt is the format of the cometbft event subscription query
+	// for committed transactions which contain a message of the given type URL.
+	// (see: https://docs.cosmos.network/v0.47/core/events#subscribing-to-events)
+	txMsgActionQueryFmt = "tm.event='Tx' AND message.action='%s'"
+	// forEachMsgResubscribeInterval is the interval at which ForEachMsg checks
+	// whether the replay client's events sequence observable was replaced.
+	forEachMsgResubscribeInterval = time.Second
+)
+
+// TxMsgsEvent is emitted when a transaction which contains one or more messages
+// of type M is successfully committed on-chain.
+type TxMsgsEvent[M cosmostypes.Msg] struct {
+	// Height is the height of the block which the transaction was committed in.
+	Height int64
+	// TxHash is the hash of the transaction.
+	TxHash []byte
+	// Msgs are the messages of type M which the transaction contains, in the
+	// order they appear in it.
+	Msgs []M
+}
+
+// NewTxMsgsReplayClient creates a new events replay client which is notified
+// when transactions which contain messages of type M are successfully committed
+// on-chain. The given tx decoder is used to decode the committed transactions.
+//
+// Required dependencies:
+//   - client.EventsQueryClient
+func NewTxMsgsReplayClient[M cosmostypes.Msg](
+	ctx context.Context,
+	deps depinject.Config,
+	txDecoder cosmostypes.TxDecoder,
+) (client.EventsReplayClient[*TxMsgsEvent[M]], error) {
+	var msg M
+	query := fmt.Sprintf(txMsgActionQueryFmt, cosmostypes.MsgTypeURL(msg))
+
+	return NewEventsReplayClient[*TxMsgsEvent[M]](
+		ctx,
+		deps,
+		query,
+		newTxMsgsEventFn[M](txDecoder),
+		DefaultTxMsgsReplayBufferSize,
+	)
+}
+
+// newTxMsgsEventFn returns a client.NewEventsFn which decodes tx event message
+// bytes into a TxMsgsEvent of messages of type M. It returns an error if the
+// bytes are not a tx event, if the transaction failed, or if the transaction
+// contains no messages of type M.
+func newTxMsgsEventFn[M cosmostypes.Msg](
+	txDecoder cosmostypes.TxDecoder,
+) client.NewEventsFn[*TxMsgsEvent[M]] {
+	return func(eventBz []byte) (*TxMsgsEvent[M], error) {
+		txEvent, err := unmarshalTxEvent(eventBz)
+		if err != nil {
+			return nil, err
+		}
+
+		// Failed transactions are included in blocks but have no effect.
+		if txEvent.Result.Code != 0 {
+			return nil, ErrTxFailed.Wrapf(
+				"with code %d: %s",
+				txEvent.Result.Code, txEvent.Result.Log,
+			)
+		}
+
+		tx, err := txDecoder(txEvent.Tx)
+		if err != nil {
+			return nil, ErrUnmarshalTxEvent.Wrapf("decoding tx: %s", err)
+		}
+
+		var msgs []M
+		for _, txMsg := range tx.GetMsgs() {
+			if msg, ok := txMsg.(M); ok {
+				msgs = append(msgs, msg)
+			}
+		}
+		if len(msgs) == 0 {
+			return nil, ErrNoMatchingMsgs.Wrapf("%T", *new(M))
+		}
+
+		return &TxMsgsEvent[M]{
+			Height: txEvent.Height,
+			TxHash: comettypes.Tx(txEvent.Tx).Hash(),
+			Msgs:   msgs,
+		}, nil
+	}
+}
+
+// unmarshalTxEvent attempts to deserialize the given events query subscription
+// message bytes (i.e. a JSON-RPC response) into a committed tx event. It returns
+// an ErrUnmarshalTxEvent error if the message isn't one; for example, when it's
+// the response to the subscription request itself.
+func unmarshalTxEvent(eventBz []byte) (*comettypes.EventDataTx, error) {
+	rpcResponse := new(rpctypes.RPCResponse)
+	if err := json.Unmarshal(eventBz, rpcResponse); err != nil {
+		return nil, ErrUnmarshalTxEvent.Wrapf("%s", err)
+	}
+
+	if rpcResponse.Error != nil {
+		return nil, ErrUnmarshalTxEvent.Wrapf("%s", rpcResponse.Error)
+	}
+
+	resultEvent := new(coretypes.ResultEvent)
+	if err := cmtjson.Unmarshal(rpcResponse.Result, resultEvent); err != nil {
+		return nil, ErrUnmarshalTxEvent.Wrapf("%s", err)
+	}
+
+	txEvent, ok := resultEvent.Data.(comettypes.EventDataTx)
+	if !ok {
+		return nil, ErrUnmarshalTxEvent.Wrapf("unexpected event data type %T", resultEvent.Data)
+	}
+
+	return &txEvent, nil
+}
+
+// ForEachMsg calls fn with each message of type M which is received by the
+// given replay client's events sequence, until the context is done. It does
+// not block.
+// The events sequence observable is replaced whenever the replay client
+// re-establishes its events query subscription (e.g. after a connection error),
+// so it is polled every forEachMsgResubscribeInterval and re-subscribed to
+// whenever a new one is active.
+func ForEachMsg[M cosmostypes.Msg](
+	ctx context.Context,
+	replayClient client.EventsReplayClient[*TxMsgsEvent[M]],
+	fn func(ctx context.Context, msg M),
+) {
+	go goForEachMsg(ctx, replayClient, fn)
+}
+
+// goForEachMsg implements ForEachMsg. It is intended to be called in a goroutine.
+func goForEachMsg[M cosmostypes.Msg](
+	ctx context.Context,
+	replayClient client.EventsReplayClient[*TxMsgsEvent[M]],
+	fn func(ctx context.Context, msg M),
+) {
+	var (
+		eventsObsvbl   observable.ReplayObservable[*TxMsgsEvent[M]]
+		eventsObserver observable.Observer[*TxMsgsEvent[M]]
+		eventsCh       <-chan *TxMsgsEvent[M]
+	)
+	defer func() {
+		if eventsObserver != nil {
+			eventsObserver.Unsubscribe()
+		}
+	}()
+
+	resubscribeTicker := time.NewTicker(forEachMsgResubscribeInterval)
+	defer resubscribeTicker.Stop()
+
+	for {
+		// Subscribe to the active events sequence observable if it changed.
+		if activeEventsObsvbl := replayClient.EventsSequence(ctx); activeEventsObsvbl != eventsObsvbl {
+			if eventsObserver != nil {
+				eventsObserver.Unsubscribe()
+			}
+			eventsObsvbl = activeEventsObsvbl
+			eventsObserver = eventsObsvbl.Subscribe(ctx)
+			eventsCh = eventsObserver.Ch()
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-resubscribeTicker.C:
+		case event, ok := <-eventsCh:
+			if !ok {
+				// The events sequence observable closed; wait for the replay
+				// client to replace it.
+				eventsCh = nil
+				continue
+			}
+			for _, msg := range event.Msgs {
+				fn(ctx, msg)
+			}
+		}
+	}
+}
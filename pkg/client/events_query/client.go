@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"go.uber.org/multierr"
 
@@ -16,6 +17,21 @@ import (
 	"github.com/pokt-network/poktroll/pkg/either"
 	"github.com/pokt-network/poktroll/pkg/observable"
 	"github.com/pokt-network/poktroll/pkg/observable/channel"
+	"github.com/pokt-network/poktroll/pkg/polylog"
+)
+
+const (
+	// DefaultReconnectRetryLimit is the default maximum number of consecutive
+	// attempts to re-establish a subscription's connection after it errors,
+	// before the error is propagated to its observers.
+	DefaultReconnectRetryLimit = 10
+	// DefaultReconnectInitialDelay is the default delay before the first attempt
+	// to re-establish a subscription's connection. It doubles with each
+	// subsequent attempt, up to DefaultReconnectMaxDelay.
+	DefaultReconnectInitialDelay = 500 * time.Millisecond
+	// DefaultReconnectMaxDelay is the default maximum delay between attempts to
+	// re-establish a subscription's connection.
+	DefaultReconnectMaxDelay = 30 * time.Second
 )
 
 var _ client.EventsQueryClient = (*eventsQueryClient)(nil)
@@ -42,6 +58,14 @@ type eventsQueryClient struct {
 	// eventsBytesAndConns maps event subscription queries to their respective
 	// eventsBytes observable, connection, and isClosed status.
 	eventsBytesAndConns map[string]*eventsBytesAndConn
+	// reconnectRetryLimit is the maximum number of consecutive attempts to
+	// re-establish a subscription's connection after it errors. If zero, the
+	// error is propagated to the subscription's observers immediately.
+	reconnectRetryLimit int
+	// reconnectInitialDelay is the delay before the first reconnection attempt.
+	reconnectInitialDelay time.Duration
+	// reconnectMaxDelay is the maximum delay between reconnection attempts.
+	reconnectMaxDelay time.Duration
 }
 
 // eventsBytesAndConn is a struct which holds an eventsBytes observable & the
@@ -51,26 +75,67 @@ type eventsBytesAndConn struct {
 	// matching the given query. It receives an either.Bytes which is
 	// either an error or the event message bytes.
 	eventsBytes observable.Observable[either.Bytes]
-	conn        client.Connection
-	isClosed    bool
+	// connMu protects conn and isClosed, as conn is replaced when the
+	// subscription is re-established.
+	connMu   sync.Mutex
+	conn     client.Connection
+	isClosed bool
 }
 
 // Close unsubscribes all observers of eventsBytesAndConn's observable and also
 // closes its connection.
 func (ebc *eventsBytesAndConn) Close() {
 	ebc.eventsBytes.UnsubscribeAll()
+
+	ebc.connMu.Lock()
+	defer ebc.connMu.Unlock()
+
+	ebc.isClosed = true
 	_ = ebc.conn.Close()
 }
 
+// closed returns true if Close has been called.
+func (ebc *eventsBytesAndConn) closed() bool {
+	ebc.connMu.Lock()
+	defer ebc.connMu.Unlock()
+
+	return ebc.isClosed
+}
+
+// replaceConn closes the current connection and replaces it with the given one,
+// unless Close has been called, in which case the given connection is closed
+// instead and false is returned.
+func (ebc *eventsBytesAndConn) replaceConn(conn client.Connection) bool {
+	ebc.connMu.Lock()
+	defer ebc.connMu.Unlock()
+
+	if ebc.isClosed {
+		_ = conn.Close()
+		return false
+	}
+
+	_ = ebc.conn.Close()
+	ebc.conn = conn
+	return true
+}
+
 // NewEventsQueryClient returns a new events query client which is used to
 // subscribe to on-chain events matching the given query.
+// When a subscription's connection errors, the client attempts to re-establish
+// it with an exponential backoff, without interrupting the subscription's
+// observers. Events which occur while disconnected are NOT replayed.
 //
 // Available options:
 //   - WithDialer
+//   - WithReconnectRetryLimit
+//   - WithReconnectBackoff
 func NewEventsQueryClient(cometWebsocketURL string, opts ...client.EventsQueryClientOption) client.EventsQueryClient {
 	evtClient := &eventsQueryClient{
-		cometWebsocketURL:   cometWebsocketURL,
-		eventsBytesAndConns: make(map[string]*eventsBytesAndConn),
+		cometWebsocketURL:     cometWebsocketURL,
+		eventsBytesAndConns:   make(map[string]*eventsBytesAndConn),
+		reconnectRetryLimit:   DefaultReconnectRetryLimit,
+		reconnectInitialDelay: DefaultReconnectInitialDelay,
+		reconnectMaxDelay:     DefaultReconnectMaxDelay,
 	}
 
 	for _, opt := range opts {
@@ -158,16 +223,19 @@ func (eqc *eventsQueryClient) newEventsBytesAndConn(
 	// Construct an eventsBytes for the given query.
 	eventsBzObservable, eventsBzPublishCh := channel.NewObservable[either.Bytes]()
 
+	eventsBzConn := &eventsBytesAndConn{
+		eventsBytes: eventsBzObservable,
+		conn:        conn,
+	}
+
 	// Publish either events bytes or an error received from the connection to
-	// the eventsBz observable.
-	// NB: intentionally not retrying on error, leaving that to the caller.
+	// the eventsBz observable. Connection errors are retried by re-establishing
+	// the subscription until reconnectRetryLimit is reached, after which the
+	// error is published, leaving any further retrying to the caller.
 	// (see: https://github.com/pokt-network/poktroll/pull/64#discussion_r1373826542)
-	go eqc.goPublishEventsBz(ctx, conn, eventsBzPublishCh)
+	go eqc.goPublishEventsBz(ctx, query, eventsBzConn, eventsBzPublishCh)
 
-	return &eventsBytesAndConn{
-		eventsBytes: eventsBzObservable,
-		conn:        conn,
-	}, nil
+	return eventsBzConn, nil
 }
 
 // openEventsBytesAndConn gets a connection using the configured dialer and sends
@@ -199,16 +267,32 @@ func (eqc *eventsQueryClient) openEventsBytesAndConn(
 }
 
 // goPublishEventsBz blocks on reading messages from a websocket connection.
+// If reading fails while the subscription is still open, it attempts to
+// re-establish the connection (see: reconnect) and continues reading from the
+// new one.
 // It is intended to be called from within a go routine.
 func (eqc *eventsQueryClient) goPublishEventsBz(
 	ctx context.Context,
-	conn client.Connection,
+	query string,
+	eventsBzConn *eventsBytesAndConn,
 	eventsBzPublishCh chan<- either.Bytes,
 ) {
+	conn := eventsBzConn.conn
+
 	// Read and handle messages from the websocket. This loop will exit when the
-	// websocket connection is isClosed and/or returns an error.
+	// websocket connection is isClosed and/or returns an error which can't be
+	// recovered from by reconnecting.
 	for {
 		eventBz, err := conn.Receive()
+		if err != nil && eqc.shouldReconnect(ctx, eventsBzConn) {
+			var reconnectErr error
+			conn, reconnectErr = eqc.reconnect(ctx, query, eventsBzConn, err)
+			if reconnectErr == nil {
+				continue
+			}
+			err = reconnectErr
+		}
+
 		if err != nil {
 			// TODO_CONSIDERATION: should we close the publish channel here too?
 
@@ -226,7 +310,7 @@ func (eqc *eventsQueryClient) goPublishEventsBz(
 				eventsBzPublishCh <- either.Error[[]byte](err)
 			}
 
-			eqc.close()
+			eqc.closeQuery(query)
 			return
 		}
 
@@ -235,6 +319,70 @@ func (eqc *eventsQueryClient) goPublishEventsBz(
 	}
 }
 
+// shouldReconnect returns true if reconnection is enabled and the given
+// subscription is still open; i.e. its connection errored unexpectedly.
+func (eqc *eventsQueryClient) shouldReconnect(
+	ctx context.Context,
+	eventsBzConn *eventsBytesAndConn,
+) bool {
+	return eqc.reconnectRetryLimit > 0 &&
+		ctx.Err() == nil &&
+		!eventsBzConn.closed()
+}
+
+// reconnect attempts to re-establish the given subscription's connection after
+// it failed with receiveErr, waiting an exponentially increasing delay before
+// each attempt, up to reconnectRetryLimit attempts. It returns the new connection
+// or an error if all attempts failed, the context is done, or the subscription
+// was closed in the meantime.
+func (eqc *eventsQueryClient) reconnect(
+	ctx context.Context,
+	query string,
+	eventsBzConn *eventsBytesAndConn,
+	receiveErr error,
+) (client.Connection, error) {
+	logger := polylog.Ctx(ctx).With("query", query)
+
+	var (
+		retryDelay = eqc.reconnectInitialDelay
+		dialErr    error
+	)
+	for attempt := 1; attempt <= eqc.reconnectRetryLimit; attempt++ {
+		logger.Warn().
+			Err(receiveErr).
+			Int("attempt", attempt).
+			Dur("delay", retryDelay).
+			Msg("events query subscription errored; reconnecting")
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(retryDelay):
+		}
+
+		var conn client.Connection
+		conn, dialErr = eqc.openEventsBytesAndConn(ctx, query)
+		if dialErr == nil {
+			if !eventsBzConn.replaceConn(conn) {
+				return nil, ErrConnClosed
+			}
+
+			logger.Info().Int("attempt", attempt).Msg("events query subscription reconnected")
+			return conn, nil
+		}
+
+		receiveErr = dialErr
+		retryDelay *= 2
+		if retryDelay > eqc.reconnectMaxDelay {
+			retryDelay = eqc.reconnectMaxDelay
+		}
+	}
+
+	return nil, ErrReconnect.Wrapf(
+		"after %d attempts: %s", eqc.reconnectRetryLimit, dialErr,
+	)
+}
+
 // goUnsubscribeOnDone unsubscribes from the subscription when the context is done.
 // It is intended to be called  in a goroutine.
 func (eqc *eventsQueryClient) goUnsubscribeOnDone(
@@ -244,8 +392,14 @@ func (eqc *eventsQueryClient) goUnsubscribeOnDone(
 	// Wait for the context to be done.
 	<-ctx.Done()
 	// Only close the eventsBytes for the given query.
-	eqc.eventsBytesAndConnsMu.RLock()
-	defer eqc.eventsBytesAndConnsMu.RUnlock()
+	eqc.closeQuery(query)
+}
+
+// closeQuery unsubscribes all observers of the given query's eventsBytes
+// observable, closes its connection, and removes it.
+func (eqc *eventsQueryClient) closeQuery(query string) {
+	eqc.eventsBytesAndConnsMu.Lock()
+	defer eqc.eventsBytesAndConnsMu.Unlock()
 
 	if eventsBzConn, ok := eqc.eventsBytesAndConns[query]; ok {
 		// Unsubscribe all observers of the given query's eventsBzConn's observable
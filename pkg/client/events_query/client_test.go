@@ -265,7 +265,9 @@ func TestEventsQueryClient_Subscribe_ReceiveError(t *testing.T) {
 		MinTimes(handleEventLimit)
 
 	dialerOpt := eventsquery.WithDialer(dialerMock)
-	queryClient := eventsquery.NewEventsQueryClient("", dialerOpt)
+	// Disable reconnection such that the receive error is propagated.
+	reconnectOpt := eventsquery.WithReconnectRetryLimit(0)
+	queryClient := eventsquery.NewEventsQueryClient("", dialerOpt, reconnectOpt)
 
 	// set up query observer
 	eventsObservable, err := queryClient.EventsBytes(ctx, testQuery(0))
@@ -282,6 +284,107 @@ func TestEventsQueryClient_Subscribe_ReceiveError(t *testing.T) {
 	)
 }
 
+func TestEventsQueryClient_Subscribe_Reconnects(t *testing.T) {
+	var (
+		// eventsPerConn is the number of events which are received from the
+		// first connection before it errors.
+		eventsPerConn        = 5
+		readAllEventsTimeout = time.Second
+		readEventCounter     int
+		// delayFirstEvent delays the first event published by the first mocked
+		// connection to give the test ample time to subscribe to the events bytes
+		// observable before it starts receiving events.
+		delayFirstEvent     sync.Once
+		secondConnClosed    atomic.Bool
+		ctrl                = gomock.NewController(t)
+		ctx, cancelQuery    = context.WithCancel(context.Background())
+		dialCounter         int
+		firstConnMock       = mockclient.NewMockConnection(ctrl)
+		secondConnMock      = mockclient.NewMockConnection(ctrl)
+		connMocksByDialIdx  = []*mockclient.MockConnection{firstConnMock, secondConnMock}
+		reconnectBackoffOpt = eventsquery.WithReconnectBackoff(time.Millisecond, time.Millisecond)
+	)
+	t.Cleanup(cancelQuery)
+
+	// The first connection errors after eventsPerConn events and is closed when
+	// it is replaced by the second connection.
+	firstConnMock.EXPECT().Send(gomock.Any()).Return(nil).Times(1)
+	firstConnMock.EXPECT().Receive().
+		DoAndReturn(func() (any, error) {
+			delayFirstEvent.Do(func() { time.Sleep(50 * time.Millisecond) })
+
+			if readEventCounter >= eventsPerConn {
+				return nil, websocket.ErrReceive
+			}
+
+			event := testEvent(int32(readEventCounter))
+			readEventCounter++
+			return event, nil
+		}).
+		Times(eventsPerConn + 1)
+	firstConnMock.EXPECT().Close().Return(nil).Times(1)
+
+	// The second connection continues where the first one left off until it is
+	// closed when the subscription's context is cancelled.
+	secondConnMock.EXPECT().Send(gomock.Any()).Return(nil).Times(1)
+	secondConnMock.EXPECT().Receive().
+		DoAndReturn(func() (any, error) {
+			if secondConnClosed.Load() {
+				return nil, eventsquery.ErrConnClosed
+			}
+
+			event := testEvent(int32(readEventCounter))
+			readEventCounter++
+
+			// Simulate IO delay between sequential events.
+			time.Sleep(10 * time.Microsecond)
+
+			return event, nil
+		}).
+		MinTimes(eventsPerConn)
+	secondConnMock.EXPECT().Close().
+		DoAndReturn(func() error {
+			secondConnClosed.CompareAndSwap(false, true)
+			return nil
+		}).
+		Times(1)
+
+	dialerMock := mockclient.NewMockDialer(ctrl)
+	dialerMock.EXPECT().DialContext(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string) (*mockclient.MockConnection, error) {
+			connMock := connMocksByDialIdx[dialCounter]
+			dialCounter++
+			return connMock, nil
+		}).
+		Times(2)
+
+	dialerOpt := eventsquery.WithDialer(dialerMock)
+	queryClient := eventsquery.NewEventsQueryClient("", dialerOpt, reconnectBackoffOpt)
+
+	eventsObservable, err := queryClient.EventsBytes(ctx, testQuery(0))
+	require.NoError(t, err)
+
+	eventsObserver := eventsObservable.Subscribe(ctx)
+
+	onLimit := func() {
+		// Cancelling the context should close the second connection.
+		cancelQuery()
+		// Closing the connection happens asynchronously, so we need to wait a bit
+		// for the connection to close to satisfy the connection mock expectations.
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// Assert that the observer receives the events from both connections,
+	// contiguously and without receiving the first connection's error.
+	behavesLikeEitherObserver(
+		t, eventsObserver,
+		2*eventsPerConn,
+		nil,
+		readAllEventsTimeout,
+		onLimit,
+	)
+}
+
 // TODO_TECHDEBT: add test coverage for multiple observers with distinct and overlapping queries
 func TestEventsQueryClient_EventsBytes_MultipleObservers(t *testing.T) {
 	t.Skip("TODO_TECHDEBT: add test coverage for multiple observers with distinct and overlapping queries")
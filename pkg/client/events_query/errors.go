@@ -6,6 +6,7 @@ var (
 	ErrDial       = errorsmod.Register(codespace, 1, "dialing for connection failed")
 	ErrConnClosed = errorsmod.Register(codespace, 2, "connection closed")
 	ErrSubscribe  = errorsmod.Register(codespace, 3, "failed to subscribe to events")
+	ErrReconnect  = errorsmod.Register(codespace, 4, "failed to re-establish events subscription")
 
 	codespace = "events_query_client"
 )
@@ -1,6 +1,10 @@
 package eventsquery
 
-import "github.com/pokt-network/poktroll/pkg/client"
+import (
+	"time"
+
+	"github.com/pokt-network/poktroll/pkg/client"
+)
 
 // WithDialer returns a client.EventsQueryClientOption which sets the given dialer on the
 // resulting eventsQueryClient when passed to NewEventsQueryClient().
@@ -9,3 +13,23 @@ func WithDialer(dialer client.Dialer) client.EventsQueryClientOption {
 		evtClient.(*eventsQueryClient).dialer = dialer
 	}
 }
+
+// WithReconnectRetryLimit returns a client.EventsQueryClientOption which sets the
+// maximum number of consecutive attempts to re-establish a subscription's
+// connection after it errors. A limit of zero disables reconnection, such that
+// connection errors are propagated to the subscription's observers immediately.
+func WithReconnectRetryLimit(limit int) client.EventsQueryClientOption {
+	return func(evtClient client.EventsQueryClient) {
+		evtClient.(*eventsQueryClient).reconnectRetryLimit = limit
+	}
+}
+
+// WithReconnectBackoff returns a client.EventsQueryClientOption which sets the
+// delay before the first attempt to re-establish a subscription's connection
+// and the maximum delay between attempts, as the delay doubles with each attempt.
+func WithReconnectBackoff(initialDelay, maxDelay time.Duration) client.EventsQueryClientOption {
+	return func(evtClient client.EventsQueryClient) {
+		evtClient.(*eventsQueryClient).reconnectInitialDelay = initialDelay
+		evtClient.(*eventsQueryClient).reconnectMaxDelay = maxDelay
+	}
+}
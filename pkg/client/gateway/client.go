@@ -0,0 +1,108 @@
+package gateway
+
+import (
+	"context"
+
+	"cosmossdk.io/depinject"
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/pokt-network/poktroll/pkg/client"
+	"github.com/pokt-network/poktroll/pkg/client/keyring"
+	gatewaytypes "github.com/pokt-network/poktroll/x/gateway/types"
+)
+
+var _ client.GatewayClient = (*gatewayClient)(nil)
+
+// gatewayClient implements the GatewayClient interface by signing and
+// broadcasting gateway messages, authored by the configured signing key, via a
+// TxClient.
+type gatewayClient struct {
+	signingKeyName string
+	signingKeyAddr cosmostypes.AccAddress
+
+	txClient client.TxClient
+	txCtx    client.TxContext
+}
+
+// NewGatewayClient constructs a new GatewayClient with the given dependencies
+// and options. If a signingKeyName is not configured, an error will be returned.
+//
+// Required dependencies:
+//   - client.TxClient
+//   - client.TxContext
+//
+// Available options:
+//   - WithSigningKeyName
+func NewGatewayClient(
+	deps depinject.Config,
+	opts ...client.GatewayClientOption,
+) (*gatewayClient, error) {
+	gClient := &gatewayClient{}
+
+	if err := depinject.Inject(
+		deps,
+		&gClient.txClient,
+		&gClient.txCtx,
+	); err != nil {
+		return nil, err
+	}
+
+	for _, opt := range opts {
+		opt(gClient)
+	}
+
+	if err := gClient.validateConfigAndSetDefaults(); err != nil {
+		return nil, err
+	}
+
+	return gClient, nil
+}
+
+// Stake constructs a stake gateway message then signs and broadcasts it to the
+// network via #txClient. It blocks until the transaction is included in a block
+// or times out.
+func (gClient *gatewayClient) Stake(ctx context.Context, stake cosmostypes.Coin) error {
+	msg := gatewaytypes.NewMsgStakeGateway(gClient.signingKeyAddr.String(), stake)
+	return gClient.signAndBroadcast(ctx, msg)
+}
+
+// Unstake constructs an unstake gateway message then signs and broadcasts it to
+// the network via #txClient. It blocks until the transaction is included in a
+// block or times out.
+func (gClient *gatewayClient) Unstake(ctx context.Context) error {
+	msg := gatewaytypes.NewMsgUnstakeGateway(gClient.signingKeyAddr.String())
+	return gClient.signAndBroadcast(ctx, msg)
+}
+
+// signAndBroadcast signs and broadcasts the given message via #txClient and
+// blocks until the transaction is included in a block or times out.
+func (gClient *gatewayClient) signAndBroadcast(
+	ctx context.Context,
+	msg cosmostypes.Msg,
+) error {
+	eitherErr := gClient.txClient.SignAndBroadcast(ctx, msg)
+	err, errCh := eitherErr.SyncOrAsyncError()
+	if err != nil {
+		return err
+	}
+
+	return <-errCh
+}
+
+// validateConfigAndSetDefaults attempts to get the address from the keyring
+// corresponding to the key whose name matches the configured signingKeyName.
+// If signingKeyName is empty or the keyring does not contain the corresponding
+// key, an error is returned.
+func (gClient *gatewayClient) validateConfigAndSetDefaults() error {
+	signingAddr, err := keyring.KeyNameToAddr(
+		gClient.signingKeyName,
+		gClient.txCtx.GetKeyring(),
+	)
+	if err != nil {
+		return err
+	}
+
+	gClient.signingKeyAddr = signingAddr
+
+	return nil
+}
@@ -0,0 +1,136 @@
+package gateway_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cosmossdk.io/depinject"
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/pkg/client"
+	"github.com/pokt-network/poktroll/pkg/client/gateway"
+	"github.com/pokt-network/poktroll/pkg/client/keyring"
+	"github.com/pokt-network/poktroll/testutil/mockclient"
+	"github.com/pokt-network/poktroll/testutil/testclient/testkeyring"
+	"github.com/pokt-network/poktroll/testutil/testclient/testtx"
+)
+
+var testSigningKeyName = "test_signer"
+
+func TestNewGatewayClient(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	memKeyring, _ := testkeyring.NewTestKeyringWithKey(t, testSigningKeyName)
+	txCtxMock, _ := testtx.NewAnyTimesTxTxContext(t, memKeyring)
+	txClientMock := mockclient.NewMockTxClient(ctrl)
+
+	deps := depinject.Supply(
+		txCtxMock,
+		txClientMock,
+	)
+
+	tests := []struct {
+		name           string
+		signingKeyName string
+		expectedErr    error
+	}{
+		{
+			name:           "valid signing key name",
+			signingKeyName: testSigningKeyName,
+			expectedErr:    nil,
+		},
+		{
+			name:           "empty signing key name",
+			signingKeyName: "",
+			expectedErr:    keyring.ErrEmptySigningKeyName,
+		},
+		{
+			name:           "no such signing key name",
+			signingKeyName: "nonexistent",
+			expectedErr:    keyring.ErrNoSuchSigningKey,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signingKeyOpt := gateway.WithSigningKeyName(tt.signingKeyName)
+
+			gatewayClient, err := gateway.NewGatewayClient(deps, signingKeyOpt)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				require.Nil(t, gatewayClient)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, gatewayClient)
+			}
+		})
+	}
+}
+
+func TestGatewayClient_Msgs(t *testing.T) {
+	tests := []struct {
+		name string
+		send func(ctx context.Context, gatewayClient client.GatewayClient) error
+	}{
+		{
+			name: "Stake",
+			send: func(ctx context.Context, gatewayClient client.GatewayClient) error {
+				return gatewayClient.Stake(ctx, cosmostypes.NewInt64Coin("upokt", 1000))
+			},
+		},
+		{
+			name: "Unstake",
+			send: func(ctx context.Context, gatewayClient client.GatewayClient) error {
+				return gatewayClient.Unstake(ctx)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				signAndBroadcastDelay = 50 * time.Millisecond
+				doneCh                = make(chan struct{}, 1)
+				ctx                   = context.Background()
+			)
+
+			keyring, testGatewayKey := testkeyring.NewTestKeyringWithKey(t, testSigningKeyName)
+
+			txCtxMock, _ := testtx.NewAnyTimesTxTxContext(t, keyring)
+			txClientMock := testtx.NewOneTimeDelayedSignAndBroadcastTxClient(t, signAndBroadcastDelay)
+
+			signingKeyOpt := gateway.WithSigningKeyName(testGatewayKey.Name)
+			deps := depinject.Supply(
+				txCtxMock,
+				txClientMock,
+			)
+
+			gatewayClient, err := gateway.NewGatewayClient(deps, signingKeyOpt)
+			require.NoError(t, err)
+			require.NotNil(t, gatewayClient)
+
+			go func() {
+				err = tt.send(ctx, gatewayClient)
+				require.NoError(t, err)
+				close(doneCh)
+			}()
+
+			select {
+			case <-doneCh:
+				t.Fatalf("expected %s to block for signAndBroadcastDelay", tt.name)
+			case <-time.After(signAndBroadcastDelay * 95 / 100):
+				t.Logf("OK: %s blocked for at least 95%% of signAndBroadcastDelay", tt.name)
+			}
+
+			select {
+			case <-time.After(signAndBroadcastDelay):
+				t.Fatalf("expected %s to unblock after signAndBroadcastDelay", tt.name)
+			case <-doneCh:
+				t.Logf("OK: %s unblocked after signAndBroadcastDelay", tt.name)
+			}
+		})
+	}
+}
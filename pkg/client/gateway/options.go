@@ -0,0 +1,13 @@
+package gateway
+
+import (
+	"github.com/pokt-network/poktroll/pkg/client"
+)
+
+// WithSigningKeyName sets the name of the key which the gateway client should
+// retrieve from the keyring to use for authoring and signing its messages.
+func WithSigningKeyName(keyName string) client.GatewayClientOption {
+	return func(gClient client.GatewayClient) {
+		gClient.(*gatewayClient).signingKeyName = keyName
+	}
+}
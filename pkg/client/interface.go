@@ -1,7 +1,10 @@
 //go:generate mockgen -destination=../../testutil/mockclient/events_query_client_mock.go -package=mockclient . Dialer,Connection,EventsQueryClient
-//go:generate mockgen -destination=../../testutil/mockclient/block_client_mock.go -package=mockclient . Block,BlockClient
+//go:generate mockgen -destination=../../testutil/mockclient/block_client_mock.go -package=mockclient . Block,BlockClient,BlockQueryClient
 //go:generate mockgen -destination=../../testutil/mockclient/tx_client_mock.go -package=mockclient . TxContext,TxClient
 //go:generate mockgen -destination=../../testutil/mockclient/supplier_client_mock.go -package=mockclient . SupplierClient
+//go:generate mockgen -destination=../../testutil/mockclient/application_client_mock.go -package=mockclient . ApplicationClient
+//go:generate mockgen -destination=../../testutil/mockclient/gateway_client_mock.go -package=mockclient . GatewayClient
+//go:generate mockgen -destination=../../testutil/mockclient/query_client_mock.go -package=mockclient . AccountQuerier,ApplicationQuerier,SupplierQuerier,SessionQuerier
 //go:generate mockgen -destination=../../testutil/mockclient/cosmos_tx_builder_mock.go -package=mockclient github.com/cosmos/cosmos-sdk/client TxBuilder
 //go:generate mockgen -destination=../../testutil/mockclient/cosmos_keyring_mock.go -package=mockclient github.com/cosmos/cosmos-sdk/crypto/keyring Keyring
 //go:generate mockgen -destination=../../testutil/mockclient/cosmos_client_mock.go -package=mockclient github.com/cosmos/cosmos-sdk/client AccountRetriever
@@ -14,12 +17,16 @@ import (
 	comettypes "github.com/cometbft/cometbft/rpc/core/types"
 	cosmosclient "github.com/cosmos/cosmos-sdk/client"
 	cosmoskeyring "github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+	accounttypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/pokt-network/smt"
 
 	"github.com/pokt-network/poktroll/pkg/either"
 	"github.com/pokt-network/poktroll/pkg/observable"
+	apptypes "github.com/pokt-network/poktroll/x/application/types"
 	sessiontypes "github.com/pokt-network/poktroll/x/session/types"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
 )
 
 // SupplierClient is an interface for sufficient for a supplier operator to be
@@ -45,6 +52,42 @@ type SupplierClient interface {
 	) error
 }
 
+// ApplicationClient is an interface sufficient for an application to be able
+// to construct blockchain transactions from pocket protocol-specific messages
+// related to its role.
+type ApplicationClient interface {
+	// Stake sends a stake application message which stakes the calling
+	// application with the given amount for the given services, or updates its
+	// stake and services if it is already staked.
+	Stake(
+		ctx context.Context,
+		stake cosmostypes.Coin,
+		serviceConfigs []*sharedtypes.ApplicationServiceConfig,
+	) error
+	// Unstake sends an unstake application message which unstakes the calling
+	// application.
+	Unstake(ctx context.Context) error
+	// DelegateToGateway sends a delegate to gateway message which delegates the
+	// calling application to the gateway with the given address, such that the
+	// latter can sign relay requests on its behalf.
+	DelegateToGateway(ctx context.Context, gatewayAddress string) error
+	// UndelegateFromGateway sends an undelegate from gateway message which
+	// revokes the calling application's delegation to the gateway with the
+	// given address.
+	UndelegateFromGateway(ctx context.Context, gatewayAddress string) error
+}
+
+// GatewayClient is an interface sufficient for a gateway operator to be able
+// to construct blockchain transactions from pocket protocol-specific messages
+// related to its role.
+type GatewayClient interface {
+	// Stake sends a stake gateway message which stakes the calling gateway with
+	// the given amount, or updates its stake if it is already staked.
+	Stake(ctx context.Context, stake cosmostypes.Coin) error
+	// Unstake sends an unstake gateway message which unstakes the calling gateway.
+	Unstake(ctx context.Context) error
+}
+
 // TxClient provides a synchronous interface initiating and waiting for transactions
 // derived from cosmos-sdk messages, in a cosmos-sdk based blockchain network.
 type TxClient interface {
@@ -71,17 +114,35 @@ type TxContext interface {
 	// NewTxBuilder creates and returns a new transaction builder instance.
 	NewTxBuilder() cosmosclient.TxBuilder
 
-	// SignTx signs a transaction using the specified key name. It can operate in offline mode,
-	// and can overwrite any existing signatures based on the provided flags.
+	// GetAccountNumberSequence returns the account number and sequence of the
+	// account with the given address, as of the latest committed state.
+	GetAccountNumberSequence(
+		addr cosmostypes.AccAddress,
+	) (accountNumber, sequence uint64, err error)
+
+	// SignTx signs a transaction using the specified key name, account number and
+	// sequence; it does not query the network for the latter. It can overwrite any
+	// existing signatures based on the provided flag.
 	SignTx(
 		keyName string,
 		txBuilder cosmosclient.TxBuilder,
-		offline, overwriteSig bool,
+		accountNumber, sequence uint64,
+		overwriteSig bool,
 	) error
 
 	// EncodeTx takes a transaction builder and encodes it, returning its byte representation.
 	EncodeTx(txBuilder cosmosclient.TxBuilder) ([]byte, error)
 
+	// Simulate simulates the execution of a transaction containing the given
+	// messages, as if it were signed by the key with the given name using the
+	// given sequence, and returns the amount of gas it consumed.
+	Simulate(
+		ctx context.Context,
+		signingKeyName string,
+		sequence uint64,
+		msgs ...cosmostypes.Msg,
+	) (gasUsed uint64, err error)
+
 	// BroadcastTx broadcasts the given transaction to the network.
 	BroadcastTx(txBytes []byte) (*cosmostypes.TxResponse, error)
 
@@ -94,6 +155,53 @@ type TxContext interface {
 	) (*comettypes.ResultTx, error)
 }
 
+// AccountQuerier is used to query for accounts via some blockchain API.
+type AccountQuerier interface {
+	// GetAccount returns the account with the given address, as of the latest
+	// committed state.
+	GetAccount(ctx context.Context, address string) (accounttypes.AccountI, error)
+	// GetPubKeyFromAddress returns the public key of the account with the given
+	// address. As an account's public key never changes once it is set, it is
+	// only queried the first time it is needed.
+	GetPubKeyFromAddress(ctx context.Context, address string) (cryptotypes.PubKey, error)
+}
+
+// ApplicationQuerier is used to query for applications via some blockchain API.
+// Applications are cached until a transaction which changes them (e.g. staking,
+// unstaking or delegating) is committed.
+type ApplicationQuerier interface {
+	// GetApplication returns the application with the given address.
+	GetApplication(ctx context.Context, appAddress string) (apptypes.Application, error)
+	// GetApplicationRing returns the addresses which make up the ring of the
+	// application with the given address, as of the session of the given block
+	// height; i.e. the application's address followed by the addresses of the
+	// gateways it was delegated to during that session. As delegation changes
+	// only take effect at the start of the next session, the ring of a session
+	// is only queried the first time it is needed.
+	GetApplicationRing(ctx context.Context, appAddress string, blockHeight int64) ([]string, error)
+}
+
+// SupplierQuerier is used to query for suppliers via some blockchain API.
+// Suppliers are cached until a transaction which changes them (e.g. staking or
+// unstaking) is committed.
+type SupplierQuerier interface {
+	// GetSupplier returns the supplier with the given address.
+	GetSupplier(ctx context.Context, supplierAddress string) (sharedtypes.Supplier, error)
+}
+
+// SessionQuerier is used to query for sessions via some blockchain API.
+// Sessions are cached until a block past their end is committed.
+type SessionQuerier interface {
+	// GetSession returns the session of the given application and service which
+	// the given block height is part of.
+	GetSession(
+		ctx context.Context,
+		appAddress string,
+		serviceId string,
+		blockHeight int64,
+	) (*sessiontypes.Session, error)
+}
+
 // BlocksObservable is an observable which is notified with an either
 // value which contains either an error or the event message bytes.
 //
@@ -114,6 +222,14 @@ type BlockClient interface {
 	Close()
 }
 
+// BlockQueryClient is used to query for committed blocks by height via some
+// blockchain API. It is satisfied by cometbft's RPC clients (e.g. rpc/client/http).
+type BlockQueryClient interface {
+	// Block returns the block at the given height, or the latest block if height
+	// is nil.
+	Block(ctx context.Context, height *int64) (*comettypes.ResultBlock, error)
+}
+
 // Block is an interface which abstracts the details of a block to its minimal
 // necessary components.
 type Block interface {
@@ -121,6 +237,29 @@ type Block interface {
 	Hash() []byte
 }
 
+// EventsReplayClient is an interface which provides notifications about newly
+// received on-chain events of type T, which match some query, as well as direct
+// access to the latest ones via some blockchain API.
+//
+// NB: unlike the other client interfaces, no mock is generated for this one due
+// to gomock's lack of support for generic types.
+type EventsReplayClient[T any] interface {
+	// EventsSequence returns a replay observable which emits newly received
+	// events of type T.
+	EventsSequence(context.Context) observable.ReplayObservable[T]
+	// LastNEvents returns the latest n events that have been received,
+	// blocking until at least one has been.
+	LastNEvents(ctx context.Context, n int) []T
+	// Close closes the corresponding events query subscription, after which
+	// no more events are received.
+	Close()
+}
+
+// NewEventsFn is a function which decodes event message bytes into an event of
+// type T. It returns an error if the bytes don't represent such an event (e.g.
+// they are a subscription response or an event of a different type).
+type NewEventsFn[T any] func(eventBz []byte) (T, error)
+
 // EventsBytesObservable is an observable which is notified with an either
 // value which contains either an error or the event message bytes.
 //
@@ -172,8 +311,17 @@ type Dialer interface {
 // EventsQueryClientOption defines a function type that modifies the EventsQueryClient.
 type EventsQueryClientOption func(EventsQueryClient)
 
+// BlockClientOption defines a function type that modifies the BlockClient.
+type BlockClientOption func(BlockClient)
+
 // TxClientOption defines a function type that modifies the TxClient.
 type TxClientOption func(TxClient)
 
 // SupplierClientOption defines a function type that modifies the SupplierClient.
 type SupplierClientOption func(SupplierClient)
+
+// ApplicationClientOption defines a function type that modifies the ApplicationClient.
+type ApplicationClientOption func(ApplicationClient)
+
+// GatewayClientOption defines a function type that modifies the GatewayClient.
+type GatewayClientOption func(GatewayClient)
@@ -0,0 +1,109 @@
+package query
+
+import (
+	"context"
+
+	"cosmossdk.io/depinject"
+	cosmosclient "github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	accounttypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	lru "github.com/hashicorp/golang-lru/v2"
+
+	"github.com/pokt-network/poktroll/pkg/client"
+)
+
+var _ client.AccountQuerier = (*accQuerier)(nil)
+
+// accQuerier is a wrapper around the accounttypes.QueryClient that enables the
+// querying of on-chain account information through a single exposed method
+// which returns an accounttypes.AccountI interface, and caches the public keys
+// of the accounts it has queried.
+type accQuerier struct {
+	clientCtx      cosmosclient.Context
+	accountQuerier accounttypes.QueryClient
+	// cdc is used to unpack the queried accounts.
+	cdc *codec.ProtoCodec
+	// pubKeyCache caches the public keys of accounts by address. As an account's
+	// public key never changes once set, entries are only evicted to bound it.
+	pubKeyCache *lru.Cache[string, cryptotypes.PubKey]
+}
+
+// NewAccountQuerier returns a new instance of a client.AccountQuerier by
+// injecting the dependecies provided by the depinject.Config.
+//
+// Required dependencies:
+// - cosmosclient.Context
+func NewAccountQuerier(deps depinject.Config) (client.AccountQuerier, error) {
+	aq := &accQuerier{}
+
+	if err := depinject.Inject(
+		deps,
+		&aq.clientCtx,
+	); err != nil {
+		return nil, err
+	}
+
+	pubKeyCache, err := newCache[cryptotypes.PubKey](DefaultCacheSize)
+	if err != nil {
+		return nil, err
+	}
+
+	reg := codectypes.NewInterfaceRegistry()
+	accounttypes.RegisterInterfaces(reg)
+	cryptocodec.RegisterInterfaces(reg)
+
+	aq.cdc = codec.NewProtoCodec(reg)
+	aq.pubKeyCache = pubKeyCache
+	aq.accountQuerier = accounttypes.NewQueryClient(aq.clientCtx)
+
+	return aq, nil
+}
+
+// GetAccount returns an accounttypes.AccountI interface for a given address.
+func (aq *accQuerier) GetAccount(
+	ctx context.Context,
+	address string,
+) (accounttypes.AccountI, error) {
+	req := &accounttypes.QueryAccountRequest{Address: address}
+	res, err := aq.accountQuerier.Account(ctx, req)
+	if err != nil {
+		return nil, ErrQueryAccountNotFound.Wrapf("address: %s [%v]", address, err)
+	}
+
+	var acc accounttypes.AccountI
+	if err = aq.cdc.UnpackAny(res.Account, &acc); err != nil {
+		return nil, ErrQueryUnableToDeserializeAccount.Wrapf("address: %s [%v]", address, err)
+	}
+
+	return acc, nil
+}
+
+// GetPubKeyFromAddress returns the public key of the account with the given
+// address, querying for the account only if its public key isn't cached. Public
+// keys are only cached once set, as accounts don't have one until they have
+// signed a transaction.
+func (aq *accQuerier) GetPubKeyFromAddress(
+	ctx context.Context,
+	address string,
+) (cryptotypes.PubKey, error) {
+	if pubKey, ok := aq.pubKeyCache.Get(address); ok {
+		return pubKey, nil
+	}
+
+	acc, err := aq.GetAccount(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	pubKey := acc.GetPubKey()
+	if pubKey == nil {
+		return nil, ErrQueryPubKeyNotFound.Wrapf("address: %s", address)
+	}
+
+	aq.pubKeyCache.Add(address, pubKey)
+
+	return pubKey, nil
+}
@@ -0,0 +1,215 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/depinject"
+	cosmosclient "github.com/cosmos/cosmos-sdk/client"
+	lru "github.com/hashicorp/golang-lru/v2"
+
+	"github.com/pokt-network/poktroll/pkg/client"
+	"github.com/pokt-network/poktroll/pkg/client/events"
+	apptypes "github.com/pokt-network/poktroll/x/application/types"
+	sharedhelpers "github.com/pokt-network/poktroll/x/shared/helpers"
+)
+
+var _ client.ApplicationQuerier = (*appQuerier)(nil)
+
+// appQuerier is a wrapper around the apptypes.QueryClient that enables the
+// querying of on-chain application information through a single exposed method
+// which returns an apptypes.Application struct, and caches the applications it
+// has queried until they change on-chain, as well as the rings of applications
+// per session.
+type appQuerier struct {
+	clientCtx          cosmosclient.Context
+	eventsQueryClient  client.EventsQueryClient
+	applicationQuerier apptypes.QueryClient
+	// appCache caches applications by address. Entries are evicted when a
+	// transaction which changes the corresponding application is committed.
+	appCache *lru.Cache[string, apptypes.Application]
+	// ringCache caches the ring addresses of applications by ringCacheKey. As
+	// the ring of a session doesn't change once it has started, entries are
+	// only evicted when the corresponding application is (un)staked.
+	ringCache *lru.Cache[string, []string]
+}
+
+// NewApplicationQuerier returns a new instance of a client.ApplicationQuerier
+// by injecting the dependecies provided by the depinject.Config. It subscribes
+// to the on-chain events which change applications (i.e. staking, unstaking,
+// delegating to and undelegating from gateways) in order to evict them from its
+// cache until the given context is done.
+//
+// Required dependencies:
+// - cosmosclient.Context
+// - client.EventsQueryClient
+func NewApplicationQuerier(
+	ctx context.Context,
+	deps depinject.Config,
+) (client.ApplicationQuerier, error) {
+	aq := &appQuerier{}
+
+	if err := depinject.Inject(
+		deps,
+		&aq.clientCtx,
+		&aq.eventsQueryClient,
+	); err != nil {
+		return nil, err
+	}
+
+	appCache, err := newCache[apptypes.Application](DefaultCacheSize)
+	if err != nil {
+		return nil, err
+	}
+
+	ringCache, err := newCache[[]string](DefaultCacheSize)
+	if err != nil {
+		return nil, err
+	}
+
+	aq.appCache = appCache
+	aq.ringCache = ringCache
+	aq.applicationQuerier = apptypes.NewQueryClient(aq.clientCtx)
+
+	if err := aq.evictAppsOnChanges(ctx); err != nil {
+		return nil, err
+	}
+
+	return aq, nil
+}
+
+// GetApplication returns an apptypes.Application struct for a given address,
+// querying for it only if it isn't cached.
+func (aq *appQuerier) GetApplication(
+	ctx context.Context,
+	appAddress string,
+) (apptypes.Application, error) {
+	if app, ok := aq.appCache.Get(appAddress); ok {
+		return app, nil
+	}
+
+	req := apptypes.QueryGetApplicationRequest{Address: appAddress}
+	res, err := aq.applicationQuerier.Application(ctx, &req)
+	if err != nil {
+		return apptypes.Application{}, ErrQueryRetrieveApplication.Wrapf(
+			"address: %s [%v]", appAddress, err,
+		)
+	}
+
+	aq.appCache.Add(appAddress, res.Application)
+
+	return res.Application, nil
+}
+
+// GetApplicationRing returns the ring addresses of the application with the
+// given address as of the session of the given block height, querying for them
+// only if they aren't cached.
+func (aq *appQuerier) GetApplicationRing(
+	ctx context.Context,
+	appAddress string,
+	blockHeight int64,
+) ([]string, error) {
+	if blockHeight < 0 {
+		return nil, ErrQueryRetrieveApplicationRing.Wrapf(
+			"address: %s; invalid block height: %d", appAddress, blockHeight,
+		)
+	}
+
+	key := ringCacheKey(appAddress, blockHeight)
+	if ringAddresses, ok := aq.ringCache.Get(key); ok {
+		return ringAddresses, nil
+	}
+
+	req := apptypes.NewQueryGetApplicationRingRequest(appAddress, getRingQueryHeight(blockHeight))
+	res, err := aq.applicationQuerier.ApplicationRing(ctx, req)
+	if err != nil {
+		return nil, ErrQueryRetrieveApplicationRing.Wrapf(
+			"address: %s; block height: %d [%v]", appAddress, blockHeight, err,
+		)
+	}
+
+	aq.ringCache.Add(key, res.RingAddresses)
+
+	return res.RingAddresses, nil
+}
+
+// evictAppsOnChanges subscribes to the on-chain events which change an
+// application and evicts the corresponding application from the cache whenever
+// one is committed, such that it is re-queried the next time it is needed.
+func (aq *appQuerier) evictAppsOnChanges(ctx context.Context) error {
+	deps := depinject.Supply(aq.eventsQueryClient)
+	txDecoder := aq.clientCtx.TxConfig.TxDecoder()
+
+	appStakeClient, err := events.NewAppStakeReplayClient(ctx, deps, txDecoder)
+	if err != nil {
+		return err
+	}
+	appUnstakeClient, err := events.NewAppUnstakeReplayClient(ctx, deps, txDecoder)
+	if err != nil {
+		return err
+	}
+	delegationClient, err := events.NewDelegationReplayClient(ctx, deps, txDecoder)
+	if err != nil {
+		return err
+	}
+	undelegationClient, err := events.NewUndelegationReplayClient(ctx, deps, txDecoder)
+	if err != nil {
+		return err
+	}
+
+	events.ForEachMsg(ctx, appStakeClient,
+		func(_ context.Context, msg *apptypes.MsgStakeApplication) {
+			aq.appCache.Remove(msg.GetAddress())
+			aq.evictRings(msg.GetAddress())
+		},
+	)
+	events.ForEachMsg(ctx, appUnstakeClient,
+		func(_ context.Context, msg *apptypes.MsgUnstakeApplication) {
+			aq.appCache.Remove(msg.GetAddress())
+			aq.evictRings(msg.GetAddress())
+		},
+	)
+	events.ForEachMsg(ctx, delegationClient,
+		func(_ context.Context, msg *apptypes.MsgDelegateToGateway) {
+			aq.appCache.Remove(msg.GetAppAddress())
+		},
+	)
+	events.ForEachMsg(ctx, undelegationClient,
+		func(_ context.Context, msg *apptypes.MsgUndelegateFromGateway) {
+			aq.appCache.Remove(msg.GetAppAddress())
+		},
+	)
+
+	return nil
+}
+
+// evictRings removes the cached rings of the application with the given address.
+// They only change if the application is unstaked (and restaked), as it loses
+// its delegations.
+func (aq *appQuerier) evictRings(appAddress string) {
+	prefix := appAddress + "/"
+	for _, key := range aq.ringCache.Keys() {
+		if strings.HasPrefix(key, prefix) {
+			aq.ringCache.Remove(key)
+		}
+	}
+}
+
+// ringCacheKey returns the key which the ring of the given application, as of
+// the session of the given block height, is cached by.
+func ringCacheKey(appAddress string, blockHeight int64) string {
+	return fmt.Sprintf("%s/%d", appAddress, sharedhelpers.GetSessionNumber(blockHeight))
+}
+
+// getRingQueryHeight returns the block height at which the ring of the session of
+// the given block height is queried. A block height of 0 is interpreted as the
+// latest one on-chain, so the ring of the first session, which starts at height
+// 0, is queried at its second block instead; the ring is the same at any height
+// of a session.
+func getRingQueryHeight(blockHeight int64) int64 {
+	if blockHeight == 0 {
+		return 1
+	}
+	return blockHeight
+}
@@ -0,0 +1,78 @@
+package query
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/pokt-network/poktroll/testutil/sample"
+	apptypes "github.com/pokt-network/poktroll/x/application/types"
+)
+
+func TestAppQuerier_GetApplicationRing(t *testing.T) {
+	ctx := context.Background()
+	appAddr := sample.AccAddress()
+
+	appCache, err := newCache[apptypes.Application](DefaultCacheSize)
+	require.NoError(t, err)
+	ringCache, err := newCache[[]string](DefaultCacheSize)
+	require.NoError(t, err)
+
+	queryClient := &fakeAppQueryClient{}
+	aq := &appQuerier{
+		applicationQuerier: queryClient,
+		appCache:           appCache,
+		ringCache:          ringCache,
+	}
+
+	// The first session starts at height 0, which would be interpreted as the
+	// latest height on-chain, so its ring is queried at a later height of it.
+	for _, height := range []int64{0, 1, 3} {
+		ringAddresses, err := aq.GetApplicationRing(ctx, appAddr, height)
+		require.NoError(t, err)
+		require.Equal(t, []string{appAddr}, ringAddresses)
+	}
+	require.Equal(t, []int64{1}, queryClient.getQueryHeights())
+
+	// The rings of other sessions are queried at the given height, once.
+	for _, height := range []int64{4, 5, 7} {
+		_, err := aq.GetApplicationRing(ctx, appAddr, height)
+		require.NoError(t, err)
+	}
+	require.Equal(t, []int64{1, 4}, queryClient.getQueryHeights())
+
+	_, err = aq.GetApplicationRing(ctx, appAddr, -1)
+	require.ErrorIs(t, err, ErrQueryRetrieveApplicationRing)
+	require.Equal(t, []int64{1, 4}, queryClient.getQueryHeights())
+}
+
+// fakeAppQueryClient is an apptypes.QueryClient which serves rings made up of
+// the application's address only, and records the heights it is queried at.
+type fakeAppQueryClient struct {
+	apptypes.QueryClient
+
+	mu           sync.Mutex
+	queryHeights []int64
+}
+
+func (c *fakeAppQueryClient) ApplicationRing(
+	_ context.Context,
+	req *apptypes.QueryGetApplicationRingRequest,
+	_ ...grpc.CallOption,
+) (*apptypes.QueryGetApplicationRingResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.queryHeights = append(c.queryHeights, req.GetBlockHeight())
+	return &apptypes.QueryGetApplicationRingResponse{RingAddresses: []string{req.GetAddress()}}, nil
+}
+
+func (c *fakeAppQueryClient) getQueryHeights() []int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return append([]int64(nil), c.queryHeights...)
+}
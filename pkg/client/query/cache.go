@@ -0,0 +1,20 @@
+package query
+
+import (
+	lru "github.com/hashicorp/golang-lru/v2"
+)
+
+// DefaultCacheSize is the maximum number of entries which each of the queriers'
+// caches holds, beyond which the least recently used entries are evicted.
+const DefaultCacheSize = 1024
+
+// newCache returns a new LRU cache of values of type V, keyed by strings (e.g.
+// addresses), which holds at most size entries. It returns an
+// ErrQueryInvalidCacheSize error if size isn't positive.
+func newCache[V any](size int) (*lru.Cache[string, V], error) {
+	cache, err := lru.New[string, V](size)
+	if err != nil {
+		return nil, ErrQueryInvalidCacheSize.Wrapf("%s", err)
+	}
+	return cache, nil
+}
@@ -0,0 +1,51 @@
+package query
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCache(t *testing.T) {
+	tests := []struct {
+		desc        string
+		size        int
+		expectedErr error
+	}{
+		{
+			desc: "positive size",
+			size: 2,
+		},
+		{
+			desc:        "zero size",
+			size:        0,
+			expectedErr: ErrQueryInvalidCacheSize,
+		},
+		{
+			desc:        "negative size",
+			size:        -1,
+			expectedErr: ErrQueryInvalidCacheSize,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			cache, err := newCache[string](test.size)
+			if test.expectedErr != nil {
+				require.ErrorIs(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			// The least recently used entry is evicted once the cache is full.
+			cache.Add("key1", "value1")
+			cache.Add("key2", "value2")
+			cache.Get("key1")
+			cache.Add("key3", "value3")
+
+			require.True(t, cache.Contains("key1"))
+			require.False(t, cache.Contains("key2"))
+			require.True(t, cache.Contains("key3"))
+		})
+	}
+}
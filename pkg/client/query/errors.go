@@ -0,0 +1,15 @@
+package query
+
+import sdkerrors "cosmossdk.io/errors"
+
+var (
+	codespace                          = "query"
+	ErrQueryAccountNotFound            = sdkerrors.Register(codespace, 1, "account not found")
+	ErrQueryUnableToDeserializeAccount = sdkerrors.Register(codespace, 2, "unable to deserialize account")
+	ErrQueryPubKeyNotFound             = sdkerrors.Register(codespace, 3, "account pub key not found")
+	ErrQueryRetrieveApplication        = sdkerrors.Register(codespace, 4, "unable to retrieve application")
+	ErrQueryRetrieveSupplier           = sdkerrors.Register(codespace, 5, "unable to retrieve supplier")
+	ErrQueryRetrieveSession            = sdkerrors.Register(codespace, 6, "unable to retrieve session")
+	ErrQueryInvalidCacheSize           = sdkerrors.Register(codespace, 7, "invalid query cache size")
+	ErrQueryRetrieveApplicationRing    = sdkerrors.Register(codespace, 8, "unable to retrieve application ring")
+)
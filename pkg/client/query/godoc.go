@@ -0,0 +1,9 @@
+// Package query provides implementations of the client.AccountQuerier,
+// client.ApplicationQuerier, client.SupplierQuerier and client.SessionQuerier
+// interfaces. They wrap the respective module query clients with bounded LRU
+// caches which are invalidated as the on-chain state they hold changes (i.e.
+// when a block past a cached session's end, or a transaction which changes a
+// cached actor, is committed), such that every off-chain component which shares
+// them shares one consistent view of the chain state while avoiding redundant
+// queries.
+package query
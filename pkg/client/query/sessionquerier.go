@@ -0,0 +1,128 @@
+package query
+
+import (
+	"context"
+
+	"cosmossdk.io/depinject"
+	cosmosclient "github.com/cosmos/cosmos-sdk/client"
+	lru "github.com/hashicorp/golang-lru/v2"
+
+	"github.com/pokt-network/poktroll/pkg/client"
+	"github.com/pokt-network/poktroll/pkg/observable"
+	"github.com/pokt-network/poktroll/pkg/observable/channel"
+	sessiontypes "github.com/pokt-network/poktroll/x/session/types"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+)
+
+var _ client.SessionQuerier = (*sessionQuerier)(nil)
+
+// sessionQuerier is a wrapper around the sessiontypes.QueryClient that enables
+// the querying of on-chain session information through a single exposed method
+// which returns a sessiontypes.Session struct, and caches the sessions it has
+// queried until they end.
+type sessionQuerier struct {
+	clientCtx      cosmosclient.Context
+	blockClient    client.BlockClient
+	sessionQuerier sessiontypes.QueryClient
+	// sessionCache caches the latest queried session of each application and
+	// service, keyed by sessionCacheKey. Entries are evicted when a block past
+	// the end of the corresponding session is committed.
+	sessionCache *lru.Cache[string, *sessiontypes.Session]
+}
+
+// NewSessionQuerier returns a new instance of a client.SessionQuerier by
+// injecting the dependecies provided by the depinject.Config. It observes the
+// committed blocks in order to evict ended sessions from its cache until the
+// given context is done.
+//
+// Required dependencies:
+// - cosmosclient.Context
+// - client.BlockClient
+func NewSessionQuerier(
+	ctx context.Context,
+	deps depinject.Config,
+) (client.SessionQuerier, error) {
+	sq := &sessionQuerier{}
+
+	if err := depinject.Inject(
+		deps,
+		&sq.clientCtx,
+		&sq.blockClient,
+	); err != nil {
+		return nil, err
+	}
+
+	sessionCache, err := newCache[*sessiontypes.Session](DefaultCacheSize)
+	if err != nil {
+		return nil, err
+	}
+
+	sq.sessionCache = sessionCache
+	sq.sessionQuerier = sessiontypes.NewQueryClient(sq.clientCtx)
+
+	sq.evictSessionsOnNewBlocks(ctx)
+
+	return sq, nil
+}
+
+// GetSession returns a sessiontypes.Session struct for a given application
+// address, service ID and block height, querying for it only if the cached
+// session of the application and service isn't the one which the block height
+// is part of.
+func (sq *sessionQuerier) GetSession(
+	ctx context.Context,
+	appAddress string,
+	serviceId string,
+	blockHeight int64,
+) (*sessiontypes.Session, error) {
+	key := sessionCacheKey(appAddress, serviceId)
+	if session, ok := sq.sessionCache.Get(key); ok && session.ContainsHeight(blockHeight) {
+		return session, nil
+	}
+
+	req := &sessiontypes.QueryGetSessionRequest{
+		ApplicationAddress: appAddress,
+		Service:            &sharedtypes.Service{Id: serviceId},
+		BlockHeight:        blockHeight,
+	}
+	res, err := sq.sessionQuerier.GetSession(ctx, req)
+	if err != nil {
+		return nil, ErrQueryRetrieveSession.Wrapf(
+			"address: %s; serviceId: %s; block height: %d; error: [%v]",
+			appAddress, serviceId, blockHeight, err,
+		)
+	}
+	session := res.Session
+
+	// Sessions of past heights don't displace a more recent cached session.
+	cachedSession, ok := sq.sessionCache.Peek(key)
+	if !ok || session.GetHeader().GetSessionStartBlockHeight() >=
+		cachedSession.GetHeader().GetSessionStartBlockHeight() {
+		sq.sessionCache.Add(key, session)
+	}
+
+	return session, nil
+}
+
+// evictSessionsOnNewBlocks removes the sessions which have ended from the cache
+// whenever a new block is committed.
+func (sq *sessionQuerier) evictSessionsOnNewBlocks(ctx context.Context) {
+	channel.ForEach(
+		ctx,
+		observable.Observable[client.Block](sq.blockClient.CommittedBlocksSequence(ctx)),
+		func(_ context.Context, block client.Block) {
+			for _, key := range sq.sessionCache.Keys() {
+				session, ok := sq.sessionCache.Peek(key)
+				if ok && session.IsEnded(block.Height()) {
+					sq.sessionCache.Remove(key)
+				}
+			}
+		},
+	)
+}
+
+// sessionCacheKey returns the key which the session of the given application
+// and service is cached by.
+func sessionCacheKey(appAddress, serviceId string) string {
+	return appAddress + "/" + serviceId
+}
@@ -0,0 +1,164 @@
+package query
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/pokt-network/poktroll/pkg/client"
+	"github.com/pokt-network/poktroll/pkg/observable/channel"
+	"github.com/pokt-network/poktroll/testutil/mockclient"
+	"github.com/pokt-network/poktroll/testutil/sample"
+	sessiontypes "github.com/pokt-network/poktroll/x/session/types"
+	sharedhelpers "github.com/pokt-network/poktroll/x/shared/helpers"
+)
+
+func TestSessionQuerier_GetSession_CachesSessions(t *testing.T) {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	t.Cleanup(cancelCtx)
+
+	appAddr := sample.AccAddress()
+	sq, queryClient, _ := newTestSessionQuerier(ctx, t)
+
+	// Heights of the same session are served from the cache.
+	for _, height := range []int64{4, 5, 7} {
+		session, err := sq.GetSession(ctx, appAddr, "svc1", height)
+		require.NoError(t, err)
+		require.Equal(t, int64(4), session.GetHeader().GetSessionStartBlockHeight())
+	}
+	require.Equal(t, 1, queryClient.getNumQueries())
+
+	// Heights of the next session are queried, and displace the cached session.
+	session, err := sq.GetSession(ctx, appAddr, "svc1", 8)
+	require.NoError(t, err)
+	require.Equal(t, int64(8), session.GetHeader().GetSessionStartBlockHeight())
+	require.Equal(t, 2, queryClient.getNumQueries())
+
+	// Heights of past sessions are queried without displacing the cached session.
+	session, err = sq.GetSession(ctx, appAddr, "svc1", 5)
+	require.NoError(t, err)
+	require.Equal(t, int64(4), session.GetHeader().GetSessionStartBlockHeight())
+	_, err = sq.GetSession(ctx, appAddr, "svc1", 9)
+	require.NoError(t, err)
+	require.Equal(t, 3, queryClient.getNumQueries())
+
+	// Sessions of other services are cached separately.
+	_, err = sq.GetSession(ctx, appAddr, "svc2", 9)
+	require.NoError(t, err)
+	require.Equal(t, 4, queryClient.getNumQueries())
+}
+
+func TestSessionQuerier_EvictsEndedSessionsOnNewBlocks(t *testing.T) {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	t.Cleanup(cancelCtx)
+
+	appAddr := sample.AccAddress()
+	sq, queryClient, blocksPublishCh := newTestSessionQuerier(ctx, t)
+
+	_, err := sq.GetSession(ctx, appAddr, "svc1", 5)
+	require.NoError(t, err)
+	key := sessionCacheKey(appAddr, "svc1")
+
+	// Blocks of the cached session don't evict it.
+	blocksPublishCh <- newTestBlock(t, 7)
+	time.Sleep(10 * time.Millisecond)
+	require.True(t, sq.sessionCache.Contains(key))
+
+	// The first block past the end of the session does.
+	blocksPublishCh <- newTestBlock(t, 8)
+	require.Eventually(t, func() bool {
+		return !sq.sessionCache.Contains(key)
+	}, evictionTimeout, 10*time.Millisecond)
+
+	_, err = sq.GetSession(ctx, appAddr, "svc1", 8)
+	require.NoError(t, err)
+	require.Equal(t, 2, queryClient.getNumQueries())
+}
+
+// newTestSessionQuerier returns a sessionQuerier which queries the returned
+// fake session query client, along with the publish channel of the committed
+// blocks which it evicts ended sessions on.
+func newTestSessionQuerier(
+	ctx context.Context,
+	t *testing.T,
+) (*sessionQuerier, *fakeSessionQueryClient, chan<- client.Block) {
+	t.Helper()
+
+	blocksObs, blocksPublishCh := channel.NewReplayObservable[client.Block](ctx, 1)
+	ctrl := gomock.NewController(t)
+	blockClient := mockclient.NewMockBlockClient(ctrl)
+	blockClient.EXPECT().
+		CommittedBlocksSequence(gomock.Any()).
+		Return(client.BlocksObservable(blocksObs)).
+		AnyTimes()
+
+	sessionCache, err := newCache[*sessiontypes.Session](DefaultCacheSize)
+	require.NoError(t, err)
+
+	queryClient := &fakeSessionQueryClient{}
+	sq := &sessionQuerier{
+		blockClient:    blockClient,
+		sessionQuerier: queryClient,
+		sessionCache:   sessionCache,
+	}
+	sq.evictSessionsOnNewBlocks(ctx)
+
+	return sq, queryClient, blocksPublishCh
+}
+
+// newTestBlock returns a mock block of the given height.
+func newTestBlock(t *testing.T, height int64) client.Block {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	block := mockclient.NewMockBlock(ctrl)
+	block.EXPECT().Height().Return(height).AnyTimes()
+	block.EXPECT().Hash().Return([]byte{}).AnyTimes()
+
+	return block
+}
+
+// fakeSessionQueryClient is a sessiontypes.QueryClient which serves sessions of
+// sharedhelpers.NumBlocksPerSession blocks for any application and service, and
+// counts the queries it receives.
+type fakeSessionQueryClient struct {
+	sessiontypes.QueryClient
+
+	mu         sync.Mutex
+	numQueries int
+}
+
+func (c *fakeSessionQueryClient) GetSession(
+	_ context.Context,
+	req *sessiontypes.QueryGetSessionRequest,
+	_ ...grpc.CallOption,
+) (*sessiontypes.QueryGetSessionResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.numQueries++
+	sessionStartHeight := sharedhelpers.GetSessionStartBlockHeight(req.GetBlockHeight())
+	return &sessiontypes.QueryGetSessionResponse{
+		Session: &sessiontypes.Session{
+			Header: &sessiontypes.SessionHeader{
+				ApplicationAddress:      req.GetApplicationAddress(),
+				Service:                 req.GetService(),
+				SessionStartBlockHeight: sessionStartHeight,
+				SessionEndBlockHeight:   sessionStartHeight + sharedhelpers.NumBlocksPerSession,
+			},
+			NumBlocksPerSession: sharedhelpers.NumBlocksPerSession,
+		},
+	}, nil
+}
+
+func (c *fakeSessionQueryClient) getNumQueries() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.numQueries
+}
@@ -0,0 +1,192 @@
+package query
+
+import (
+	"context"
+
+	"cosmossdk.io/depinject"
+	cosmosclient "github.com/cosmos/cosmos-sdk/client"
+	lru "github.com/hashicorp/golang-lru/v2"
+
+	"github.com/pokt-network/poktroll/pkg/client"
+	"github.com/pokt-network/poktroll/pkg/client/events"
+	"github.com/pokt-network/poktroll/pkg/observable"
+	"github.com/pokt-network/poktroll/pkg/observable/channel"
+	sharedhelpers "github.com/pokt-network/poktroll/x/shared/helpers"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+	suppliertypes "github.com/pokt-network/poktroll/x/supplier/types"
+)
+
+var _ client.SupplierQuerier = (*supplierQuerier)(nil)
+
+// supplierQuerier is a wrapper around the suppliertypes.QueryClient that enables
+// the querying of on-chain supplier information through a single exposed method
+// which returns a sharedtypes.Supplier struct, and caches the suppliers it has
+// queried until they change on-chain.
+type supplierQuerier struct {
+	clientCtx         cosmosclient.Context
+	eventsQueryClient client.EventsQueryClient
+	blockClient       client.BlockClient
+	supplierQuerier   suppliertypes.QueryClient
+	// supplierCache caches suppliers by address. Entries are evicted when a
+	// transaction which changes the corresponding supplier is committed, or
+	// when a pending update of the supplier takes effect.
+	supplierCache *lru.Cache[string, sharedtypes.Supplier]
+}
+
+// NewSupplierQuerier returns a new instance of a client.SupplierQuerier by
+// injecting the dependecies provided by the depinject.Config. It subscribes to
+// the on-chain events which change suppliers (i.e. staking, unstaking, updating
+// services, decreasing stake and rotating operators) and observes the committed
+// blocks, at which their pending updates take effect, in order to evict them
+// from its cache until the given context is done.
+//
+// Required dependencies:
+// - cosmosclient.Context
+// - client.EventsQueryClient
+// - client.BlockClient
+func NewSupplierQuerier(
+	ctx context.Context,
+	deps depinject.Config,
+) (client.SupplierQuerier, error) {
+	sq := &supplierQuerier{}
+
+	if err := depinject.Inject(
+		deps,
+		&sq.clientCtx,
+		&sq.eventsQueryClient,
+		&sq.blockClient,
+	); err != nil {
+		return nil, err
+	}
+
+	supplierCache, err := newCache[sharedtypes.Supplier](DefaultCacheSize)
+	if err != nil {
+		return nil, err
+	}
+
+	sq.supplierCache = supplierCache
+	sq.supplierQuerier = suppliertypes.NewQueryClient(sq.clientCtx)
+
+	if err := sq.evictSuppliersOnChanges(ctx); err != nil {
+		return nil, err
+	}
+	sq.evictSuppliersOnPendingUpdates(ctx)
+
+	return sq, nil
+}
+
+// GetSupplier returns a sharedtypes.Supplier struct for a given address,
+// querying for it only if it isn't cached.
+func (sq *supplierQuerier) GetSupplier(
+	ctx context.Context,
+	supplierAddress string,
+) (sharedtypes.Supplier, error) {
+	if supplier, ok := sq.supplierCache.Get(supplierAddress); ok {
+		return supplier, nil
+	}
+
+	req := &suppliertypes.QueryGetSupplierRequest{Address: supplierAddress}
+	res, err := sq.supplierQuerier.Supplier(ctx, req)
+	if err != nil {
+		return sharedtypes.Supplier{}, ErrQueryRetrieveSupplier.Wrapf(
+			"address: %s [%v]", supplierAddress, err,
+		)
+	}
+
+	sq.supplierCache.Add(supplierAddress, res.Supplier)
+
+	return res.Supplier, nil
+}
+
+// evictSuppliersOnChanges subscribes to the on-chain events which change a
+// supplier and evicts the corresponding supplier from the cache whenever one is
+// committed, such that it is re-queried the next time it is needed.
+func (sq *supplierQuerier) evictSuppliersOnChanges(ctx context.Context) error {
+	deps := depinject.Supply(sq.eventsQueryClient)
+	txDecoder := sq.clientCtx.TxConfig.TxDecoder()
+
+	supplierStakeClient, err := events.NewSupplierStakeReplayClient(ctx, deps, txDecoder)
+	if err != nil {
+		return err
+	}
+	supplierUnstakeClient, err := events.NewSupplierUnstakeReplayClient(ctx, deps, txDecoder)
+	if err != nil {
+		return err
+	}
+	supplierServicesUpdateClient, err := events.NewSupplierServicesUpdateReplayClient(ctx, deps, txDecoder)
+	if err != nil {
+		return err
+	}
+	supplierStakeDecreaseClient, err := events.NewSupplierStakeDecreaseReplayClient(ctx, deps, txDecoder)
+	if err != nil {
+		return err
+	}
+	supplierOperatorUpdateClient, err := events.NewSupplierOperatorUpdateReplayClient(ctx, deps, txDecoder)
+	if err != nil {
+		return err
+	}
+
+	events.ForEachMsg(ctx, supplierStakeClient,
+		func(_ context.Context, msg *suppliertypes.MsgStakeSupplier) {
+			sq.supplierCache.Remove(msg.GetOperatorAddressOrOwner())
+		},
+	)
+	events.ForEachMsg(ctx, supplierUnstakeClient,
+		func(_ context.Context, msg *suppliertypes.MsgUnstakeSupplier) {
+			sq.supplierCache.Remove(msg.GetOperatorAddressOrOwner())
+		},
+	)
+	events.ForEachMsg(ctx, supplierServicesUpdateClient,
+		func(_ context.Context, msg *suppliertypes.MsgUpdateSupplierServices) {
+			sq.supplierCache.Remove(msg.GetOperatorAddressOrOwner())
+		},
+	)
+	events.ForEachMsg(ctx, supplierStakeDecreaseClient,
+		func(_ context.Context, msg *suppliertypes.MsgDecreaseSupplierStake) {
+			sq.supplierCache.Remove(msg.GetOperatorAddressOrOwner())
+		},
+	)
+	// The new operator only takes effect at the start of the next session (see:
+	// evictSuppliersOnPendingUpdates), until which the supplier keeps its current
+	// operator address, along with the pending update.
+	events.ForEachMsg(ctx, supplierOperatorUpdateClient,
+		func(_ context.Context, msg *suppliertypes.MsgUpdateSupplierOperator) {
+			sq.supplierCache.Remove(msg.GetOperatorAddress())
+		},
+	)
+
+	return nil
+}
+
+// evictSuppliersOnPendingUpdates removes the suppliers whose pending service
+// config or operator updates have taken effect from the cache whenever a new
+// block is committed. The updates take effect at the first block of a session,
+// from which the cached suppliers are stale.
+func (sq *supplierQuerier) evictSuppliersOnPendingUpdates(ctx context.Context) {
+	channel.ForEach(
+		ctx,
+		observable.Observable[client.Block](sq.blockClient.CommittedBlocksSequence(ctx)),
+		func(_ context.Context, block client.Block) {
+			sessionNumber := sharedhelpers.GetSessionNumber(block.Height())
+			for _, key := range sq.supplierCache.Keys() {
+				supplier, ok := sq.supplierCache.Peek(key)
+				if ok && hasPendingUpdateInEffect(&supplier, sessionNumber) {
+					sq.supplierCache.Remove(key)
+				}
+			}
+		},
+	)
+}
+
+// hasPendingUpdateInEffect returns true if the given supplier has a pending
+// service config or operator update which takes effect by the session of the
+// given number.
+func hasPendingUpdateInEffect(supplier *sharedtypes.Supplier, sessionNumber int64) bool {
+	servicesUpdate := supplier.GetPendingServiceConfigUpdate()
+	if servicesUpdate != nil && servicesUpdate.GetEffectiveSessionNumber() <= sessionNumber {
+		return true
+	}
+
+	operatorUpdate := supplier.GetPendingOperatorUpdate()
+	return operatorUpdate != nil && operatorUpdate.GetEffectiveSessionNumber() <= sessionNumber
+}
@@ -0,0 +1,312 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	cosmosclient "github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/pokt-network/poktroll/pkg/client"
+	"github.com/pokt-network/poktroll/pkg/either"
+	"github.com/pokt-network/poktroll/pkg/observable/channel"
+	testevents "github.com/pokt-network/poktroll/testutil/events"
+	"github.com/pokt-network/poktroll/testutil/mockclient"
+	"github.com/pokt-network/poktroll/testutil/sample"
+	sharedhelpers "github.com/pokt-network/poktroll/x/shared/helpers"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+	suppliertypes "github.com/pokt-network/poktroll/x/supplier/types"
+)
+
+const evictionTimeout = 2 * time.Second
+
+func TestSupplierQuerier_GetSupplier_CachesSuppliers(t *testing.T) {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	t.Cleanup(cancelCtx)
+
+	supplierAddr := sample.AccAddress()
+	sq, queryClient, _, _ := newTestSupplierQuerier(ctx, t)
+	queryClient.setSupplier(sharedtypes.Supplier{OperatorAddress: supplierAddr})
+
+	for i := 0; i < 3; i++ {
+		supplier, err := sq.GetSupplier(ctx, supplierAddr)
+		require.NoError(t, err)
+		require.Equal(t, supplierAddr, supplier.OperatorAddress)
+	}
+	require.Equal(t, 1, queryClient.getNumQueries())
+
+	// Suppliers which aren't found on-chain aren't cached.
+	for i := 0; i < 2; i++ {
+		_, err := sq.GetSupplier(ctx, sample.AccAddress())
+		require.ErrorIs(t, err, ErrQueryRetrieveSupplier)
+	}
+	require.Equal(t, 3, queryClient.getNumQueries())
+}
+
+func TestSupplierQuerier_EvictsSuppliersOnChanges(t *testing.T) {
+	supplierAddr := sample.AccAddress()
+	ownerAddr := sample.AccAddress()
+
+	tests := []struct {
+		desc string
+		msg  cosmostypes.Msg
+	}{
+		{
+			desc: "stake",
+			msg: &suppliertypes.MsgStakeSupplier{
+				OwnerAddress:    ownerAddr,
+				OperatorAddress: supplierAddr,
+			},
+		},
+		{
+			desc: "unstake",
+			msg: &suppliertypes.MsgUnstakeSupplier{
+				OwnerAddress:    ownerAddr,
+				OperatorAddress: supplierAddr,
+			},
+		},
+		{
+			desc: "update services",
+			msg: &suppliertypes.MsgUpdateSupplierServices{
+				OwnerAddress:    ownerAddr,
+				OperatorAddress: supplierAddr,
+			},
+		},
+		{
+			desc: "decrease stake",
+			msg: &suppliertypes.MsgDecreaseSupplierStake{
+				OwnerAddress:    ownerAddr,
+				OperatorAddress: supplierAddr,
+			},
+		},
+		{
+			desc: "update operator",
+			msg: &suppliertypes.MsgUpdateSupplierOperator{
+				OwnerAddress:       ownerAddr,
+				OperatorAddress:    supplierAddr,
+				NewOperatorAddress: sample.AccAddress(),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			ctx, cancelCtx := context.WithCancel(context.Background())
+			t.Cleanup(cancelCtx)
+
+			sq, queryClient, publishMsg, _ := newTestSupplierQuerier(ctx, t)
+			queryClient.setSupplier(sharedtypes.Supplier{OperatorAddress: supplierAddr})
+
+			_, err := sq.GetSupplier(ctx, supplierAddr)
+			require.NoError(t, err)
+
+			// Suppliers which aren't changed by the message remain cached.
+			otherSupplierAddr := sample.AccAddress()
+			queryClient.setSupplier(sharedtypes.Supplier{OperatorAddress: otherSupplierAddr})
+			_, err = sq.GetSupplier(ctx, otherSupplierAddr)
+			require.NoError(t, err)
+
+			// The supplier is re-queried once it's evicted.
+			stake := cosmostypes.NewInt64Coin("upokt", 100)
+			queryClient.setSupplier(sharedtypes.Supplier{OperatorAddress: supplierAddr, Stake: &stake})
+
+			// Values published before the events replay clients subscribe are
+			// dropped, so the message is published until it's observed.
+			require.Eventually(t, func() bool {
+				publishMsg(t, test.msg)
+				return !sq.supplierCache.Contains(supplierAddr)
+			}, evictionTimeout, 10*time.Millisecond)
+			require.True(t, sq.supplierCache.Contains(otherSupplierAddr))
+
+			supplier, err := sq.GetSupplier(ctx, supplierAddr)
+			require.NoError(t, err)
+			require.Equal(t, &stake, supplier.Stake)
+		})
+	}
+}
+
+func TestSupplierQuerier_EvictsSuppliersOnPendingUpdates(t *testing.T) {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	t.Cleanup(cancelCtx)
+
+	sq, queryClient, _, blocksPublishCh := newTestSupplierQuerier(ctx, t)
+
+	// Both suppliers' pending updates take effect at the start of the second session.
+	servicesUpdateSupplierAddr := sample.AccAddress()
+	queryClient.setSupplier(sharedtypes.Supplier{
+		OperatorAddress: servicesUpdateSupplierAddr,
+		PendingServiceConfigUpdate: &sharedtypes.SupplierServiceConfigUpdate{
+			EffectiveSessionNumber: 2,
+		},
+	})
+	operatorUpdateSupplierAddr := sample.AccAddress()
+	queryClient.setSupplier(sharedtypes.Supplier{
+		OperatorAddress: operatorUpdateSupplierAddr,
+		PendingOperatorUpdate: &sharedtypes.SupplierOperatorUpdate{
+			NewOperatorAddress:     sample.AccAddress(),
+			EffectiveSessionNumber: 2,
+		},
+	})
+	otherSupplierAddr := sample.AccAddress()
+	queryClient.setSupplier(sharedtypes.Supplier{OperatorAddress: otherSupplierAddr})
+
+	supplierAddrs := []string{servicesUpdateSupplierAddr, operatorUpdateSupplierAddr, otherSupplierAddr}
+	for _, supplierAddr := range supplierAddrs {
+		_, err := sq.GetSupplier(ctx, supplierAddr)
+		require.NoError(t, err)
+	}
+
+	// Blocks before the updates take effect don't evict the suppliers.
+	blocksPublishCh <- newTestBlock(t, 2*sharedhelpers.NumBlocksPerSession-1)
+	time.Sleep(10 * time.Millisecond)
+	for _, supplierAddr := range supplierAddrs {
+		require.True(t, sq.supplierCache.Contains(supplierAddr))
+	}
+
+	// The first block of the session in which they take effect does.
+	blocksPublishCh <- newTestBlock(t, 2*sharedhelpers.NumBlocksPerSession)
+	require.Eventually(t, func() bool {
+		return !sq.supplierCache.Contains(servicesUpdateSupplierAddr) &&
+			!sq.supplierCache.Contains(operatorUpdateSupplierAddr)
+	}, evictionTimeout, 10*time.Millisecond)
+	require.True(t, sq.supplierCache.Contains(otherSupplierAddr))
+}
+
+// newTestSupplierQuerier returns a supplierQuerier which queries the returned
+// fake supplier query client, along with a function which publishes a committed
+// transaction, containing the given message, to the events which it evicts
+// suppliers on, and the publish channel of the committed blocks which it evicts
+// suppliers with pending updates on.
+func newTestSupplierQuerier(
+	ctx context.Context,
+	t *testing.T,
+) (*supplierQuerier, *fakeSupplierQueryClient, func(*testing.T, cosmostypes.Msg), chan<- client.Block) {
+	t.Helper()
+
+	registry := codectypes.NewInterfaceRegistry()
+	suppliertypes.RegisterInterfaces(registry)
+	txConfig := authtx.NewTxConfig(codec.NewProtoCodec(registry), authtx.DefaultSignModes)
+
+	eventsQueryClient, publishEventBz := newEventsQueryClientByQuery(t)
+
+	blocksObs, blocksPublishCh := channel.NewReplayObservable[client.Block](ctx, 1)
+	ctrl := gomock.NewController(t)
+	blockClient := mockclient.NewMockBlockClient(ctrl)
+	blockClient.EXPECT().
+		CommittedBlocksSequence(gomock.Any()).
+		Return(client.BlocksObservable(blocksObs)).
+		AnyTimes()
+
+	supplierCache, err := newCache[sharedtypes.Supplier](DefaultCacheSize)
+	require.NoError(t, err)
+
+	queryClient := &fakeSupplierQueryClient{suppliers: make(map[string]sharedtypes.Supplier)}
+	sq := &supplierQuerier{
+		clientCtx:         cosmosclient.Context{}.WithTxConfig(txConfig),
+		eventsQueryClient: eventsQueryClient,
+		blockClient:       blockClient,
+		supplierQuerier:   queryClient,
+		supplierCache:     supplierCache,
+	}
+	require.NoError(t, sq.evictSuppliersOnChanges(ctx))
+	sq.evictSuppliersOnPendingUpdates(ctx)
+
+	publishMsg := func(t *testing.T, msg cosmostypes.Msg) {
+		query := fmt.Sprintf("tm.event='Tx' AND message.action='%s'", cosmostypes.MsgTypeURL(msg))
+		publishEventBz(query, testevents.NewTxEventBz(t, txConfig, 1, 0, msg))
+	}
+
+	return sq, queryClient, publishMsg, blocksPublishCh
+}
+
+// newEventsQueryClientByQuery returns a mock events query client which returns
+// a distinct events bytes observable per query, along with a function which
+// publishes the given event bytes to the observable of the given query.
+func newEventsQueryClientByQuery(
+	t *testing.T,
+) (client.EventsQueryClient, func(query string, eventBz []byte)) {
+	t.Helper()
+
+	var (
+		publishChsMu sync.Mutex
+		publishChs   = make(map[string]chan<- either.Bytes)
+		observables  = make(map[string]client.EventsBytesObservable)
+	)
+	getPublishCh := func(query string) (client.EventsBytesObservable, chan<- either.Bytes) {
+		publishChsMu.Lock()
+		defer publishChsMu.Unlock()
+
+		if _, ok := publishChs[query]; !ok {
+			eventsBzObsvbl, eventsBzPublishCh := channel.NewObservable[either.Bytes]()
+			observables[query] = client.EventsBytesObservable(eventsBzObsvbl)
+			publishChs[query] = eventsBzPublishCh
+		}
+		return observables[query], publishChs[query]
+	}
+
+	ctrl := gomock.NewController(t)
+	eventsQueryClient := mockclient.NewMockEventsQueryClient(ctrl)
+	eventsQueryClient.EXPECT().
+		EventsBytes(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, query string) (client.EventsBytesObservable, error) {
+			eventsBzObsvbl, _ := getPublishCh(query)
+			return eventsBzObsvbl, nil
+		}).
+		AnyTimes()
+
+	publishEventBz := func(query string, eventBz []byte) {
+		_, eventsBzPublishCh := getPublishCh(query)
+		eventsBzPublishCh <- either.Success(eventBz)
+	}
+
+	return eventsQueryClient, publishEventBz
+}
+
+// fakeSupplierQueryClient is a suppliertypes.QueryClient which serves the
+// suppliers which are set on it and counts the queries it receives.
+type fakeSupplierQueryClient struct {
+	suppliertypes.QueryClient
+
+	mu         sync.Mutex
+	suppliers  map[string]sharedtypes.Supplier
+	numQueries int
+}
+
+func (c *fakeSupplierQueryClient) Supplier(
+	_ context.Context,
+	req *suppliertypes.QueryGetSupplierRequest,
+	_ ...grpc.CallOption,
+) (*suppliertypes.QueryGetSupplierResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.numQueries++
+	supplier, ok := c.suppliers[req.GetAddress()]
+	if !ok {
+		return nil, fmt.Errorf("supplier %s not found", req.GetAddress())
+	}
+	return &suppliertypes.QueryGetSupplierResponse{Supplier: supplier}, nil
+}
+
+func (c *fakeSupplierQueryClient) setSupplier(supplier sharedtypes.Supplier) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.suppliers[supplier.OperatorAddress] = supplier
+}
+
+func (c *fakeSupplierQueryClient) getNumQueries() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.numQueries
+}
@@ -0,0 +1,171 @@
+package tx
+
+import (
+	"context"
+	"time"
+
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/pokt-network/poktroll/pkg/either"
+)
+
+// DefaultMaxBatchMsgs is the default maximum number of messages which are
+// packed into a single transaction when batching is enabled.
+const DefaultMaxBatchMsgs = 50
+
+// msgBatch accumulates the messages of concurrent SignAndBroadcast calls such
+// that they can be signed and broadcast in a single transaction.
+type msgBatch struct {
+	// calls are the SignAndBroadcast calls whose messages are in this batch, in
+	// the order they were made.
+	calls []*batchedCall
+	// numMsgs is the total number of messages across all calls.
+	numMsgs int
+	// flushTimer broadcasts the batch once the batch window has elapsed since
+	// the first call was added.
+	flushTimer *time.Timer
+}
+
+// batchedCall holds the messages of a single SignAndBroadcast call and the
+// error channel which is returned to its caller.
+type batchedCall struct {
+	msgs []cosmostypes.Msg
+	// errCh receives an error, if any, and closes when the transaction which
+	// includes msgs is committed, fails, or times out.
+	// NB: intentionally buffered to avoid blocking on send. Only intended
+	// to send/receive a single error.
+	errCh chan error
+}
+
+// enqueueMsgs adds the given messages to the current batch, starting a new one
+// if necessary. The batch is broadcast when either the batch window elapses or
+// it reaches maxBatchMsgs messages, whichever happens first. The returned
+// either.AsyncError's channel receives any error, synchronous or asynchronous,
+// which the broadcast of these messages results in.
+// The batch is broadcast with the client's context, as it is shared with other
+// callers; the given context only bounds the wait for this call's result, such
+// that the returned channel receives its error if it is done first.
+func (tClient *txClient) enqueueMsgs(
+	ctx context.Context,
+	msgs ...cosmostypes.Msg,
+) either.AsyncError {
+	call := &batchedCall{
+		msgs:  msgs,
+		errCh: make(chan error, 1),
+	}
+
+	tClient.batchMu.Lock()
+	defer tClient.batchMu.Unlock()
+
+	batch := tClient.batch
+	if batch == nil {
+		batch = new(msgBatch)
+		batch.flushTimer = time.AfterFunc(tClient.batchWindow, func() {
+			tClient.flushBatch(tClient.ctx, batch)
+		})
+		tClient.batch = batch
+	}
+
+	batch.calls = append(batch.calls, call)
+	batch.numMsgs += len(msgs)
+
+	if batch.numMsgs >= tClient.maxBatchMsgs {
+		// Detach the batch while holding the lock such that the flush timer,
+		// if it has already fired, doesn't broadcast it a second time.
+		tClient.batch = nil
+		batch.flushTimer.Stop()
+		go tClient.broadcastBatch(tClient.ctx, batch)
+	}
+
+	return either.AsyncErr(waitForCall(ctx, call))
+}
+
+// waitForCall returns a channel which receives the error, if any, which the
+// given call is resolved with and closes. If the given context is done first,
+// it receives the context's error instead.
+func waitForCall(ctx context.Context, call *batchedCall) chan error {
+	errCh := make(chan error, 1)
+	go func() {
+		defer close(errCh)
+
+		select {
+		case err := <-call.errCh:
+			// NB: err is nil if the call's channel is closed without an error.
+			if err != nil {
+				errCh <- err
+			}
+		case <-ctx.Done():
+			errCh <- ctx.Err()
+		}
+	}()
+
+	return errCh
+}
+
+// flushBatch broadcasts the given batch if it is still the current one; i.e. it
+// has not already been broadcast due to reaching maxBatchMsgs messages.
+func (tClient *txClient) flushBatch(ctx context.Context, batch *msgBatch) {
+	tClient.batchMu.Lock()
+	if tClient.batch != batch {
+		tClient.batchMu.Unlock()
+		return
+	}
+	tClient.batch = nil
+	tClient.batchMu.Unlock()
+
+	tClient.broadcastBatch(ctx, batch)
+}
+
+// broadcastBatch signs and broadcasts the messages of all calls in the given
+// batch in a single transaction and forwards its result to each call's error
+// channel. If the batched transaction fails synchronously (e.g. one of its
+// messages fails the ABCI check tx), each call is broadcast in a transaction
+// of its own such that one call's failure doesn't cause the others to fail.
+func (tClient *txClient) broadcastBatch(ctx context.Context, batch *msgBatch) {
+	msgs := make([]cosmostypes.Msg, 0, batch.numMsgs)
+	for _, call := range batch.calls {
+		msgs = append(msgs, call.msgs...)
+	}
+
+	err, txErrCh := tClient.signAndBroadcast(ctx, msgs...).SyncOrAsyncError()
+	if err == nil {
+		go fanOutAsyncError(txErrCh, batch.calls...)
+		return
+	}
+
+	if len(batch.calls) == 1 {
+		resolveCall(batch.calls[0], err)
+		return
+	}
+
+	for _, call := range batch.calls {
+		err, txErrCh := tClient.signAndBroadcast(ctx, call.msgs...).SyncOrAsyncError()
+		if err != nil {
+			resolveCall(call, err)
+			continue
+		}
+
+		go fanOutAsyncError(txErrCh, call)
+	}
+}
+
+// fanOutAsyncError waits for the given transaction error channel to receive or
+// close and resolves each of the given calls with the result. As the transaction
+// error channel only ever receives a single error, the calls' callers only ever
+// receive from their own error channels.
+func fanOutAsyncError(txErrCh <-chan error, calls ...*batchedCall) {
+	// NB: receives nil if the channel is closed without an error.
+	err := <-txErrCh
+	for _, call := range calls {
+		resolveCall(call, err)
+	}
+}
+
+// resolveCall sends the given error, if any, on the call's error channel and
+// closes it.
+func resolveCall(call *batchedCall, err error) {
+	if err != nil {
+		call.errCh <- err
+	}
+	close(call.errCh)
+}
@@ -7,7 +7,9 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"sync"
+	"time"
 
 	"cosmossdk.io/depinject"
 	abciTypes "github.com/cometbft/cometbft/abci/types"
@@ -27,6 +29,10 @@ const (
 	// latest block (when broadcasting) that a transactions should be considered
 	// errored if it has not been committed.
 	DefaultCommitTimeoutHeightOffset = 5
+	// DefaultGasAdjustment is the default factor by which the simulated gas
+	// consumption of a transaction is multiplied to determine its gas limit. It
+	// provides headroom for state changes between simulation and execution.
+	DefaultGasAdjustment = 1.5
 	// txWithSenderAddrQueryFmt is the query used to subscribe to cometbft transactions
 	// events where the sender address matches the interpolated address.
 	// (see: https://docs.cosmos.network/v0.47/core/events#subscribing-to-events)
@@ -56,6 +62,18 @@ type txClient struct {
 	// signingAddr is the address of the signing key referenced by signingKeyName.
 	// It is hydrated from the keyring by calling Keyring#Key() with signingKeyName.
 	signingAddr cosmostypes.AccAddress
+	// gasAdjustment is the factor by which the simulated gas consumption of a
+	// transaction is multiplied to determine its gas limit.
+	gasAdjustment float64
+	// gasPrices are multiplied by each transaction's gas limit to determine its
+	// fees. If empty, no fees are set.
+	gasPrices cosmostypes.DecCoins
+	// feeGranterAddress is the bech32 address of an account which has granted a
+	// fee allowance to the signing account. If empty, the signer pays the fees.
+	feeGranterAddress string
+	// feeGranter is the address referenced by feeGranterAddress.
+	// It is hydrated by parsing feeGranterAddress.
+	feeGranter cosmostypes.AccAddress
 	// txCtx is the transactions context which encapsulates transactions building, signing,
 	// broadcasting, and querying, as well as keyring access.
 	txCtx client.TxContext
@@ -66,6 +84,25 @@ type txClient struct {
 	// blockClient is the client used to query for the latest block height.
 	// It is used to implement timout logic for transactions which weren't committed.
 	blockClient client.BlockClient
+	// sequencer tracks the account number and sequence of the signing account
+	// such that concurrently broadcast transactions are assigned distinct sequences.
+	sequencer *accountSequencer
+
+	// batchWindow is the duration for which the messages of SignAndBroadcast
+	// calls are accumulated before being broadcast in a single transaction.
+	// If zero, batching is disabled and each call is broadcast immediately.
+	batchWindow time.Duration
+	// maxBatchMsgs is the number of messages at which a batch is broadcast
+	// before its batch window has elapsed.
+	maxBatchMsgs int
+	// ctx is the context which the client was constructed with. It bounds the
+	// broadcast of batches, which are shared by the calls of multiple callers,
+	// such that one caller's context being done doesn't fail the others' calls.
+	ctx context.Context
+	// batchMu protects batch.
+	batchMu sync.Mutex
+	// batch is the batch which messages are currently being added to, if any.
+	batch *msgBatch
 
 	// txsMutex protects txErrorChans and txTimeoutPool maps.
 	txsMutex sync.Mutex
@@ -114,13 +151,19 @@ type TxEvent struct {
 // Available options:
 //   - WithSigningKeyName
 //   - WithCommitTimeoutHeightOffset
+//   - WithGasAdjustment
+//   - WithGasPrices
+//   - WithFeeGranter
+//   - WithBatching
 func NewTxClient(
 	ctx context.Context,
 	deps depinject.Config,
 	opts ...client.TxClientOption,
 ) (client.TxClient, error) {
 	tClient := &txClient{
+		ctx:                       ctx,
 		commitTimeoutHeightOffset: DefaultCommitTimeoutHeightOffset,
+		gasAdjustment:             DefaultGasAdjustment,
 		txErrorChans:              make(txErrorChansByHash),
 		txTimeoutPool:             make(txTimeoutPool),
 	}
@@ -156,25 +199,19 @@ func NewTxClient(
 	return tClient, nil
 }
 
-// SignAndBroadcast signs a set of Cosmos SDK messages, constructs a transaction,
-// and broadcasts it to the network. The function performs several steps to
-// ensure the messages and the resultant transaction are valid:
+// SignAndBroadcast validates the given Cosmos SDK messages and signs and
+// broadcasts them to the network in a transaction (see: signAndBroadcast).
 //
-//  1. Validates each message in the provided set.
-//  2. Constructs the transaction using the Cosmos SDK's transaction builder.
-//  3. Calculates and sets the transaction's timeout height.
-//  4. Sets a default gas limit (note: this will be made configurable in the future).
-//  5. Signs the transaction.
-//  6. Validates the constructed transaction.
-//  7. Serializes and broadcasts the transaction.
-//  8. Checks the broadcast response for errors.
-//  9. If all the above steps are successful, the function registers the
-//     transaction as pending.
+// If batching is enabled, the messages are instead added to the current batch
+// and broadcast along with those of other calls made within the batch window,
+// in a single transaction (see: enqueueMsgs). In this case, any error other than
+// a message validation error is received on the returned either.AsyncError's
+// channel, including those which would otherwise be synchronous. Batching is
+// intended for messages which are expected to succeed independently of each
+// other and of their order, such as MsgCreateClaim and MsgSubmitProof.
 //
-// If any step encounters an error, it returns an either.AsyncError populated with
-// the synchronous error. If the function completes successfully, it returns an
-// either.AsyncError populated with the error channel which will receive if the
-// transaction results in an asynchronous error or times out.
+// If validation fails, it returns an either.AsyncError populated with the
+// synchronous error.
 func (tClient *txClient) SignAndBroadcast(
 	ctx context.Context,
 	msgs ...cosmostypes.Msg,
@@ -190,6 +227,38 @@ func (tClient *txClient) SignAndBroadcast(
 		return either.SyncErr(validationErrs)
 	}
 
+	if tClient.batchWindow > 0 {
+		return tClient.enqueueMsgs(ctx, msgs...)
+	}
+
+	return tClient.signAndBroadcast(ctx, msgs...)
+}
+
+// signAndBroadcast constructs a transaction from the given messages, signs it
+// and broadcasts it to the network. It performs the following steps:
+//
+//  1. Constructs the transaction using the Cosmos SDK's transaction builder.
+//  2. Calculates and sets the transaction's timeout height.
+//  3. Takes the next account sequence from the sequencer and, while holding it:
+//     a. Simulates the transaction to estimate its gas limit and sets the
+//     corresponding fees and fee granter.
+//     b. Signs the transaction with the account number and sequence.
+//     c. Validates the constructed transaction.
+//     d. Serializes and broadcasts the transaction.
+//     e. Checks the broadcast response for errors.
+//     If the sequence turns out to be incorrect, these steps are retried with
+//     the sequence which the network expects (see: accountSequencer).
+//  4. If all the above steps are successful, the function registers the
+//     transaction as pending.
+//
+// If any step encounters an error, it returns an either.AsyncError populated with
+// the synchronous error. If the function completes successfully, it returns an
+// either.AsyncError populated with the error channel which will receive if the
+// transaction results in an asynchronous error or times out.
+func (tClient *txClient) signAndBroadcast(
+	ctx context.Context,
+	msgs ...cosmostypes.Msg,
+) either.AsyncError {
 	// Construct the transactions using cosmos' transactions builder.
 	txBuilder := tClient.txCtx.NewTxBuilder()
 	if err := txBuilder.SetMsgs(msgs...); err != nil {
@@ -201,41 +270,59 @@ func (tClient *txClient) SignAndBroadcast(
 	timeoutHeight := tClient.blockClient.LatestBlock(ctx).
 		Height() + tClient.commitTimeoutHeightOffset
 
-	// TODO_TECHDEBT: this should be configurable
-	txBuilder.SetGasLimit(200000)
 	txBuilder.SetTimeoutHeight(uint64(timeoutHeight))
 
-	// sign transactions
-	err := tClient.txCtx.SignTx(
-		tClient.signingKeyName,
-		txBuilder,
-		false, false,
-	)
-	if err != nil {
-		return either.SyncErr(err)
-	}
+	var txResponse *cosmostypes.TxResponse
+	err := tClient.sequencer.withNextSequence(func(accountNumber, sequence uint64) error {
+		// Estimate the gas limit and set the corresponding fees.
+		gasLimit, err := tClient.estimateGasLimit(ctx, sequence, msgs...)
+		if err != nil {
+			return err
+		}
+		txBuilder.SetGasLimit(gasLimit)
+		txBuilder.SetFeeAmount(tClient.calculateFees(gasLimit))
+		if tClient.feeGranter != nil {
+			txBuilder.SetFeeGranter(tClient.feeGranter)
+		}
 
-	// ensure transactions is valid
-	// NOTE: this makes the transactions valid; i.e. it is *REQUIRED*
-	if err := txBuilder.GetTx().ValidateBasic(); err != nil {
-		return either.SyncErr(err)
-	}
+		// sign transactions, overwriting the signature of any previous attempt
+		err = tClient.txCtx.SignTx(
+			tClient.signingKeyName,
+			txBuilder,
+			accountNumber, sequence,
+			true,
+		)
+		if err != nil {
+			return err
+		}
 
-	// serialize transactions
-	txBz, err := tClient.txCtx.EncodeTx(txBuilder)
-	if err != nil {
-		return either.SyncErr(err)
-	}
+		// ensure transactions is valid
+		// NOTE: this makes the transactions valid; i.e. it is *REQUIRED*
+		if err := txBuilder.GetTx().ValidateBasic(); err != nil {
+			return err
+		}
+
+		// serialize transactions
+		txBz, err := tClient.txCtx.EncodeTx(txBuilder)
+		if err != nil {
+			return err
+		}
+
+		txResponse, err = tClient.txCtx.BroadcastTx(txBz)
+		if err != nil {
+			return err
+		}
 
-	txResponse, err := tClient.txCtx.BroadcastTx(txBz)
+		if txResponse.Code != 0 {
+			return ErrCheckTx.Wrapf(txResponse.RawLog)
+		}
+
+		return nil
+	})
 	if err != nil {
 		return either.SyncErr(err)
 	}
 
-	if txResponse.Code != 0 {
-		return either.SyncErr(ErrCheckTx.Wrapf(txResponse.RawLog))
-	}
-
 	return tClient.addPendingTransactions(normalizeTxHashHex(txResponse.TxHash), timeoutHeight)
 }
 
@@ -264,13 +351,81 @@ func (tClient *txClient) validateConfigAndSetDefaults() error {
 	}
 
 	tClient.signingAddr = signingAddr
+	tClient.sequencer = newAccountSequencer(tClient.txCtx, signingAddr)
 
 	if tClient.commitTimeoutHeightOffset <= 0 {
 		tClient.commitTimeoutHeightOffset = DefaultCommitTimeoutHeightOffset
 	}
+
+	if tClient.gasAdjustment == 0 {
+		tClient.gasAdjustment = DefaultGasAdjustment
+	}
+	if tClient.gasAdjustment < 1 {
+		return ErrInvalidGasConfig.Wrapf(
+			"gas adjustment must be at least 1, got: %f", tClient.gasAdjustment,
+		)
+	}
+
+	if err := tClient.gasPrices.Validate(); err != nil {
+		return ErrInvalidGasConfig.Wrapf("gas prices: %s", err)
+	}
+
+	if tClient.feeGranterAddress != "" {
+		feeGranter, err := cosmostypes.AccAddressFromBech32(tClient.feeGranterAddress)
+		if err != nil {
+			return ErrInvalidGasConfig.Wrapf("fee granter address: %s", err)
+		}
+		tClient.feeGranter = feeGranter
+	}
+
+	if tClient.batchWindow < 0 {
+		return ErrInvalidBatchConfig.Wrapf(
+			"batch window must not be negative, got: %s", tClient.batchWindow,
+		)
+	}
+
+	if tClient.maxBatchMsgs == 0 {
+		tClient.maxBatchMsgs = DefaultMaxBatchMsgs
+	}
+	if tClient.maxBatchMsgs < 0 {
+		return ErrInvalidBatchConfig.Wrapf(
+			"max batch msgs must be positive, got: %d", tClient.maxBatchMsgs,
+		)
+	}
+
 	return nil
 }
 
+// estimateGasLimit simulates a transaction containing the given messages, signed
+// with the given sequence, and returns its gas consumption multiplied by the
+// configured gas adjustment.
+func (tClient *txClient) estimateGasLimit(
+	ctx context.Context,
+	sequence uint64,
+	msgs ...cosmostypes.Msg,
+) (uint64, error) {
+	gasUsed, err := tClient.txCtx.Simulate(ctx, tClient.signingKeyName, sequence, msgs...)
+	if err != nil {
+		return 0, ErrSimulateTx.Wrapf("%s", err)
+	}
+
+	return uint64(math.Ceil(tClient.gasAdjustment * float64(gasUsed))), nil
+}
+
+// calculateFees returns the fees for a transaction with the given gas limit
+// according to the configured gas prices, rounding each amount up.
+func (tClient *txClient) calculateFees(gasLimit uint64) cosmostypes.Coins {
+	gasLimitDec := cosmostypes.NewDecFromInt(cosmostypes.NewIntFromUint64(gasLimit))
+
+	fees := make(cosmostypes.Coins, 0, len(tClient.gasPrices))
+	for _, gasPrice := range tClient.gasPrices {
+		fee := gasPrice.Amount.Mul(gasLimitDec).Ceil().RoundInt()
+		fees = append(fees, cosmostypes.NewCoin(gasPrice.Denom, fee))
+	}
+
+	return fees.Sort()
+}
+
 // addPendingTransactions registers a new pending transaction for monitoring and
 // notification of asynchronous errors. It accomplishes the following:
 //
@@ -3,13 +3,16 @@ package tx_test
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"testing"
 	"time"
 
 	"cosmossdk.io/depinject"
 	cometbytes "github.com/cometbft/cometbft/libs/bytes"
+	comettypes "github.com/cometbft/cometbft/types"
 	cosmoskeyring "github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/types"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
@@ -119,6 +122,134 @@ func TestTxClient_SignAndBroadcast_Succeeds(t *testing.T) {
 	}
 }
 
+func TestTxClient_SignAndBroadcast_GasAndFees(t *testing.T) {
+	var (
+		// expectedTx is the expected transactions bytes that will be signed and broadcast
+		// by the transaction client. It is computed and assigned in the
+		// testtx.NewOneTimeTxTxContext helper function.
+		expectedTx        cometbytes.HexBytes
+		eventsBzPublishCh chan<- either.Bytes
+		blocksPublishCh   chan client.Block
+		ctx               = context.Background()
+		gasAdjustment     = 2.0
+		gasPrices         = types.NewDecCoins(types.NewDecCoinFromDec("upokt", types.NewDecWithPrec(1, 2)))
+		// expectedGasLimit is the simulated gas multiplied by the gas adjustment.
+		expectedGasLimit = uint64(gasAdjustment * float64(testtx.DefaultSimulatedGasUsed))
+		// expectedFees is the gas price (0.01upokt) multiplied by the gas limit.
+		expectedFees = types.NewCoins(types.NewInt64Coin("upokt", int64(expectedGasLimit/100)))
+	)
+
+	keyring, signingKey := testkeyring.NewTestKeyringWithKey(t, testSigningKeyName)
+
+	eventsQueryClient := testeventsquery.NewOneTimeTxEventsQueryClient(
+		ctx, t, signingKey, &eventsBzPublishCh,
+	)
+
+	txCtxMock := testtx.NewOneTimeTxTxContext(
+		t, keyring,
+		testSigningKeyName,
+		&expectedTx,
+	)
+
+	blockClientMock := testblock.NewOneTimeCommittedBlocksSequenceBlockClient(
+		t, blocksPublishCh,
+	)
+
+	txClientDeps := depinject.Supply(
+		eventsQueryClient,
+		txCtxMock,
+		blockClientMock,
+	)
+
+	signingKeyAddr, err := signingKey.GetAddress()
+	require.NoError(t, err)
+
+	// Construct the transaction client such that the signer is also the fee
+	// granter; this is sufficient to assert that the field is set.
+	txClient, err := tx.NewTxClient(
+		ctx, txClientDeps,
+		tx.WithSigningKeyName(testSigningKeyName),
+		tx.WithGasAdjustment(gasAdjustment),
+		tx.WithGasPrices(gasPrices),
+		tx.WithFeeGranter(signingKeyAddr.String()),
+	)
+	require.NoError(t, err)
+
+	appStake := types.NewCoin("upokt", types.NewInt(1000000))
+	appStakeMsg := &apptypes.MsgStakeApplication{
+		Address:  signingKeyAddr.String(),
+		Stake:    &appStake,
+		Services: client.NewTestApplicationServiceConfig(testServiceIdPrefix, 2),
+	}
+
+	eitherErr := txClient.SignAndBroadcast(ctx, appStakeMsg)
+	err, _ = eitherErr.SyncOrAsyncError()
+	require.NoError(t, err)
+
+	// Decode the broadcast transaction and assert its gas limit, fees and fee granter.
+	broadcastTx, err := testclient.EncodingConfig.TxConfig.TxDecoder()(expectedTx)
+	require.NoError(t, err)
+
+	feeTx, ok := broadcastTx.(types.FeeTx)
+	require.True(t, ok)
+	require.Equal(t, expectedGasLimit, feeTx.GetGas())
+	require.Equal(t, expectedFees, feeTx.GetFee())
+	require.Equal(t, signingKeyAddr, feeTx.FeeGranter())
+}
+
+func TestTxClient_NewTxClient_InvalidGasConfig(t *testing.T) {
+	keyring, _ := testkeyring.NewTestKeyringWithKey(t, testSigningKeyName)
+
+	tests := []struct {
+		desc string
+		opt  client.TxClientOption
+	}{
+		{
+			desc: "gas adjustment less than 1",
+			opt:  tx.WithGasAdjustment(0.5),
+		},
+		{
+			desc: "non-positive gas price",
+			opt: tx.WithGasPrices(types.DecCoins{
+				{Denom: "upokt", Amount: types.ZeroDec()},
+			}),
+		},
+		{
+			desc: "invalid fee granter address",
+			opt:  tx.WithFeeGranter("invalid_address"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			var (
+				ctrl = gomock.NewController(t)
+				ctx  = context.Background()
+			)
+
+			// Since we expect the NewTxClient call to fail, we don't need to set
+			// any expectations on these mocks.
+			eventsQueryClient := mockclient.NewMockEventsQueryClient(ctrl)
+			txCtxMock, _ := testtx.NewAnyTimesTxTxContext(t, keyring)
+			blockClientMock := mockclient.NewMockBlockClient(ctrl)
+
+			txClientDeps := depinject.Supply(
+				eventsQueryClient,
+				txCtxMock,
+				blockClientMock,
+			)
+
+			txClient, err := tx.NewTxClient(
+				ctx, txClientDeps,
+				tx.WithSigningKeyName(testSigningKeyName),
+				tt.opt,
+			)
+			require.ErrorIs(t, err, tx.ErrInvalidGasConfig)
+			require.Nil(t, txClient)
+		})
+	}
+}
+
 func TestTxClient_NewTxClient_Error(t *testing.T) {
 	// Construct an empty in-memory keyring.
 	memKeyring := cosmoskeyring.NewInMemory(testclient.EncodingConfig.Marshaler)
@@ -402,7 +533,323 @@ func TestTxClient_SignAndBroadcast_Timeout(t *testing.T) {
 	}
 }
 
-// TODO_TECHDEBT: add coverage for sending multiple messages simultaneously
-func TestTxClient_SignAndBroadcast_MultipleMsgs(t *testing.T) {
-	t.SkipNow()
+func TestTxClient_SignAndBroadcast_SequenceMismatch(t *testing.T) {
+	var (
+		expectedTx        cometbytes.HexBytes
+		eventsBzPublishCh chan<- either.Bytes
+		blocksPublishCh   chan client.Block
+		ctx               = context.Background()
+		// networkSequence is the sequence which the network expects; it is ahead
+		// of the one returned by the (mock) account query (i.e. 1) as if there
+		// were pending transactions in the mempool.
+		networkSequence = uint64(5)
+		// broadcastSequences are the sequences of each broadcast transaction, in order.
+		broadcastSequences []uint64
+	)
+
+	keyring, signingKey := testkeyring.NewTestKeyringWithKey(t, testSigningKeyName)
+
+	eventsQueryClient := testeventsquery.NewOneTimeTxEventsQueryClient(
+		ctx, t, signingKey, &eventsBzPublishCh,
+	)
+
+	txCtxMock := testtx.NewBaseTxContext(
+		t, testSigningKeyName,
+		keyring,
+		&expectedTx,
+	)
+
+	// intercept #BroadcastTx() call to mock the network's account sequence check.
+	txCtxMock.EXPECT().BroadcastTx(gomock.Any()).
+		DoAndReturn(
+			func(txBz []byte) (*types.TxResponse, error) {
+				var txHash cometbytes.HexBytes = comettypes.Tx(txBz).Hash()
+				sequence := getTxSignerSequence(t, txBz)
+				broadcastSequences = append(broadcastSequences, sequence)
+
+				if sequence != networkSequence {
+					return &types.TxResponse{
+						TxHash:    txHash.String(),
+						Code:      32,
+						Codespace: "sdk",
+						RawLog: fmt.Sprintf(
+							"account sequence mismatch, expected %d, got %d: incorrect account sequence",
+							networkSequence, sequence,
+						),
+					}, nil
+				}
+
+				networkSequence++
+				return &types.TxResponse{
+					Height: 1,
+					TxHash: txHash.String(),
+				}, nil
+			},
+		).Times(3)
+
+	blockClientMock := testblock.NewOneTimeCommittedBlocksSequenceBlockClient(
+		t, blocksPublishCh,
+	)
+
+	txClientDeps := depinject.Supply(
+		eventsQueryClient,
+		txCtxMock,
+		blockClientMock,
+	)
+
+	txClient, err := tx.NewTxClient(
+		ctx, txClientDeps, tx.WithSigningKeyName(testSigningKeyName),
+	)
+	require.NoError(t, err)
+
+	signingKeyAddr, err := signingKey.GetAddress()
+	require.NoError(t, err)
+
+	appStake := types.NewCoin("upokt", types.NewInt(1000000))
+	appStakeMsg := &apptypes.MsgStakeApplication{
+		Address:  signingKeyAddr.String(),
+		Stake:    &appStake,
+		Services: client.NewTestApplicationServiceConfig(testServiceIdPrefix, 2),
+	}
+
+	// The first transaction is re-signed with the sequence which the network
+	// expects after the first broadcast fails.
+	err, _ = txClient.SignAndBroadcast(ctx, appStakeMsg).SyncOrAsyncError()
+	require.NoError(t, err)
+
+	// The second transaction is signed with the locally incremented sequence.
+	err, _ = txClient.SignAndBroadcast(ctx, appStakeMsg).SyncOrAsyncError()
+	require.NoError(t, err)
+
+	require.Equal(t, []uint64{1, 5, 6}, broadcastSequences)
+}
+
+func TestTxClient_SignAndBroadcast_Batching(t *testing.T) {
+	var (
+		expectedTx        cometbytes.HexBytes
+		eventsBzPublishCh chan<- either.Bytes
+		blocksPublishCh   chan client.Block
+		ctx               = context.Background()
+		// broadcastTxCh receives the bytes of the batched transaction when it
+		// is broadcast.
+		broadcastTxCh = make(chan []byte, 1)
+	)
+
+	keyring, signingKey := testkeyring.NewTestKeyringWithKey(t, testSigningKeyName)
+
+	eventsQueryClient := testeventsquery.NewOneTimeTxEventsQueryClient(
+		ctx, t, signingKey, &eventsBzPublishCh,
+	)
+
+	txCtxMock := testtx.NewBaseTxContext(
+		t, testSigningKeyName,
+		keyring,
+		&expectedTx,
+	)
+
+	// intercept #BroadcastTx() call to mock response and capture the batched tx.
+	txCtxMock.EXPECT().BroadcastTx(gomock.Any()).
+		DoAndReturn(
+			func(txBz []byte) (*types.TxResponse, error) {
+				var txHash cometbytes.HexBytes = comettypes.Tx(txBz).Hash()
+				broadcastTxCh <- txBz
+				return &types.TxResponse{
+					Height: 1,
+					TxHash: txHash.String(),
+				}, nil
+			},
+		).Times(1)
+
+	blockClientMock := testblock.NewOneTimeCommittedBlocksSequenceBlockClient(
+		t, blocksPublishCh,
+	)
+
+	txClientDeps := depinject.Supply(
+		eventsQueryClient,
+		txCtxMock,
+		blockClientMock,
+	)
+
+	// Construct the transaction client with a batch window long enough that the
+	// batch is only broadcast upon reaching the maximum number of messages.
+	txClient, err := tx.NewTxClient(
+		ctx, txClientDeps,
+		tx.WithSigningKeyName(testSigningKeyName),
+		tx.WithBatching(time.Minute, 2),
+	)
+	require.NoError(t, err)
+
+	signingKeyAddr, err := signingKey.GetAddress()
+	require.NoError(t, err)
+
+	appStake := types.NewCoin("upokt", types.NewInt(1000000))
+	appStakeMsg := &apptypes.MsgStakeApplication{
+		Address:  signingKeyAddr.String(),
+		Stake:    &appStake,
+		Services: client.NewTestApplicationServiceConfig(testServiceIdPrefix, 2),
+	}
+
+	err, errCh1 := txClient.SignAndBroadcast(ctx, appStakeMsg).SyncOrAsyncError()
+	require.NoError(t, err)
+
+	err, errCh2 := txClient.SignAndBroadcast(ctx, appStakeMsg).SyncOrAsyncError()
+	require.NoError(t, err)
+
+	var txBz []byte
+	select {
+	case txBz = <-broadcastTxCh:
+	case <-time.After(time.Second):
+		t.Fatal("test timed out waiting for the batched tx to be broadcast")
+	}
+
+	// Assert that the messages of both calls were broadcast in a single transaction.
+	broadcastTx, err := testclient.EncodingConfig.TxConfig.TxDecoder()(txBz)
+	require.NoError(t, err)
+	require.Len(t, broadcastTx.GetMsgs(), 2)
+
+	// Give the client some time to register the transaction as pending.
+	time.Sleep(10 * time.Millisecond)
+
+	txEventBz, err := json.Marshal(&tx.TxEvent{Tx: txBz})
+	require.NoError(t, err)
+	eventsBzPublishCh <- either.Success[[]byte](txEventBz)
+
+	// Assert that both calls' error channels were closed without receiving.
+	for _, errCh := range []chan error{errCh1, errCh2} {
+		select {
+		case err, ok := <-errCh:
+			require.NoError(t, err)
+			require.Falsef(t, ok, "expected errCh to be closed")
+		case <-time.After(txCommitTimeout):
+			t.Fatal("test timed out waiting for errCh to receive")
+		}
+	}
+}
+
+func TestTxClient_SignAndBroadcast_BatchingWithCancelledCallerContext(t *testing.T) {
+	var (
+		expectedTx        cometbytes.HexBytes
+		eventsBzPublishCh chan<- either.Bytes
+		blocksPublishCh   chan client.Block
+		ctx               = context.Background()
+		// broadcastTxCh receives the bytes of the batched transaction when it
+		// is broadcast.
+		broadcastTxCh = make(chan []byte, 1)
+	)
+
+	keyring, signingKey := testkeyring.NewTestKeyringWithKey(t, testSigningKeyName)
+
+	eventsQueryClient := testeventsquery.NewOneTimeTxEventsQueryClient(
+		ctx, t, signingKey, &eventsBzPublishCh,
+	)
+
+	txCtxMock := testtx.NewBaseTxContext(
+		t, testSigningKeyName,
+		keyring,
+		&expectedTx,
+	)
+
+	// intercept #BroadcastTx() call to mock response and capture the batched tx.
+	txCtxMock.EXPECT().BroadcastTx(gomock.Any()).
+		DoAndReturn(
+			func(txBz []byte) (*types.TxResponse, error) {
+				var txHash cometbytes.HexBytes = comettypes.Tx(txBz).Hash()
+				broadcastTxCh <- txBz
+				return &types.TxResponse{
+					Height: 1,
+					TxHash: txHash.String(),
+				}, nil
+			},
+		).Times(1)
+
+	blockClientMock := testblock.NewOneTimeCommittedBlocksSequenceBlockClient(
+		t, blocksPublishCh,
+	)
+
+	txClientDeps := depinject.Supply(
+		eventsQueryClient,
+		txCtxMock,
+		blockClientMock,
+	)
+
+	// Construct the transaction client such that the batch is only broadcast
+	// once the batch window has elapsed.
+	txClient, err := tx.NewTxClient(
+		ctx, txClientDeps,
+		tx.WithSigningKeyName(testSigningKeyName),
+		tx.WithBatching(100*time.Millisecond, 10),
+	)
+	require.NoError(t, err)
+
+	signingKeyAddr, err := signingKey.GetAddress()
+	require.NoError(t, err)
+
+	appStake := types.NewCoin("upokt", types.NewInt(1000000))
+	appStakeMsg := &apptypes.MsgStakeApplication{
+		Address:  signingKeyAddr.String(),
+		Stake:    &appStake,
+		Services: client.NewTestApplicationServiceConfig(testServiceIdPrefix, 2),
+	}
+
+	// The caller which opens the batch cancels its context before it is broadcast.
+	cancelledCtx, cancel := context.WithCancel(ctx)
+	err, errCh1 := txClient.SignAndBroadcast(cancelledCtx, appStakeMsg).SyncOrAsyncError()
+	require.NoError(t, err)
+
+	err, errCh2 := txClient.SignAndBroadcast(ctx, appStakeMsg).SyncOrAsyncError()
+	require.NoError(t, err)
+	cancel()
+
+	// Only the wait of the caller whose context was cancelled is aborted.
+	select {
+	case err := <-errCh1:
+		require.ErrorIs(t, err, context.Canceled)
+	case <-time.After(time.Second):
+		t.Fatal("test timed out waiting for errCh1 to receive")
+	}
+
+	var txBz []byte
+	select {
+	case txBz = <-broadcastTxCh:
+	case <-time.After(time.Second):
+		t.Fatal("test timed out waiting for the batched tx to be broadcast")
+	}
+
+	// Assert that the messages of both calls were still broadcast.
+	broadcastTx, err := testclient.EncodingConfig.TxConfig.TxDecoder()(txBz)
+	require.NoError(t, err)
+	require.Len(t, broadcastTx.GetMsgs(), 2)
+
+	// Give the client some time to register the transaction as pending.
+	time.Sleep(10 * time.Millisecond)
+
+	txEventBz, err := json.Marshal(&tx.TxEvent{Tx: txBz})
+	require.NoError(t, err)
+	eventsBzPublishCh <- either.Success[[]byte](txEventBz)
+
+	select {
+	case err, ok := <-errCh2:
+		require.NoError(t, err)
+		require.Falsef(t, ok, "expected errCh to be closed")
+	case <-time.After(txCommitTimeout):
+		t.Fatal("test timed out waiting for errCh2 to receive")
+	}
+}
+
+// getTxSignerSequence decodes the given transaction bytes and returns the
+// sequence of its (single) signature.
+func getTxSignerSequence(t *testing.T, txBz []byte) uint64 {
+	t.Helper()
+
+	decodedTx, err := testclient.EncodingConfig.TxConfig.TxDecoder()(txBz)
+	require.NoError(t, err)
+
+	sigTx, ok := decodedTx.(authsigning.SigVerifiableTx)
+	require.True(t, ok)
+
+	sigs, err := sigTx.GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigs, 1)
+
+	return sigs[0].Sequence
 }
@@ -9,6 +9,8 @@ import (
 	cosmostx "github.com/cosmos/cosmos-sdk/client/tx"
 	cosmoskeyring "github.com/cosmos/cosmos-sdk/crypto/keyring"
 	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
 
 	"github.com/pokt-network/poktroll/pkg/client"
@@ -54,21 +56,37 @@ func (txCtx cosmosTxContext) GetKeyring() cosmoskeyring.Keyring {
 	return txCtx.txFactory.Keybase()
 }
 
-// SignTx signs the provided transaction using the given key name. It can operate in offline mode
-// and can optionally overwrite any existing signatures.
+// GetAccountNumberSequence queries for the account number and sequence of the
+// account with the given address using the client context's account retriever.
+func (txCtx cosmosTxContext) GetAccountNumberSequence(
+	addr cosmostypes.AccAddress,
+) (accountNumber, sequence uint64, err error) {
+	clientCtx := cosmosclient.Context(txCtx.clientCtx)
+	return clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, addr)
+}
+
+// SignTx signs the provided transaction using the given key name, account number
+// and sequence, and can optionally overwrite any existing signatures. It signs in
+// offline mode such that the given account number and sequence are used rather
+// than those of the latest committed state.
 // It is a proxy to the cosmos-sdk auth module client SignTx function.
 // (see: https://pkg.go.dev/github.com/cosmos/cosmos-sdk@v0.47.5/x/auth/client)
 func (txCtx cosmosTxContext) SignTx(
 	signingKeyName string,
 	txBuilder cosmosclient.TxBuilder,
-	offline, overwriteSig bool,
+	accountNumber, sequence uint64,
+	overwriteSig bool,
 ) error {
+	txFactory := txCtx.txFactory.
+		WithAccountNumber(accountNumber).
+		WithSequence(sequence)
+
 	return authclient.SignTx(
-		txCtx.txFactory,
+		txFactory,
 		cosmosclient.Context(txCtx.clientCtx),
 		signingKeyName,
 		txBuilder,
-		offline, overwriteSig,
+		true, overwriteSig,
 	)
 }
 
@@ -82,6 +100,60 @@ func (txCtx cosmosTxContext) EncodeTx(txBuilder cosmosclient.TxBuilder) ([]byte,
 	return txCtx.clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
 }
 
+// Simulate builds a transaction containing the given messages with an empty
+// signature from the key with the given name and simulates its execution against
+// the node's check state, returning the amount of gas consumed. As the check
+// state includes pending (mempool) transactions, sequence MUST account for them
+// in order for the simulated ante handler checks to succeed.
+// (see: https://pkg.go.dev/github.com/cosmos/cosmos-sdk@v0.47.5/client/tx#CalculateGas)
+func (txCtx cosmosTxContext) Simulate(
+	ctx context.Context,
+	signingKeyName string,
+	sequence uint64,
+	msgs ...cosmostypes.Msg,
+) (gasUsed uint64, err error) {
+	clientCtx := cosmosclient.Context(txCtx.clientCtx)
+
+	signingKey, err := txCtx.GetKeyring().Key(signingKeyName)
+	if err != nil {
+		return 0, err
+	}
+
+	signingPubKey, err := signingKey.GetPubKey()
+	if err != nil {
+		return 0, err
+	}
+
+	txBuilder := txCtx.NewTxBuilder()
+	if err := txBuilder.SetMsgs(msgs...); err != nil {
+		return 0, err
+	}
+
+	// Set an empty signature; the ante handler skips signature verification
+	// in simulation mode but still consumes the corresponding amount of gas.
+	if err := txBuilder.SetSignatures(signingtypes.SignatureV2{
+		PubKey:   signingPubKey,
+		Data:     &signingtypes.SingleSignatureData{SignMode: txCtx.txFactory.SignMode()},
+		Sequence: sequence,
+	}); err != nil {
+		return 0, err
+	}
+
+	txBz, err := txCtx.EncodeTx(txBuilder)
+	if err != nil {
+		return 0, err
+	}
+
+	simRes, err := txtypes.NewServiceClient(clientCtx).Simulate(
+		ctx, &txtypes.SimulateRequest{TxBytes: txBz},
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return simRes.GetGasInfo().GetGasUsed(), nil
+}
+
 // BroadcastTx broadcasts the given transaction to the network, blocking until the check-tx
 // ABCI operation completes and returns a TxResponse of the transaction status at that point in time.
 func (txCtx cosmosTxContext) BroadcastTx(txBytes []byte) (*cosmostypes.TxResponse, error) {
@@ -37,5 +37,21 @@ var (
 	// bytes into the corresponding Tx structure or object.
 	ErrUnmarshalTx = errorsmod.Register(codespace, 10, "failed to unmarshal tx")
 
+	// ErrSimulateTx signals a failure to simulate a transaction in order to
+	// estimate the amount of gas it will consume.
+	ErrSimulateTx = errorsmod.Register(codespace, 11, "failed to simulate tx")
+
+	// ErrInvalidGasConfig indicates that the gas adjustment, gas prices, or fee
+	// granter which the client was configured with are invalid.
+	ErrInvalidGasConfig = errorsmod.Register(codespace, 12, "invalid gas configuration")
+
+	// ErrAccountSequence signals a failure to determine the account number and
+	// sequence of the signing account.
+	ErrAccountSequence = errorsmod.Register(codespace, 13, "failed to get account sequence")
+
+	// ErrInvalidBatchConfig indicates that the message batching window or maximum
+	// batch size which the client was configured with are invalid.
+	ErrInvalidBatchConfig = errorsmod.Register(codespace, 14, "invalid batching configuration")
+
 	codespace = "tx_client"
 )
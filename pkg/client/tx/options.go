@@ -1,6 +1,10 @@
 package tx
 
 import (
+	"time"
+
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/pokt-network/poktroll/pkg/client"
 )
 
@@ -20,3 +24,39 @@ func WithSigningKeyName(keyName string) client.TxClientOption {
 		client.(*txClient).signingKeyName = keyName
 	}
 }
+
+// WithGasAdjustment sets the factor by which the simulated gas consumption of
+// each transaction is multiplied in order to determine its gas limit.
+func WithGasAdjustment(gasAdjustment float64) client.TxClientOption {
+	return func(client client.TxClient) {
+		client.(*txClient).gasAdjustment = gasAdjustment
+	}
+}
+
+// WithGasPrices sets the gas prices which are used to calculate the fees of each
+// transaction from its gas limit.
+func WithGasPrices(gasPrices cosmostypes.DecCoins) client.TxClientOption {
+	return func(client client.TxClient) {
+		client.(*txClient).gasPrices = gasPrices
+	}
+}
+
+// WithFeeGranter sets the bech32 address of an account which has granted a fee
+// allowance to the signing key's account; it is set as the fee granter of each
+// transaction such that it pays the fees instead of the signer.
+func WithFeeGranter(feeGranterAddress string) client.TxClientOption {
+	return func(client client.TxClient) {
+		client.(*txClient).feeGranterAddress = feeGranterAddress
+	}
+}
+
+// WithBatching enables batching of the messages of SignAndBroadcast calls which
+// are made within batchWindow of each other into a single transaction, which is
+// broadcast early if it reaches maxBatchMsgs messages. If maxBatchMsgs is zero,
+// DefaultMaxBatchMsgs is used.
+func WithBatching(batchWindow time.Duration, maxBatchMsgs int) client.TxClientOption {
+	return func(client client.TxClient) {
+		client.(*txClient).batchWindow = batchWindow
+		client.(*txClient).maxBatchMsgs = maxBatchMsgs
+	}
+}
@@ -0,0 +1,136 @@
+package tx
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/pokt-network/poktroll/pkg/client"
+)
+
+const (
+	// maxSequenceMismatchRetries is the number of times that a transaction is
+	// re-signed and re-broadcast after failing due to an account sequence
+	// mismatch before the error is returned to the caller.
+	maxSequenceMismatchRetries = 3
+	// incorrectSequenceErrMsg is the message of the cosmos-sdk ErrWrongSequence
+	// error which is included in the raw log of transactions which fail the
+	// ante handler's sequence check.
+	// (see: https://pkg.go.dev/github.com/cosmos/cosmos-sdk@v0.47.5/types/errors#ErrWrongSequence)
+	incorrectSequenceErrMsg = "incorrect account sequence"
+)
+
+// expectedSequenceRegex matches the sequence which the ante handler expected in
+// the message of an account sequence mismatch error; e.g.:
+// "account sequence mismatch, expected 5, got 4: incorrect account sequence".
+var expectedSequenceRegex = regexp.MustCompile(`account sequence mismatch, expected (\d+)`)
+
+// accountSequencer tracks the account number and next sequence of the signing
+// account locally such that concurrently broadcast transactions don't race on
+// the sequence of the latest committed state, which doesn't account for those
+// which are still in the mempool.
+type accountSequencer struct {
+	// mu serializes the signing and broadcasting of transactions such that each
+	// is assigned a distinct, consecutive sequence.
+	mu sync.Mutex
+	// txCtx is used to query for the account number and sequence of addr.
+	txCtx client.TxContext
+	// addr is the address of the signing account.
+	addr cosmostypes.AccAddress
+	// synced is true when accountNumber and nextSequence reflect the network
+	// state. It is false until the first query and whenever a sequence mismatch
+	// error which doesn't include the expected sequence is encountered.
+	synced        bool
+	accountNumber uint64
+	nextSequence  uint64
+}
+
+// newAccountSequencer constructs a new accountSequencer for the account with the
+// given address. The account number and sequence are lazily queried on first use.
+func newAccountSequencer(
+	txCtx client.TxContext,
+	addr cosmostypes.AccAddress,
+) *accountSequencer {
+	return &accountSequencer{
+		txCtx: txCtx,
+		addr:  addr,
+	}
+}
+
+// withNextSequence calls fn with the account number and next sequence of the
+// signing account, holding the lock for its duration. If fn succeeds, the next
+// sequence is incremented. If fn returns an account sequence mismatch error, the
+// next sequence is updated to the one the network expects (or re-queried if it
+// can't be determined from the error) and fn is retried, up to
+// maxSequenceMismatchRetries times. Any other error leaves the next sequence
+// unchanged and is returned as is.
+func (seq *accountSequencer) withNextSequence(
+	fn func(accountNumber, sequence uint64) error,
+) error {
+	seq.mu.Lock()
+	defer seq.mu.Unlock()
+
+	for retries := 0; ; retries++ {
+		if !seq.synced {
+			if err := seq.sync(); err != nil {
+				return err
+			}
+		}
+
+		err := fn(seq.accountNumber, seq.nextSequence)
+		if err == nil {
+			seq.nextSequence++
+			return nil
+		}
+
+		if !isSequenceMismatchError(err) || retries >= maxSequenceMismatchRetries {
+			return err
+		}
+
+		if expectedSequence, ok := parseExpectedSequence(err); ok {
+			seq.nextSequence = expectedSequence
+		} else {
+			seq.synced = false
+		}
+	}
+}
+
+// sync queries for the account number and sequence of the signing account as of
+// the latest committed state. It MUST be called while holding the lock.
+func (seq *accountSequencer) sync() error {
+	accountNumber, sequence, err := seq.txCtx.GetAccountNumberSequence(seq.addr)
+	if err != nil {
+		return ErrAccountSequence.Wrapf("querying account %s: %s", seq.addr, err)
+	}
+
+	seq.accountNumber = accountNumber
+	seq.nextSequence = sequence
+	seq.synced = true
+
+	return nil
+}
+
+// isSequenceMismatchError returns true if the given error was caused by the
+// transaction having been signed with an incorrect account sequence.
+func isSequenceMismatchError(err error) bool {
+	return strings.Contains(err.Error(), incorrectSequenceErrMsg)
+}
+
+// parseExpectedSequence extracts the sequence which the network expected from
+// the given account sequence mismatch error, if present.
+func parseExpectedSequence(err error) (uint64, bool) {
+	matches := expectedSequenceRegex.FindStringSubmatch(err.Error())
+	if len(matches) != 2 {
+		return 0, false
+	}
+
+	expectedSequence, parseErr := strconv.ParseUint(matches[1], 10, 64)
+	if parseErr != nil {
+		return 0, false
+	}
+
+	return expectedSequence, true
+}
@@ -0,0 +1,17 @@
+package crypto
+
+import (
+	"context"
+
+	ring "github.com/noot/ring-go"
+)
+
+// RingClient is used to build the rings which relay requests are signed with,
+// on behalf of applications, and verified against.
+type RingClient interface {
+	// GetRingForAddress returns the ring of the application with the given
+	// address, as of the session of the given block height; i.e. the ring of
+	// the public keys of the application and of the gateways it was delegated
+	// to during that session.
+	GetRingForAddress(ctx context.Context, appAddress string, blockHeight int64) (*ring.Ring, error)
+}
@@ -0,0 +1,121 @@
+package rings
+
+import (
+	"context"
+
+	"cosmossdk.io/depinject"
+	ring_secp256k1 "github.com/athanorlabs/go-dleq/secp256k1"
+	ringtypes "github.com/athanorlabs/go-dleq/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	ring "github.com/noot/ring-go"
+
+	"github.com/pokt-network/poktroll/pkg/client"
+	"github.com/pokt-network/poktroll/pkg/crypto"
+)
+
+var _ crypto.RingClient = (*ringClient)(nil)
+
+// ringClient implements the crypto.RingClient interface by getting the ring
+// addresses of applications and their public keys from the shared queriers.
+type ringClient struct {
+	// applicationQuerier is used to get the addresses which make up the ring of
+	// an application as of a given session.
+	applicationQuerier client.ApplicationQuerier
+
+	// accountQuerier is used to get the public keys of the ring addresses.
+	accountQuerier client.AccountQuerier
+}
+
+// NewRingClient returns a new crypto.RingClient by injecting the dependencies
+// provided by the depinject.Config.
+//
+// Required dependencies:
+//   - client.ApplicationQuerier
+//   - client.AccountQuerier
+func NewRingClient(deps depinject.Config) (crypto.RingClient, error) {
+	rc := &ringClient{}
+
+	if err := depinject.Inject(
+		deps,
+		&rc.applicationQuerier,
+		&rc.accountQuerier,
+	); err != nil {
+		return nil, err
+	}
+
+	return rc, nil
+}
+
+// GetRingForAddress returns the ring of the application with the given address
+// as of the session of the given block height. It does so by getting the
+// application's ring addresses as of that height, and converting their public
+// keys to points on the secp256k1 curve in order to create the ring.
+func (rc *ringClient) GetRingForAddress(
+	ctx context.Context,
+	appAddress string,
+	blockHeight int64,
+) (*ring.Ring, error) {
+	points, err := rc.getDelegatedPubKeysForAddress(ctx, appAddress, blockHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	return newRingFromPoints(points)
+}
+
+// newRingFromPoints creates a new ring from a slice of points on the secp256k1 curve
+func newRingFromPoints(points []ringtypes.Point) (*ring.Ring, error) {
+	return ring.NewFixedKeyRingFromPublicKeys(ring_secp256k1.NewCurve(), points)
+}
+
+// getDelegatedPubKeysForAddress returns the points on the secp256k1 curve of the
+// public keys which make up the ring of the given application as of the given
+// block height.
+func (rc *ringClient) getDelegatedPubKeysForAddress(
+	ctx context.Context,
+	appAddress string,
+	blockHeight int64,
+) ([]ringtypes.Point, error) {
+	// get the application's ring as of the given height; i.e. the addresses of
+	// the application and of the gateways it was delegated to in that session.
+	ringAddresses, err := rc.applicationQuerier.GetApplicationRing(ctx, appAddress, blockHeight)
+	if err != nil {
+		return nil, ErrRingsRetrieveRing.Wrapf("application address: %s [%s]", appAddress, err)
+	}
+
+	if len(ringAddresses) < 2 {
+		// add app address twice to make the ring size of mininmum 2
+		// TODO_TECHDEBT: We are adding the appAddress twice because a ring
+		// signature requires AT LEAST two pubKeys. When the Application has
+		// not delegated to any gateways, we add the application's own address
+		// twice. This is a HACK and should be investigated as to what is the
+		// best approach to take in this situation.
+		ringAddresses = []string{appAddress, appAddress}
+	}
+
+	// get the points on the secp256k1 curve for the addresses
+	return rc.addressesToPoints(ctx, ringAddresses)
+}
+
+// addressesToPoints converts a slice of addresses to a slice of points on the
+// secp256k1 curve, by getting the public key for each address and converting
+// them to the corresponding points on the secp256k1 curve
+func (rc *ringClient) addressesToPoints(ctx context.Context, addresses []string) ([]ringtypes.Point, error) {
+	curve := ring_secp256k1.NewCurve()
+	points := make([]ringtypes.Point, len(addresses))
+	for i, addr := range addresses {
+		key, err := rc.accountQuerier.GetPubKeyFromAddress(ctx, addr)
+		if err != nil {
+			return nil, ErrRingsRetrievePubKey.Wrapf("address: %s [%s]", addr, err)
+		}
+		if _, ok := key.(*secp256k1.PubKey); !ok {
+			return nil, ErrRingsInvalidPubKeyType.Wrapf("address: %s; got %T", addr, key)
+		}
+		point, err := curve.DecodeToPoint(key.Bytes())
+		if err != nil {
+			return nil, err
+		}
+		points[i] = point
+	}
+	return points, nil
+}
@@ -0,0 +1,90 @@
+package rings_test
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/depinject"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/pkg/crypto/rings"
+	"github.com/pokt-network/poktroll/testutil/mockclient"
+)
+
+func TestRingClient_GetRingForAddress(t *testing.T) {
+	const sessionStartHeight = 4
+
+	pubKeys := map[string]cryptotypes.PubKey{
+		"app":            secp256k1.GenPrivKey().PubKey(),
+		"gateway":        secp256k1.GenPrivKey().PubKey(),
+		"ed25519Gateway": ed25519.GenPrivKey().PubKey(),
+	}
+
+	tests := []struct {
+		desc             string
+		ringAddresses    []string
+		expectedRingSize int
+		expectedErr      error
+	}{
+		{
+			desc:             "application without delegations",
+			ringAddresses:    []string{"app"},
+			expectedRingSize: 2,
+		},
+		{
+			desc:             "application delegated to a gateway",
+			ringAddresses:    []string{"app", "gateway"},
+			expectedRingSize: 2,
+		},
+		{
+			desc:          "unknown public key",
+			ringAddresses: []string{"app", "unknownGateway"},
+			expectedErr:   rings.ErrRingsRetrievePubKey,
+		},
+		{
+			desc:          "non-secp256k1 public key",
+			ringAddresses: []string{"app", "ed25519Gateway"},
+			expectedErr:   rings.ErrRingsInvalidPubKeyType,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			ctx := context.Background()
+			ctrl := gomock.NewController(t)
+
+			applicationQuerier := mockclient.NewMockApplicationQuerier(ctrl)
+			applicationQuerier.EXPECT().
+				GetApplicationRing(gomock.Any(), "app", int64(sessionStartHeight)).
+				Return(test.ringAddresses, nil).
+				Times(1)
+
+			accountQuerier := mockclient.NewMockAccountQuerier(ctrl)
+			accountQuerier.EXPECT().
+				GetPubKeyFromAddress(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, address string) (cryptotypes.PubKey, error) {
+					pubKey, ok := pubKeys[address]
+					if !ok {
+						return nil, rings.ErrRingsRetrievePubKey
+					}
+					return pubKey, nil
+				}).
+				AnyTimes()
+
+			ringClient, err := rings.NewRingClient(depinject.Supply(applicationQuerier, accountQuerier))
+			require.NoError(t, err)
+
+			ring, err := ringClient.GetRingForAddress(ctx, "app", sessionStartHeight)
+			if test.expectedErr != nil {
+				require.ErrorIs(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expectedRingSize, ring.Size())
+		})
+	}
+}
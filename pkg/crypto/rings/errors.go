@@ -0,0 +1,10 @@
+package rings
+
+import sdkerrors "cosmossdk.io/errors"
+
+var (
+	codespace                 = "rings"
+	ErrRingsRetrieveRing      = sdkerrors.Register(codespace, 1, "unable to retrieve application ring")
+	ErrRingsRetrievePubKey    = sdkerrors.Register(codespace, 2, "unable to retrieve public key")
+	ErrRingsInvalidPubKeyType = sdkerrors.Register(codespace, 3, "public key is not a secp256k1 key")
+)
@@ -0,0 +1,5 @@
+// Package rings provides an implementation of the crypto.RingClient interface,
+// which the appgate server (via the SDK) and the relayer share to build the
+// application rings which relay requests are ring-signed with and verified
+// against, respectively.
+package rings
@@ -6,8 +6,12 @@ import (
 	"cosmossdk.io/depinject"
 	"github.com/spf13/cobra"
 
+	"github.com/pokt-network/poktroll/pkg/client/application"
 	"github.com/pokt-network/poktroll/pkg/client/block"
 	eventsquery "github.com/pokt-network/poktroll/pkg/client/events_query"
+	"github.com/pokt-network/poktroll/pkg/client/gateway"
+	"github.com/pokt-network/poktroll/pkg/client/query"
+	"github.com/pokt-network/poktroll/pkg/polylog"
 )
 
 // SupplierFn is a function that is used to supply a depinject config.
@@ -36,6 +40,18 @@ func SupplyConfig(
 	return deps, nil
 }
 
+// SupplyLogger returns a new depinject.Config which is supplied with the given
+// deps and the polylog.Logger associated with ctx (see: polylog.Ctx).
+func SupplyLogger(
+	ctx context.Context,
+	deps depinject.Config,
+	_ *cobra.Command,
+) (depinject.Config, error) {
+	logger := polylog.Ctx(ctx)
+
+	return depinject.Configs(deps, depinject.Supply(logger)), nil
+}
+
 // NewSupplyEventsQueryClientFn returns a new function which constructs an
 // EventsQueryClient instance and returns a new depinject.Config which is supplied
 // with the given deps and the new EventsQueryClient.
@@ -70,3 +86,113 @@ func NewSupplyBlockClientFn(pocketNodeWebsocketUrl string) SupplierFn {
 		return depinject.Configs(deps, depinject.Supply(blockClient)), nil
 	}
 }
+
+// SupplyAccountQuerier returns a new depinject.Config which is supplied with
+// the given deps and a new AccountQuerier. The deps MUST already be supplied
+// with a cosmosclient.Context.
+func SupplyAccountQuerier(
+	_ context.Context,
+	deps depinject.Config,
+	_ *cobra.Command,
+) (depinject.Config, error) {
+	accountQuerier, err := query.NewAccountQuerier(deps)
+	if err != nil {
+		return nil, err
+	}
+
+	return depinject.Configs(deps, depinject.Supply(accountQuerier)), nil
+}
+
+// SupplyApplicationQuerier returns a new depinject.Config which is supplied
+// with the given deps and a new ApplicationQuerier. The deps MUST already be
+// supplied with a cosmosclient.Context and an EventsQueryClient.
+func SupplyApplicationQuerier(
+	ctx context.Context,
+	deps depinject.Config,
+	_ *cobra.Command,
+) (depinject.Config, error) {
+	applicationQuerier, err := query.NewApplicationQuerier(ctx, deps)
+	if err != nil {
+		return nil, err
+	}
+
+	return depinject.Configs(deps, depinject.Supply(applicationQuerier)), nil
+}
+
+// SupplySupplierQuerier returns a new depinject.Config which is supplied with
+// the given deps and a new SupplierQuerier. The deps MUST already be supplied
+// with a cosmosclient.Context, an EventsQueryClient and a BlockClient.
+func SupplySupplierQuerier(
+	ctx context.Context,
+	deps depinject.Config,
+	_ *cobra.Command,
+) (depinject.Config, error) {
+	supplierQuerier, err := query.NewSupplierQuerier(ctx, deps)
+	if err != nil {
+		return nil, err
+	}
+
+	return depinject.Configs(deps, depinject.Supply(supplierQuerier)), nil
+}
+
+// SupplySessionQuerier returns a new depinject.Config which is supplied with
+// the given deps and a new SessionQuerier. The deps MUST already be supplied
+// with a cosmosclient.Context and a BlockClient.
+func SupplySessionQuerier(
+	ctx context.Context,
+	deps depinject.Config,
+	_ *cobra.Command,
+) (depinject.Config, error) {
+	sessionQuerier, err := query.NewSessionQuerier(ctx, deps)
+	if err != nil {
+		return nil, err
+	}
+
+	return depinject.Configs(deps, depinject.Supply(sessionQuerier)), nil
+}
+
+// NewSupplyApplicationClientFn returns a function which constructs an
+// ApplicationClient instance, which signs with the key with the given name, and
+// returns a new depinject.Config which is supplied with the given deps and the
+// new ApplicationClient. The deps MUST already be supplied with a TxClient and
+// a TxContext.
+func NewSupplyApplicationClientFn(signingKeyName string) SupplierFn {
+	return func(
+		_ context.Context,
+		deps depinject.Config,
+		_ *cobra.Command,
+	) (depinject.Config, error) {
+		appClient, err := application.NewApplicationClient(
+			deps,
+			application.WithSigningKeyName(signingKeyName),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		return depinject.Configs(deps, depinject.Supply(appClient)), nil
+	}
+}
+
+// NewSupplyGatewayClientFn returns a function which constructs a GatewayClient
+// instance, which signs with the key with the given name, and returns a new
+// depinject.Config which is supplied with the given deps and the new
+// GatewayClient. The deps MUST already be supplied with a TxClient and a
+// TxContext.
+func NewSupplyGatewayClientFn(signingKeyName string) SupplierFn {
+	return func(
+		_ context.Context,
+		deps depinject.Config,
+		_ *cobra.Command,
+	) (depinject.Config, error) {
+		gatewayClient, err := gateway.NewGatewayClient(
+			deps,
+			gateway.WithSigningKeyName(signingKeyName),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		return depinject.Configs(deps, depinject.Supply(gatewayClient)), nil
+	}
+}
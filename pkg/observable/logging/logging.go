@@ -2,19 +2,19 @@ package logging
 
 import (
 	"context"
-	"log"
 
 	"github.com/pokt-network/poktroll/pkg/observable"
 	"github.com/pokt-network/poktroll/pkg/observable/channel"
+	"github.com/pokt-network/poktroll/pkg/polylog"
 )
 
 // LogErrors operates on an observable of errors. It logs all errors received
-// from the observable.
+// from the observable using the logger associated with ctx (see: polylog.Ctx).
 func LogErrors(ctx context.Context, errs observable.Observable[error]) {
 	channel.ForEach(ctx, errs, forEachErrorLogError)
 }
 
 // forEachErrorLogError is a ForEachFn that logs the given error.
-func forEachErrorLogError(_ context.Context, err error) {
-	log.Print(err)
+func forEachErrorLogError(ctx context.Context, err error) {
+	polylog.Ctx(ctx).Error().Err(err).Send()
 }
@@ -1,8 +1,6 @@
 package partials
 
 import (
-	"log"
-
 	"github.com/pokt-network/poktroll/pkg/partials/payloads"
 	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
 )
@@ -52,7 +50,6 @@ func GetComputeUnits(payloadBz []byte) (uint64, error) {
 // that contains only the fields necessary to generate an error response and
 // handle accounting for the request's method.
 func PartiallyUnmarshalRequest(payloadBz []byte) (PartialPayload, error) {
-	log.Printf("DEBUG: Partially Unmarshalling request: %s", string(payloadBz))
 	// First attempt to unmarshal the payload into a partial JSON-RPC request
 	jsonPayload, err := payloads.PartiallyUnmarshalJSONPayload(payloadBz)
 	if err != nil {
@@ -3,7 +3,6 @@ package payloads
 import (
 	"encoding/json"
 	"errors"
-	"log"
 
 	"github.com/pokt-network/poktroll/x/shared/types"
 )
@@ -32,7 +31,6 @@ func (j PartialJSONPayload) ValidateBasic() error {
 	if j.Method == "" {
 		err = errors.Join(err, errors.New("method field is empty"))
 	}
-	log.Printf("DEBUG: Validating basic JSON payload: %v", err)
 	return err
 }
 
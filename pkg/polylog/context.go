@@ -0,0 +1,31 @@
+package polylog
+
+import "context"
+
+// ctxKey is the unexported type of CtxKey; it prevents collisions with context
+// keys defined in other packages.
+type ctxKey struct{}
+
+// CtxKey is the key used to store a Logger in a context.Context. It is
+// independent of any implementation-specific context key which the underlying
+// logging library may also use (e.g. zerolog.Ctx()).
+var CtxKey = ctxKey{}
+
+// DefaultContextLogger is returned by Ctx when no Logger is associated with the
+// given context. If it is nil, a no-op logger is returned instead.
+var DefaultContextLogger Logger
+
+// Ctx returns the Logger associated with the given context. If no Logger is
+// associated, DefaultContextLogger is returned, unless it is nil, in which case
+// a no-op logger is returned.
+func Ctx(ctx context.Context) Logger {
+	if logger, ok := ctx.Value(CtxKey).(Logger); ok {
+		return logger
+	}
+
+	if DefaultContextLogger != nil {
+		return DefaultContextLogger
+	}
+
+	return noopLogger{}
+}
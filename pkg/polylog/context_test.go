@@ -0,0 +1,40 @@
+package polylog_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/pkg/polylog"
+)
+
+func TestCtx_DefaultsToNoopLogger(t *testing.T) {
+	logger := polylog.Ctx(context.Background())
+	require.NotNil(t, logger)
+
+	event := logger.Info().Str("key", "value")
+	require.False(t, event.Enabled())
+	// Must not panic.
+	event.Msg("discarded")
+}
+
+func TestCtx_DefaultContextLogger(t *testing.T) {
+	defaultLogger := polylog.NewNoopLogger().With("default", true)
+	polylog.DefaultContextLogger = defaultLogger
+	t.Cleanup(func() { polylog.DefaultContextLogger = nil })
+
+	require.Equal(t, defaultLogger, polylog.Ctx(context.Background()))
+}
+
+func TestNoopLogger_WithContext(t *testing.T) {
+	noopLogger := polylog.NewNoopLogger()
+
+	// A no-op logger is not attached to a context without a logger.
+	ctx := context.Background()
+	require.Equal(t, ctx, noopLogger.WithContext(ctx))
+
+	// A no-op logger replaces a logger which is already attached.
+	ctxWithLogger := context.WithValue(ctx, polylog.CtxKey, polylog.NewNoopLogger())
+	require.NotEqual(t, ctxWithLogger, noopLogger.WithContext(ctxWithLogger))
+}
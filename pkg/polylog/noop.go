@@ -0,0 +1,83 @@
+package polylog
+
+import (
+	"context"
+	"time"
+)
+
+var (
+	_ Logger = noopLogger{}
+	_ Event  = noopEvent{}
+)
+
+// noopLogger is a Logger implementation which discards all events.
+type noopLogger struct{}
+
+// noopEvent is an Event implementation which ignores all fields and never sends.
+type noopEvent struct{}
+
+// NewNoopLogger returns a Logger which discards all log events. It is useful
+// for tests and for consumers which need to satisfy a Logger dependency without
+// producing any output.
+func NewNoopLogger() Logger {
+	return noopLogger{}
+}
+
+// Debug implements the respective Logger interface method.
+func (noopLogger) Debug() Event { return noopEvent{} }
+
+// Info implements the respective Logger interface method.
+func (noopLogger) Info() Event { return noopEvent{} }
+
+// Warn implements the respective Logger interface method.
+func (noopLogger) Warn() Event { return noopEvent{} }
+
+// Error implements the respective Logger interface method.
+func (noopLogger) Error() Event { return noopEvent{} }
+
+// WithLevel implements the respective Logger interface method.
+func (noopLogger) WithLevel(int) Event { return noopEvent{} }
+
+// With implements the respective Logger interface method.
+func (nl noopLogger) With(...any) Logger { return nl }
+
+// WithContext implements the respective Logger interface method. As the no-op
+// logger is always disabled, it is only attached to the returned context if the
+// given context already has a Logger attached.
+func (nl noopLogger) WithContext(ctx context.Context) context.Context {
+	if _, ok := ctx.Value(CtxKey).(Logger); !ok {
+		return ctx
+	}
+
+	return context.WithValue(ctx, CtxKey, Logger(nl))
+}
+
+// Write implements the respective Logger interface method. It reports all bytes
+// as written.
+func (noopLogger) Write(p []byte) (int, error) { return len(p), nil }
+
+func (ne noopEvent) Str(string, string) Event        { return ne }
+func (ne noopEvent) Bool(string, bool) Event         { return ne }
+func (ne noopEvent) Int(string, int) Event           { return ne }
+func (ne noopEvent) Int8(string, int8) Event         { return ne }
+func (ne noopEvent) Int16(string, int16) Event       { return ne }
+func (ne noopEvent) Int32(string, int32) Event       { return ne }
+func (ne noopEvent) Int64(string, int64) Event       { return ne }
+func (ne noopEvent) Uint(string, uint) Event         { return ne }
+func (ne noopEvent) Uint8(string, uint8) Event       { return ne }
+func (ne noopEvent) Uint16(string, uint16) Event     { return ne }
+func (ne noopEvent) Uint32(string, uint32) Event     { return ne }
+func (ne noopEvent) Uint64(string, uint64) Event     { return ne }
+func (ne noopEvent) Float32(string, float32) Event   { return ne }
+func (ne noopEvent) Float64(string, float64) Event   { return ne }
+func (ne noopEvent) Err(error) Event                 { return ne }
+func (ne noopEvent) Timestamp() Event                { return ne }
+func (ne noopEvent) Time(string, time.Time) Event    { return ne }
+func (ne noopEvent) Dur(string, time.Duration) Event { return ne }
+func (ne noopEvent) Fields(any) Event                { return ne }
+func (ne noopEvent) Func(func(Event)) Event          { return ne }
+func (ne noopEvent) Discard() Event                  { return ne }
+func (noopEvent) Enabled() bool                      { return false }
+func (noopEvent) Msg(string)                         {}
+func (noopEvent) Msgf(string, ...interface{})        {}
+func (noopEvent) Send()                              {}
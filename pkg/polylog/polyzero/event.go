@@ -0,0 +1,172 @@
+package polyzero
+
+import (
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/pokt-network/poktroll/pkg/polylog"
+)
+
+var _ polylog.Event = (*zerologEvent)(nil)
+
+// zerologEvent is a polylog.Event implementation which wraps a *zerolog.Event.
+// NB: zerolog returns a nil *zerolog.Event for events which are filtered out by
+// level; all of its methods are safe to call on a nil receiver.
+type zerologEvent struct {
+	event *zerolog.Event
+}
+
+func newEvent(event *zerolog.Event) polylog.Event {
+	return &zerologEvent{event: event}
+}
+
+// Str implements the respective polylog.Event interface method.
+func (ze *zerologEvent) Str(key, value string) polylog.Event {
+	ze.event.Str(key, value)
+	return ze
+}
+
+// Bool implements the respective polylog.Event interface method.
+func (ze *zerologEvent) Bool(key string, value bool) polylog.Event {
+	ze.event.Bool(key, value)
+	return ze
+}
+
+// Int implements the respective polylog.Event interface method.
+func (ze *zerologEvent) Int(key string, value int) polylog.Event {
+	ze.event.Int(key, value)
+	return ze
+}
+
+// Int8 implements the respective polylog.Event interface method.
+func (ze *zerologEvent) Int8(key string, value int8) polylog.Event {
+	ze.event.Int8(key, value)
+	return ze
+}
+
+// Int16 implements the respective polylog.Event interface method.
+func (ze *zerologEvent) Int16(key string, value int16) polylog.Event {
+	ze.event.Int16(key, value)
+	return ze
+}
+
+// Int32 implements the respective polylog.Event interface method.
+func (ze *zerologEvent) Int32(key string, value int32) polylog.Event {
+	ze.event.Int32(key, value)
+	return ze
+}
+
+// Int64 implements the respective polylog.Event interface method.
+func (ze *zerologEvent) Int64(key string, value int64) polylog.Event {
+	ze.event.Int64(key, value)
+	return ze
+}
+
+// Uint implements the respective polylog.Event interface method.
+func (ze *zerologEvent) Uint(key string, value uint) polylog.Event {
+	ze.event.Uint(key, value)
+	return ze
+}
+
+// Uint8 implements the respective polylog.Event interface method.
+func (ze *zerologEvent) Uint8(key string, value uint8) polylog.Event {
+	ze.event.Uint8(key, value)
+	return ze
+}
+
+// Uint16 implements the respective polylog.Event interface method.
+func (ze *zerologEvent) Uint16(key string, value uint16) polylog.Event {
+	ze.event.Uint16(key, value)
+	return ze
+}
+
+// Uint32 implements the respective polylog.Event interface method.
+func (ze *zerologEvent) Uint32(key string, value uint32) polylog.Event {
+	ze.event.Uint32(key, value)
+	return ze
+}
+
+// Uint64 implements the respective polylog.Event interface method.
+func (ze *zerologEvent) Uint64(key string, value uint64) polylog.Event {
+	ze.event.Uint64(key, value)
+	return ze
+}
+
+// Float32 implements the respective polylog.Event interface method.
+func (ze *zerologEvent) Float32(key string, value float32) polylog.Event {
+	ze.event.Float32(key, value)
+	return ze
+}
+
+// Float64 implements the respective polylog.Event interface method.
+func (ze *zerologEvent) Float64(key string, value float64) polylog.Event {
+	ze.event.Float64(key, value)
+	return ze
+}
+
+// Err implements the respective polylog.Event interface method.
+func (ze *zerologEvent) Err(err error) polylog.Event {
+	ze.event.Err(err)
+	return ze
+}
+
+// Timestamp implements the respective polylog.Event interface method.
+func (ze *zerologEvent) Timestamp() polylog.Event {
+	ze.event.Timestamp()
+	return ze
+}
+
+// Time implements the respective polylog.Event interface method.
+func (ze *zerologEvent) Time(key string, value time.Time) polylog.Event {
+	ze.event.Time(key, value)
+	return ze
+}
+
+// Dur implements the respective polylog.Event interface method.
+func (ze *zerologEvent) Dur(key string, value time.Duration) polylog.Event {
+	ze.event.Dur(key, value)
+	return ze
+}
+
+// Fields implements the respective polylog.Event interface method.
+func (ze *zerologEvent) Fields(fields any) polylog.Event {
+	ze.event.Fields(fields)
+	return ze
+}
+
+// Func implements the respective polylog.Event interface method.
+func (ze *zerologEvent) Func(fn func(polylog.Event)) polylog.Event {
+	ze.event.Func(func(event *zerolog.Event) {
+		fn(newEvent(event))
+	})
+	return ze
+}
+
+// Enabled implements the respective polylog.Event interface method.
+func (ze *zerologEvent) Enabled() bool {
+	return ze.event.Enabled()
+}
+
+// Discard implements the respective polylog.Event interface method.
+func (ze *zerologEvent) Discard() polylog.Event {
+	// zerolog returns nil from Discard; retain it so that subsequent calls are
+	// no-ops.
+	ze.event = ze.event.Discard()
+	return ze
+}
+
+// Msg implements the respective polylog.Event interface method.
+func (ze *zerologEvent) Msg(message string) {
+	ze.event.Msg(message)
+}
+
+// Msgf implements the respective polylog.Event interface method.
+func (ze *zerologEvent) Msgf(format string, keyVals ...interface{}) {
+	ze.event.Msgf(format, keyVals...)
+}
+
+// Send implements the respective polylog.Event interface method.
+func (ze *zerologEvent) Send() {
+	ze.event.Send()
+}
@@ -0,0 +1,15 @@
+// Package polyzero provides a polylog.Logger implementation backed by
+// github.com/rs/zerolog. It is an extremely thin wrapper which delegates all
+// calls to the underlying zerolog.Logger and zerolog.Event.
+//
+// Example usage:
+//
+//	logger := polyzero.NewLogger(
+//		polyzero.WithLevel(polyzero.InfoLevel),
+//		polyzero.WithOutput(os.Stderr),
+//	)
+//	ctx = logger.WithContext(ctx)
+//
+//	// Elsewhere, e.g. in a function which receives ctx:
+//	polylog.Ctx(ctx).Info().Str("key", "value").Msg("message")
+package polyzero
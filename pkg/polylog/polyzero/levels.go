@@ -0,0 +1,21 @@
+package polyzero
+
+import (
+	"github.com/rs/zerolog"
+)
+
+// Level re-exports zerolog's log levels so that consumers need not import
+// zerolog directly in order to configure a polyzero logger.
+const (
+	DebugLevel    = zerolog.DebugLevel
+	InfoLevel     = zerolog.InfoLevel
+	WarnLevel     = zerolog.WarnLevel
+	ErrorLevel    = zerolog.ErrorLevel
+	DisabledLevel = zerolog.Disabled
+)
+
+// ParseLevel converts a level string (e.g. "debug", "info", "warn", "error")
+// into a zerolog.Level. An empty string is parsed as zerolog.NoLevel.
+func ParseLevel(levelStr string) (zerolog.Level, error) {
+	return zerolog.ParseLevel(levelStr)
+}
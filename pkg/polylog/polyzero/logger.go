@@ -0,0 +1,103 @@
+package polyzero
+
+import (
+	"context"
+	"os"
+
+	"github.com/rs/zerolog"
+
+	"github.com/pokt-network/poktroll/pkg/polylog"
+)
+
+var _ polylog.Logger = (*zerologLogger)(nil)
+
+// zerologLogger is a polylog.Logger implementation which wraps a zerolog.Logger.
+type zerologLogger struct {
+	zerolog.Logger
+}
+
+// NewLogger constructs a new zerolog-backed polylog.Logger. By default, it
+// writes JSON encoded log lines, including a timestamp, to os.Stderr at the
+// debug level.
+//
+// Available options:
+//   - WithOutput
+//   - WithConsoleOutput
+//   - WithLevel
+//   - WithSetupFn
+func NewLogger(opts ...polylog.LoggerOption) polylog.Logger {
+	logger := &zerologLogger{
+		Logger: zerolog.New(os.Stderr).
+			Level(zerolog.DebugLevel).
+			With().Timestamp().Logger(),
+	}
+
+	for _, opt := range opts {
+		opt(logger)
+	}
+
+	return logger
+}
+
+// Debug starts a new message with debug level.
+//
+// You must call Msg on the returned event in order to send the event.
+func (zl *zerologLogger) Debug() polylog.Event {
+	return newEvent(zl.Logger.Debug())
+}
+
+// Info starts a new message with info level.
+//
+// You must call Msg on the returned event in order to send the event.
+func (zl *zerologLogger) Info() polylog.Event {
+	return newEvent(zl.Logger.Info())
+}
+
+// Warn starts a new message with warn level.
+//
+// You must call Msg on the returned event in order to send the event.
+func (zl *zerologLogger) Warn() polylog.Event {
+	return newEvent(zl.Logger.Warn())
+}
+
+// Error starts a new message with error level.
+//
+// You must call Msg on the returned event in order to send the event.
+func (zl *zerologLogger) Error() polylog.Event {
+	return newEvent(zl.Logger.Error())
+}
+
+// WithLevel starts a new message with the given level.
+//
+// You must call Msg on the returned event in order to send the event.
+func (zl *zerologLogger) WithLevel(level int) polylog.Event {
+	return newEvent(zl.Logger.WithLevel(zerolog.Level(level)))
+}
+
+// With creates a child logger with the fields constructed from keyVals added
+// to its context. keyVals MUST alternate string keys and arbitrary values.
+func (zl *zerologLogger) With(keyVals ...any) polylog.Logger {
+	return &zerologLogger{
+		Logger: zl.Logger.With().Fields(keyVals).Logger(),
+	}
+}
+
+// WithContext returns a copy of ctx with the receiver attached. The underlying
+// zerolog.Logger is also attached such that zerolog.Ctx(ctx) is consistent with
+// polylog.Ctx(ctx). If the receiver's level is disabled, it is only attached if
+// ctx already has a polylog.Logger attached.
+func (zl *zerologLogger) WithContext(ctx context.Context) context.Context {
+	if _, ok := ctx.Value(polylog.CtxKey).(polylog.Logger); !ok &&
+		zl.Logger.GetLevel() == zerolog.Disabled {
+		return ctx
+	}
+
+	ctx = zl.Logger.WithContext(ctx)
+	return context.WithValue(ctx, polylog.CtxKey, polylog.Logger(zl))
+}
+
+// Write implements io.Writer. This is useful to set as a writer for the
+// standard library log.
+func (zl *zerologLogger) Write(p []byte) (n int, err error) {
+	return zl.Logger.Write(p)
+}
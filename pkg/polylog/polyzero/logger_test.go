@@ -0,0 +1,164 @@
+package polyzero_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/pkg/polylog"
+	"github.com/pokt-network/poktroll/pkg/polylog/polyzero"
+)
+
+func TestZerologLogger_Levels(t *testing.T) {
+	tests := []struct {
+		desc          string
+		level         string
+		expectedLines []string
+	}{
+		{
+			desc:          "debug level logs everything",
+			level:         "debug",
+			expectedLines: []string{"debug", "info", "warn", "error"},
+		},
+		{
+			desc:          "info level filters debug",
+			level:         "info",
+			expectedLines: []string{"info", "warn", "error"},
+		},
+		{
+			desc:          "error level filters all but error",
+			level:         "error",
+			expectedLines: []string{"error"},
+		},
+		{
+			desc:          "disabled level filters everything",
+			level:         "disabled",
+			expectedLines: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			level, err := polyzero.ParseLevel(tt.level)
+			require.NoError(t, err)
+
+			var output bytes.Buffer
+			logger := polyzero.NewLogger(
+				polyzero.WithOutput(&output),
+				polyzero.WithLevel(level),
+			)
+
+			logger.Debug().Msg("debug")
+			logger.Info().Msg("info")
+			logger.Warn().Msg("warn")
+			logger.Error().Msg("error")
+
+			lines := nonEmptyLines(output.String())
+			require.Len(t, lines, len(tt.expectedLines))
+			for i, line := range lines {
+				entry := unmarshalLine(t, line)
+				require.Equal(t, tt.expectedLines[i], entry["message"])
+				require.Equal(t, tt.expectedLines[i], entry["level"])
+			}
+		})
+	}
+}
+
+func TestZerologLogger_Fields(t *testing.T) {
+	var output bytes.Buffer
+	logger := polyzero.NewLogger(polyzero.WithOutput(&output)).
+		With("component", "test")
+
+	logger.Info().
+		Str("str", "value").
+		Int64("int64", 42).
+		Bool("bool", true).
+		Dur("dur", time.Second).
+		Err(nil).
+		Msgf("hello %s", "world")
+
+	lines := nonEmptyLines(output.String())
+	require.Len(t, lines, 1)
+
+	entry := unmarshalLine(t, lines[0])
+	require.Equal(t, "hello world", entry["message"])
+	require.Equal(t, "test", entry["component"])
+	require.Equal(t, "value", entry["str"])
+	require.Equal(t, float64(42), entry["int64"])
+	require.Equal(t, true, entry["bool"])
+	require.Contains(t, entry, "time")
+	// A nil error MUST NOT add an error field.
+	require.NotContains(t, entry, "error")
+
+	output.Reset()
+	logger.Error().Err(errors.New("test error")).Send()
+	entry = unmarshalLine(t, nonEmptyLines(output.String())[0])
+	require.Equal(t, "test error", entry["error"])
+}
+
+func TestZerologLogger_Discard(t *testing.T) {
+	var output bytes.Buffer
+	logger := polyzero.NewLogger(polyzero.WithOutput(&output))
+
+	event := logger.Info().Discard()
+	require.False(t, event.Enabled())
+	event.Msg("discarded")
+
+	require.Empty(t, output.String())
+}
+
+func TestZerologLogger_ConsoleOutput(t *testing.T) {
+	var output bytes.Buffer
+	logger := polyzero.NewLogger(polyzero.WithConsoleOutput(&output, true))
+
+	logger.Info().Str("key", "value").Msg("console")
+
+	require.Contains(t, output.String(), "INF")
+	require.Contains(t, output.String(), "console")
+	require.Contains(t, output.String(), "key=value")
+}
+
+func TestZerologLogger_WithContext(t *testing.T) {
+	var output bytes.Buffer
+	logger := polyzero.NewLogger(polyzero.WithOutput(&output))
+
+	ctx := logger.WithContext(context.Background())
+	polylog.Ctx(ctx).Info().Msg("from context")
+
+	lines := nonEmptyLines(output.String())
+	require.Len(t, lines, 1)
+	require.Equal(t, "from context", unmarshalLine(t, lines[0])["message"])
+
+	// A disabled logger is not attached to a context without a logger.
+	disabledLogger := polyzero.NewLogger(polyzero.WithLevel(polyzero.DisabledLevel))
+	emptyCtx := context.Background()
+	require.Equal(t, emptyCtx, disabledLogger.WithContext(emptyCtx))
+
+	// A disabled logger replaces a logger which is already attached.
+	disabledCtx := disabledLogger.WithContext(ctx)
+	polylog.Ctx(disabledCtx).Info().Msg("should not be logged")
+	require.Len(t, nonEmptyLines(output.String()), 1)
+}
+
+func nonEmptyLines(output string) (lines []string) {
+	for _, line := range strings.Split(output, "\n") {
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+func unmarshalLine(t *testing.T, line string) map[string]any {
+	t.Helper()
+
+	entry := make(map[string]any)
+	require.NoError(t, json.Unmarshal([]byte(line), &entry))
+	return entry
+}
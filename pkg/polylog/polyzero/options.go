@@ -0,0 +1,51 @@
+package polyzero
+
+import (
+	"io"
+	"os"
+
+	"github.com/rs/zerolog"
+
+	"github.com/pokt-network/poktroll/pkg/polylog"
+)
+
+// WithOutput returns an option function which configures the logger to write
+// JSON encoded log lines to the given io.Writer.
+func WithOutput(output io.Writer) polylog.LoggerOption {
+	return func(logger polylog.Logger) {
+		zl := logger.(*zerologLogger)
+		zl.Logger = zl.Logger.Output(output)
+	}
+}
+
+// WithConsoleOutput returns an option function which configures the logger to
+// write human-friendly, colorized (if noColor is false) log lines to the given
+// io.Writer. If output is nil, os.Stderr is used.
+func WithConsoleOutput(output io.Writer, noColor bool) polylog.LoggerOption {
+	if output == nil {
+		output = os.Stderr
+	}
+
+	return WithOutput(zerolog.ConsoleWriter{
+		Out:     output,
+		NoColor: noColor,
+	})
+}
+
+// WithLevel returns an option function which configures the minimum level of
+// events which the logger will send. Events below this level are discarded.
+func WithLevel(level zerolog.Level) polylog.LoggerOption {
+	return func(logger polylog.Logger) {
+		zl := logger.(*zerologLogger)
+		zl.Logger = zl.Logger.Level(level)
+	}
+}
+
+// WithSetupFn returns an option function which calls the given function with a
+// pointer to the underlying zerolog.Logger; allowing for arbitrary configuration
+// which is not otherwise exposed via polyzero options.
+func WithSetupFn(fn func(logger *zerolog.Logger)) polylog.LoggerOption {
+	return func(logger polylog.Logger) {
+		fn(&logger.(*zerologLogger).Logger)
+	}
+}
@@ -2,7 +2,6 @@ package cmd
 
 import (
 	"context"
-	"log"
 	"net/url"
 	"os"
 
@@ -12,7 +11,9 @@ import (
 	cosmostx "github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/spf13/cobra"
 
+	"github.com/pokt-network/poktroll/cmd/logger"
 	"github.com/pokt-network/poktroll/cmd/signals"
+	"github.com/pokt-network/poktroll/pkg/client"
 	"github.com/pokt-network/poktroll/pkg/client/supplier"
 	"github.com/pokt-network/poktroll/pkg/client/tx"
 	"github.com/pokt-network/poktroll/pkg/deps/config"
@@ -63,6 +64,14 @@ func runRelayer(cmd *cobra.Command, _ []string) error {
 	// Ensure context cancellation.
 	defer cancelCtx()
 
+	// Construct a logger according to the --log_level and --log_format flags and
+	// associate it with ctx such that it is available to all dependencies.
+	relayerLogger, err := logger.NewLoggerFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+	ctx = relayerLogger.WithContext(ctx)
+
 	// Handle interrupt and kill signals asynchronously.
 	signals.GoOnExitSignal(cancelCtx)
 
@@ -77,8 +86,8 @@ func runRelayer(cmd *cobra.Command, _ []string) error {
 	}
 
 	// Sets up the following dependencies:
-	// Miner, EventsQueryClient, BlockClient, cosmosclient.Context, TxFactory,
-	// TxContext, TxClient, SupplierClient, RelayerProxy, RelayerSessionsManager.
+	// Logger, EventsQueryClient, BlockClient, cosmosclient.Context, Miner, TxFactory,
+	// TxContext, TxClient, SupplierClient, RelayerSessionsManager, RelayerProxy.
 	deps, err := setupRelayerDependencies(ctx, cmd, relayMinerConfig)
 	if err != nil {
 		return err
@@ -90,20 +99,21 @@ func runRelayer(cmd *cobra.Command, _ []string) error {
 	}
 
 	// Start the relay miner
-	log.Println("INFO: Starting relay miner...")
+	relayerLogger.Info().Msg("starting relay miner")
 	if err := relayMiner.Start(ctx); err != nil {
 		return err
 	}
 
-	log.Println("INFO: Relay miner stopped; exiting")
+	relayerLogger.Info().Msg("relay miner stopped; exiting")
 	return nil
 }
 
 // setupRelayerDependencies sets up all the dependencies the relay miner needs
 // to run by building the dependency tree from the leaves up, incrementally
 // supplying each component to an accumulating depinject.Config:
-// Miner, EventsQueryClient, BlockClient, cosmosclient.Context, TxFactory, TxContext,
-// TxClient, SupplierClient, RelayerProxy, RelayerSessionsManager.
+// Logger, EventsQueryClient, BlockClient, cosmosclient.Context, AccountQuerier,
+// ApplicationQuerier, SupplierQuerier, SessionQuerier, Miner, TxFactory, TxContext,
+// TxClient, SupplierClient, RelayerSessionsManager, RelayerProxy.
 func setupRelayerDependencies(
 	ctx context.Context,
 	cmd *cobra.Command,
@@ -113,21 +123,31 @@ func setupRelayerDependencies(
 	queryNodeUrl := relayMinerConfig.QueryNodeUrl.String()
 	networkNodeUrl := relayMinerConfig.NetworkNodeUrl.String()
 	signingKeyName := relayMinerConfig.SigningKeyName
+	txClientOpts := []client.TxClientOption{
+		tx.WithGasAdjustment(relayMinerConfig.GasAdjustment),
+		tx.WithGasPrices(relayMinerConfig.GasPrices),
+		tx.WithFeeGranter(relayMinerConfig.FeeGranter),
+	}
 	proxiedServiceEndpoints := relayMinerConfig.ProxiedServiceEndpoints
 	smtStorePath := relayMinerConfig.SmtStorePath
 
 	supplierFuncs := []config.SupplierFn{
+		config.SupplyLogger, // leaf
 		config.NewSupplyEventsQueryClientFn(pocketNodeWebsocketUrl), // leaf
 		config.NewSupplyBlockClientFn(pocketNodeWebsocketUrl),
-		supplyMiner, // leaf
 		newSupplyQueryClientContextFn(queryNodeUrl), // leaf
 		newSupplyTxClientContextFn(networkNodeUrl),  // leaf
+		config.SupplyAccountQuerier,
+		config.SupplyApplicationQuerier,
+		config.SupplySupplierQuerier,
+		config.SupplySessionQuerier,
+		supplyMiner,
 		supplyTxFactory,
 		supplyTxContext,
-		newSupplyTxClientFn(signingKeyName),
+		newSupplyTxClientFn(signingKeyName, txClientOpts...),
 		newSupplySupplierClientFn(signingKeyName),
-		newSupplyRelayerProxyFn(signingKeyName, proxiedServiceEndpoints),
 		newSupplyRelayerSessionsManagerFn(smtStorePath),
+		newSupplyRelayerProxyFn(signingKeyName, proxiedServiceEndpoints),
 	}
 
 	return config.SupplyConfig(ctx, cmd, supplierFuncs)
@@ -140,7 +160,7 @@ func supplyMiner(
 	deps depinject.Config,
 	_ *cobra.Command,
 ) (depinject.Config, error) {
-	mnr, err := miner.NewMiner()
+	mnr, err := miner.NewMiner(deps)
 	if err != nil {
 		return nil, err
 	}
@@ -174,8 +194,12 @@ func newSupplyQueryClientContextFn(queryNodeUrl string) config.SupplierFn {
 		if err != nil {
 			return nil, err
 		}
+		// NB: The query client context is also supplied as a plain
+		// cosmosclient.Context, which the shared queriers (see: pkg/client/query)
+		// depend on.
 		deps = depinject.Configs(deps, depinject.Supply(
 			relayer.QueryClientContext(queryClientCtx),
+			queryClientCtx,
 		))
 		return deps, nil
 	}
@@ -250,21 +274,28 @@ func supplyTxContext(
 }
 
 // newSupplyTxClientFn returns a function which constructs a TxClient
-// instance and returns a new depinject.Config which is supplied with
-// the given deps and the new TxClient.
-func newSupplyTxClientFn(signingKeyName string) config.SupplierFn {
+// instance, configured with the given signing key name and any additional
+// options, and returns a new depinject.Config which is supplied with the
+// given deps and the new TxClient.
+func newSupplyTxClientFn(
+	signingKeyName string,
+	opts ...client.TxClientOption,
+) config.SupplierFn {
 	return func(
 		ctx context.Context,
 		deps depinject.Config,
 		_ *cobra.Command,
 	) (depinject.Config, error) {
-		txClient, err := tx.NewTxClient(
-			ctx,
-			deps,
-			tx.WithSigningKeyName(signingKeyName),
-			// TODO_TECHDEBT: populate this from some config.
-			tx.WithCommitTimeoutBlocks(tx.DefaultCommitTimeoutHeightOffset),
+		txClientOpts := append(
+			[]client.TxClientOption{
+				tx.WithSigningKeyName(signingKeyName),
+				// TODO_TECHDEBT: populate this from some config.
+				tx.WithCommitTimeoutBlocks(tx.DefaultCommitTimeoutHeightOffset),
+			},
+			opts...,
 		)
+
+		txClient, err := tx.NewTxClient(ctx, deps, txClientOpts...)
 		if err != nil {
 			return nil, err
 		}
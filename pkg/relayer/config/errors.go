@@ -10,4 +10,6 @@ var (
 	ErrRelayMinerConfigInvalidServiceEndpoint = sdkerrors.Register(codespace, 4, "invalid service endpoint in RelayMiner config")
 	ErrRelayMinerConfigInvalidSigningKeyName  = sdkerrors.Register(codespace, 5, "invalid signing key name in RelayMiner config")
 	ErrRelayMinerConfigInvalidSmtStorePath    = sdkerrors.Register(codespace, 6, "invalid smt store path in RelayMiner config")
+	ErrRelayMinerConfigInvalidGasAdjustment   = sdkerrors.Register(codespace, 7, "invalid gas adjustment in RelayMiner config")
+	ErrRelayMinerConfigInvalidGasPrices       = sdkerrors.Register(codespace, 8, "invalid gas prices in RelayMiner config")
 )
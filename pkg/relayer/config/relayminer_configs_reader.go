@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/url"
 
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
 	"gopkg.in/yaml.v2"
 )
 
@@ -16,6 +17,9 @@ type YAMLRelayMinerConfig struct {
 	SigningKeyName          string            `yaml:"signing_key_name"`
 	ProxiedServiceEndpoints map[string]string `yaml:"proxied_service_endpoints"`
 	SmtStorePath            string            `yaml:"smt_store_path"`
+	GasAdjustment           float64           `yaml:"gas_adjustment"`
+	GasPrices               string            `yaml:"gas_prices"`
+	FeeGranter              string            `yaml:"fee_granter"`
 }
 
 // RelayMinerConfig is the structure describing the RelayMiner config
@@ -26,6 +30,9 @@ type RelayMinerConfig struct {
 	SigningKeyName          string
 	ProxiedServiceEndpoints map[string]*url.URL
 	SmtStorePath            string
+	GasAdjustment           float64
+	GasPrices               cosmostypes.DecCoins
+	FeeGranter              string
 }
 
 // ParseRelayMinerConfigs parses the relay miner config file into a RelayMinerConfig
@@ -88,6 +95,20 @@ func ParseRelayMinerConfigs(configContent []byte) (*RelayMinerConfig, error) {
 		proxiedServiceEndpoints[serviceId] = endpoint
 	}
 
+	// A zero gas adjustment is valid and results in the tx client's default.
+	gasAdjustment := yamlRelayMinerConfig.GasAdjustment
+	if gasAdjustment != 0 && gasAdjustment < 1 {
+		return nil, ErrRelayMinerConfigInvalidGasAdjustment.Wrapf(
+			"gas adjustment must be at least 1, got: %f", gasAdjustment,
+		)
+	}
+
+	// Parse the gas prices; an empty string results in no fees being set.
+	gasPrices, err := cosmostypes.ParseDecCoins(yamlRelayMinerConfig.GasPrices)
+	if err != nil {
+		return nil, ErrRelayMinerConfigInvalidGasPrices.Wrapf("%s", err)
+	}
+
 	relayMinerCMDConfig := &RelayMinerConfig{
 		QueryNodeUrl:            queryNodeUrl,
 		NetworkNodeUrl:          networkNodeUrl,
@@ -95,6 +116,9 @@ func ParseRelayMinerConfigs(configContent []byte) (*RelayMinerConfig, error) {
 		SigningKeyName:          yamlRelayMinerConfig.SigningKeyName,
 		ProxiedServiceEndpoints: proxiedServiceEndpoints,
 		SmtStorePath:            yamlRelayMinerConfig.SmtStorePath,
+		GasAdjustment:           gasAdjustment,
+		GasPrices:               gasPrices,
+		FeeGranter:              yamlRelayMinerConfig.FeeGranter,
 	}
 
 	return relayMinerCMDConfig, nil
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	sdkerrors "cosmossdk.io/errors"
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
 	"github.com/gogo/status"
 	"github.com/stretchr/testify/require"
 
@@ -47,6 +48,37 @@ func Test_ParseRelayMinerConfigs(t *testing.T) {
 				SmtStorePath: "smt_stores",
 			},
 		},
+		{
+			desc: "valid: relay miner config with gas and fee settings",
+
+			inputConfig: `
+				query_node_url: tcp://localhost:26657
+				network_node_url: tcp://127.0.0.1:36657
+				signing_key_name: servicer1
+				proxied_service_endpoints:
+				  anvil: http://anvil:8080
+				smt_store_path: smt_stores
+				gas_adjustment: 1.5
+				gas_prices: 0.01upokt
+				fee_granter: pokt1mrqt5f7qh8uxs27cjm9t7v9e74a9vvdnq5jva4
+				`,
+
+			expectedError: nil,
+			expectedConfig: &config.RelayMinerConfig{
+				QueryNodeUrl:   &url.URL{Scheme: "tcp", Host: "localhost:26657"},
+				NetworkNodeUrl: &url.URL{Scheme: "tcp", Host: "127.0.0.1:36657"},
+				SigningKeyName: "servicer1",
+				ProxiedServiceEndpoints: map[string]*url.URL{
+					"anvil": {Scheme: "http", Host: "anvil:8080"},
+				},
+				SmtStorePath:  "smt_stores",
+				GasAdjustment: 1.5,
+				GasPrices: cosmostypes.NewDecCoins(
+					cosmostypes.NewDecCoinFromDec("upokt", cosmostypes.NewDecWithPrec(1, 2)),
+				),
+				FeeGranter: "pokt1mrqt5f7qh8uxs27cjm9t7v9e74a9vvdnq5jva4",
+			},
+		},
 		// Invalid Configs
 		{
 			desc: "invalid: invalid network node url",
@@ -175,6 +207,36 @@ func Test_ParseRelayMinerConfigs(t *testing.T) {
 
 			expectedError: config.ErrRelayMinerConfigUnmarshalYAML,
 		},
+		{
+			desc: "invalid: gas adjustment less than 1",
+
+			inputConfig: `
+				query_node_url: tcp://localhost:26657
+				network_node_url: tcp://127.0.0.1:36657
+				signing_key_name: servicer1
+				proxied_service_endpoints:
+				  anvil: http://anvil:8080
+				smt_store_path: smt_stores
+				gas_adjustment: 0.5
+				`,
+
+			expectedError: config.ErrRelayMinerConfigInvalidGasAdjustment,
+		},
+		{
+			desc: "invalid: invalid gas prices",
+
+			inputConfig: `
+				query_node_url: tcp://localhost:26657
+				network_node_url: tcp://127.0.0.1:36657
+				signing_key_name: servicer1
+				proxied_service_endpoints:
+				  anvil: http://anvil:8080
+				smt_store_path: smt_stores
+				gas_prices: upokt0.01
+				`,
+
+			expectedError: config.ErrRelayMinerConfigInvalidGasPrices,
+		},
 		{
 			desc: "invalid: empty RelayMiner config file",
 
@@ -205,6 +267,9 @@ func Test_ParseRelayMinerConfigs(t *testing.T) {
 			require.Equal(t, tt.expectedConfig.NetworkNodeUrl.String(), config.NetworkNodeUrl.String())
 			require.Equal(t, tt.expectedConfig.SigningKeyName, config.SigningKeyName)
 			require.Equal(t, tt.expectedConfig.SmtStorePath, config.SmtStorePath)
+			require.Equal(t, tt.expectedConfig.GasAdjustment, config.GasAdjustment)
+			require.Equal(t, tt.expectedConfig.GasPrices.String(), config.GasPrices.String())
+			require.Equal(t, tt.expectedConfig.FeeGranter, config.FeeGranter)
 			require.Equal(t, len(tt.expectedConfig.ProxiedServiceEndpoints), len(config.ProxiedServiceEndpoints))
 			for serviceId, endpoint := range tt.expectedConfig.ProxiedServiceEndpoints {
 				require.Equal(t, endpoint.String(), config.ProxiedServiceEndpoints[serviceId].String())
@@ -86,6 +86,11 @@ type RelayerProxy interface {
 	// TODO_TECHDEBT(@red-0ne): This method should be moved out of the RelayerProxy interface
 	// that should not be responsible for signing relay responses.
 	SignRelayResponse(relayResponse *servicetypes.RelayResponse) error
+
+	// ReleaseRelayRequest is a shared method used by RelayServers to release the
+	// compute units which were reserved for a verified relay request that failed
+	// to be served, such that its application is only billed for served relays.
+	ReleaseRelayRequest(relayRequest *servicetypes.RelayRequest) error
 }
 
 type RelayerProxyOption func(RelayerProxy)
@@ -115,6 +120,11 @@ type RelayerSessionsManager interface {
 	// in their respective session's SMST (tree).
 	InsertRelays(minedRelaysObs MinedRelaysObservable)
 
+	// EnsureSessionTree returns the SessionTree for the given session, creating
+	// it if it doesn't exist yet. It is used by the RelayerProxy to account for
+	// the compute units served in the session as relays are served.
+	EnsureSessionTree(sessionHeader *sessiontypes.SessionHeader) (SessionTree, error)
+
 	// Start iterates over the session trees at the end of each, respective, session.
 	// The session trees are piped through a series of map operations which progress
 	// them through the claim/proof lifecycle, broadcasting transactions to  the
@@ -142,6 +152,18 @@ type SessionTree interface {
 	// This function should be called when a Relay has been successfully served.
 	Update(key, value []byte, weight uint64) error
 
+	// ReserveComputeUnits reserves the given number of compute units for a relay
+	// which is about to be served in the session, such that no more than
+	// maxComputeUnits are served in it. It returns an error, without reserving
+	// them, if the reservation would exceed maxComputeUnits or if the SMST has
+	// been flushed.
+	ReserveComputeUnits(computeUnits, maxComputeUnits uint64) error
+
+	// ReleaseComputeUnits releases the given number of compute units which were
+	// reserved for a relay that failed to be served, such that the application
+	// isn't billed for it.
+	ReleaseComputeUnits(computeUnits uint64)
+
 	// ProveClosest is a wrapper for the SMST's ProveClosest function. It returns the
 	// proof for the given path.
 	// This function should be called several blocks after a session has been claimed and needs to be proven.
@@ -20,8 +20,6 @@ import (
 	"github.com/pokt-network/poktroll/pkg/observable"
 	"github.com/pokt-network/poktroll/pkg/observable/channel"
 	"github.com/pokt-network/poktroll/pkg/relayer"
-	"github.com/pokt-network/poktroll/pkg/relayer/miner"
-	"github.com/pokt-network/poktroll/pkg/relayer/protocol"
 	servicetypes "github.com/pokt-network/poktroll/x/service/types"
 )
 
@@ -83,7 +81,7 @@ func main() {
 	randRelaysObs, errCh := genRandomizedMinedRelayFixtures(
 		ctx,
 		defaultRandLength,
-		miner.DefaultRelayHasher,
+		servicetypes.NewRelayHasher,
 	)
 	exitOnError(errCh)
 
@@ -152,8 +150,7 @@ func genRandomizedMinedRelayFixtures(
 				Res: nil,
 			}
 
-			// TODO_BLOCKER: use canonical codec.
-			relayBz, err := relay.Marshal()
+			relayBz, err := relay.GetCanonicalBytes()
 			if err != nil {
 				errCh <- err
 				return
@@ -200,13 +197,13 @@ func exitOnError(errCh <-chan error) {
 // difficultyGTE returns true if the given hash has a difficulty greater than or
 // equal to flagDifficultyBitsThreshold.
 func difficultyGTE(hash []byte) bool {
-	return protocol.MustCountDifficultyBits(hash) >= flagDifficultyBitsThreshold
+	return servicetypes.CountRelayDifficultyBits(hash) >= flagDifficultyBitsThreshold
 }
 
 // difficultyLT returns true if the given hash has a difficulty less than
 // flagDifficultyBitsThreshold.
 func difficultyLT(hash []byte) bool {
-	return protocol.MustCountDifficultyBits(hash) < flagDifficultyBitsThreshold
+	return servicetypes.CountRelayDifficultyBits(hash) < flagDifficultyBitsThreshold
 }
 
 // getMarshaledRelayFmtLines performs two map operations followed by a collect.
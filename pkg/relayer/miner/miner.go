@@ -2,8 +2,10 @@ package miner
 
 import (
 	"context"
-	"crypto/sha256"
-	"hash"
+	"sync"
+
+	"cosmossdk.io/depinject"
+	cosmosclient "github.com/cosmos/cosmos-sdk/client"
 
 	"github.com/pokt-network/poktroll/pkg/either"
 	"github.com/pokt-network/poktroll/pkg/observable"
@@ -11,51 +13,68 @@ import (
 	"github.com/pokt-network/poktroll/pkg/observable/filter"
 	"github.com/pokt-network/poktroll/pkg/observable/logging"
 	"github.com/pokt-network/poktroll/pkg/relayer"
-	"github.com/pokt-network/poktroll/pkg/relayer/protocol"
 	servicetypes "github.com/pokt-network/poktroll/x/service/types"
 )
 
-var (
-	_ relayer.Miner = (*miner)(nil)
-	// TODO_TECHDEBT(@h5law): Retrieve the relay hasher mechanism from the `smt` repo.
-	DefaultRelayHasher = sha256.New
-	// TODO_BLOCKER: query on-chain governance params once available.
-	// Setting this to 0 to effectively disables mining for now.
-	// I.e., all relays are added to the tree.
-	defaultRelayDifficultyBits = 0
-)
+var _ relayer.Miner = (*miner)(nil)
 
 // Miner is responsible for observing servedRelayObs, hashing and checking the
 // difficulty of each, finally publishing those with sufficient difficulty to
-// minedRelayObs as they are applicable for relay volume.
+// minedRelayObs as they are applicable for relay volume. Relays are encoded,
+// hashed and checked as defined by servicetypes, such that they match on-chain
+// proof validation.
+//
+// The minimum difficulty that a relay must have to be volume / reward applicable
+// is the on-chain relay difficulty of its service in its session, which is
+// queried once per service per session.
 //
 // Available options:
 //   - WithDifficulty
-//
-// TODO_BLOCKER: The relay hashing and relay difficulty mechanisms & values must come
-// from on-chain.
 type miner struct {
-	// relayHasher is a function which returns a hash.Hash interfact type. It is
-	// used to hash serialized relays to measure their mining difficulty.
-	relayHasher func() hash.Hash
-	// relayDifficultyBits is the minimum difficulty that a relay must have to be
-	// volume / reward applicable.
-	relayDifficultyBits int
+	// serviceQuerier is the querier used to get the on-chain relay difficulty
+	// of each relay's service.
+	serviceQuerier servicetypes.QueryClient
+	// fixedRelayDifficultyBits, if not nil, is the minimum difficulty that every
+	// relay must have, instead of the on-chain relay difficulty of its service.
+	fixedRelayDifficultyBits *int
+
+	// relayDifficultiesMu protects relayDifficulties.
+	relayDifficultiesMu sync.Mutex
+	// relayDifficulties is a cache of the relay difficulty of each service, as
+	// of the start of the latest session which a relay for it was mined in.
+	relayDifficulties map[string]sessionRelayDifficulty
+}
+
+// sessionRelayDifficulty is the relay difficulty of a service which applies to
+// the relays of the session starting at sessionStartHeight.
+type sessionRelayDifficulty struct {
+	sessionStartHeight int64
+	difficultyBits     int
 }
 
 // NewMiner creates a new miner from the given dependencies and options. It
 // returns an error if it has not been sufficiently configured or supplied.
+//
+// Required dependencies:
+//   - relayer.QueryClientContext
 func NewMiner(
+	deps depinject.Config,
 	opts ...relayer.MinerOption,
 ) (*miner, error) {
-	mnr := &miner{}
+	mnr := &miner{
+		relayDifficulties: make(map[string]sessionRelayDifficulty),
+	}
+
+	var queryClientCtx relayer.QueryClientContext
+	if err := depinject.Inject(deps, &queryClientCtx); err != nil {
+		return nil, err
+	}
+	mnr.serviceQuerier = servicetypes.NewQueryClient(cosmosclient.Context(queryClientCtx))
 
 	for _, opt := range opts {
 		opt(mnr)
 	}
 
-	mnr.setDefaults()
-
 	return mnr, nil
 }
 
@@ -82,42 +101,29 @@ func (mnr *miner) MinedRelays(
 	return filter.EitherSuccess(ctx, eitherMinedRelaysObs)
 }
 
-// setDefaults ensures that the miner has been configured with a hasherConstructor and uses
-// the default hasherConstructor if not.
-func (mnr *miner) setDefaults() {
-	if mnr.relayHasher == nil {
-		mnr.relayHasher = DefaultRelayHasher
-	}
-
-	if mnr.relayDifficultyBits == 0 {
-		mnr.relayDifficultyBits = defaultRelayDifficultyBits
-	}
-}
-
 // mapMineRelay is intended to be used as a MapFn.
 // 1. It hashes the relay and compares its difficult to the minimum threshold.
 // 2. If the relay difficulty is sufficient -> return an Either[MineRelay Value]
 // 3. If an error is encountered -> return an Either[error]
 // 4. Otherwise, skip the relay.
 func (mnr *miner) mapMineRelay(
-	_ context.Context,
+	ctx context.Context,
 	relay *servicetypes.Relay,
 ) (_ either.Either[*relayer.MinedRelay], skip bool) {
-	// TODO_BLOCKER: marshal using canonical codec.
-	relayBz, err := relay.Marshal()
+	relayDifficultyBits, err := mnr.getRelayDifficultyBits(ctx, relay)
+	if err != nil {
+		return either.Error[*relayer.MinedRelay](err), false
+	}
+
+	relayBz, err := relay.GetCanonicalBytes()
 	if err != nil {
 		return either.Error[*relayer.MinedRelay](err), false
 	}
 
-	// TODO_BLOCKER: Centralize the logic of hashing a relay. It should live
-	// alongside signing & verification.
-	//
-	// TODO_IMPROVE: We need to hash the key; it would be nice if smst.Update() could do it
-	// since smst has a reference to the hasherConstructor
-	relayHash := mnr.hash(relayBz)
+	relayHash := servicetypes.RelayHash(relayBz)
 
 	// The relay IS NOT volume / reward applicable
-	if protocol.MustCountDifficultyBits(relayHash) < mnr.relayDifficultyBits {
+	if !servicetypes.IsRelayMined(relayHash, uint64(relayDifficultyBits)) {
 		return either.Success[*relayer.MinedRelay](nil), true
 	}
 
@@ -129,9 +135,55 @@ func (mnr *miner) mapMineRelay(
 	}), false
 }
 
-// hash constructs a new hasher and hashes the given input bytes.
-func (mnr *miner) hash(inputBz []byte) []byte {
-	hasher := mnr.relayHasher()
-	hasher.Write(inputBz)
-	return hasher.Sum(nil)
+// getRelayDifficultyBits returns the minimum difficulty that the given relay
+// must have to be volume / reward applicable. Unless it has been fixed (see:
+// WithDifficulty), it is the on-chain relay difficulty of the relay's service
+// which was in effect for the relay's session, and which claims for the session
+// are therefore created with. It is queried on the first relay for the service
+// of each session and then cached.
+func (mnr *miner) getRelayDifficultyBits(
+	ctx context.Context,
+	relay *servicetypes.Relay,
+) (int, error) {
+	if mnr.fixedRelayDifficultyBits != nil {
+		return *mnr.fixedRelayDifficultyBits, nil
+	}
+
+	sessionHeader := relay.GetReq().GetMeta().GetSessionHeader()
+	serviceId := sessionHeader.GetService().GetId()
+	sessionStartHeight := sessionHeader.GetSessionStartBlockHeight()
+
+	mnr.relayDifficultiesMu.Lock()
+	cached, ok := mnr.relayDifficulties[serviceId]
+	mnr.relayDifficultiesMu.Unlock()
+
+	if ok && cached.sessionStartHeight == sessionStartHeight {
+		return cached.difficultyBits, nil
+	}
+
+	// The mutex isn't held while querying such that relays of other services,
+	// or of sessions which are already cached, aren't blocked by the query.
+	relayDifficultyRes, err := mnr.serviceQuerier.RelayDifficulty(ctx, &servicetypes.QueryRelayDifficultyRequest{
+		ServiceId:          serviceId,
+		SessionStartHeight: sessionStartHeight,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	difficultyBits := int(relayDifficultyRes.GetDifficultyBits())
+
+	mnr.relayDifficultiesMu.Lock()
+	defer mnr.relayDifficultiesMu.Unlock()
+
+	// Relays of an earlier session, which may still be in flight, don't replace
+	// the cached relay difficulty of the current one.
+	if cached, ok := mnr.relayDifficulties[serviceId]; !ok || cached.sessionStartHeight < sessionStartHeight {
+		mnr.relayDifficulties[serviceId] = sessionRelayDifficulty{
+			sessionStartHeight: sessionStartHeight,
+			difficultyBits:     difficultyBits,
+		}
+	}
+
+	return difficultyBits, nil
 }
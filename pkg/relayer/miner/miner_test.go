@@ -12,6 +12,7 @@ import (
 	"testing"
 	"time"
 
+	"cosmossdk.io/depinject"
 	"github.com/stretchr/testify/require"
 
 	"github.com/pokt-network/poktroll/pkg/observable/channel"
@@ -38,11 +39,12 @@ func TestMiner_MinedRelays(t *testing.T) {
 		mockRelaysObs, relaysFixturePublishCh = channel.NewObservable[*servicetypes.Relay]()
 		expectedMinedRelays                   = unmarshalHexMinedRelays(
 			t, marshaledMinableRelaysHex,
-			miner.DefaultRelayHasher,
+			servicetypes.NewRelayHasher,
 		)
 	)
 
-	mnr, err := miner.NewMiner(miner.WithDifficulty(testDifficulty))
+	deps := depinject.Supply(relayer.QueryClientContext{})
+	mnr, err := miner.NewMiner(deps, miner.WithDifficulty(testDifficulty))
 	require.NoError(t, err)
 
 	minedRelays := mnr.MinedRelays(ctx, mockRelaysObs)
@@ -2,10 +2,11 @@ package miner
 
 import "github.com/pokt-network/poktroll/pkg/relayer"
 
-// WithDifficulty sets the difficulty of the miner, where difficultyBytes is the
-// minimum number of leading zero bytes.
+// WithDifficulty fixes the difficulty of the miner, where difficultyBits is the
+// minimum number of leading zero bits, instead of using the on-chain relay
+// difficulty of each relay's service.
 func WithDifficulty(difficultyBits int) relayer.MinerOption {
 	return func(mnr relayer.Miner) {
-		mnr.(*miner).relayDifficultyBits = difficultyBits
+		mnr.(*miner).fixedRelayDifficultyBits = &difficultyBits
 	}
 }
@@ -1,7 +1,6 @@
 package proxy
 
 import (
-	"log"
 	"net/http"
 
 	"github.com/pokt-network/poktroll/pkg/partials"
@@ -15,7 +14,7 @@ import (
 func (sync *synchronousRPCServer) replyWithError(payloadBz []byte, writer http.ResponseWriter, err error) {
 	responseBz, err := partials.GetErrorReply(payloadBz, err)
 	if err != nil {
-		log.Printf("ERROR: failed getting error reply: %s", err)
+		sync.logger.Error().Err(err).Msg("failed getting error reply")
 		return
 	}
 
@@ -23,12 +22,12 @@ func (sync *synchronousRPCServer) replyWithError(payloadBz []byte, writer http.R
 
 	relayResponseBz, err := relayResponse.Marshal()
 	if err != nil {
-		log.Printf("ERROR: failed marshaling relay response: %s", err)
+		sync.logger.Error().Err(err).Msg("failed marshaling relay response")
 		return
 	}
 
 	if _, err = writer.Write(relayResponseBz); err != nil {
-		log.Printf("ERROR: failed writing relay response: %s", err)
+		sync.logger.Error().Err(err).Msg("failed writing relay response")
 		return
 	}
 }
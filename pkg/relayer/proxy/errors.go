@@ -15,4 +15,7 @@ var (
 	ErrRelayerProxyInvalidRelayRequest               = sdkerrors.Register(codespace, 7, "invalid relay request")
 	ErrRelayerProxyInvalidRelayResponse              = sdkerrors.Register(codespace, 8, "invalid relay response")
 	ErrRelayerProxyEmptyRelayRequestSignature        = sdkerrors.Register(codespace, 9, "empty relay response signature")
+	ErrRelayerProxyInvalidMaxConcurrentVerifications = sdkerrors.Register(codespace, 10, "invalid max concurrent relay request verifications")
+	ErrRelayerProxyRelayQuotaExceeded                = sdkerrors.Register(codespace, 11, "application relay quota exceeded")
+	ErrRelayerProxyDuplicateRelayNonce               = sdkerrors.Register(codespace, 12, "duplicate relay request nonce")
 )
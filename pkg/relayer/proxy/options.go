@@ -18,3 +18,11 @@ func WithProxiedServicesEndpoints(proxiedServicesEndpoints servicesEndpointsMap)
 		relProxy.(*relayerProxy).proxiedServicesEndpoints = proxiedServicesEndpoints
 	}
 }
+
+// WithMaxConcurrentVerifications sets the maximum number of relay requests which
+// the relayer proxy verifies concurrently. It defaults to DefaultMaxConcurrentVerifications.
+func WithMaxConcurrentVerifications(maxConcurrentVerifications int) relayer.RelayerProxyOption {
+	return func(relProxy relayer.RelayerProxy) {
+		relProxy.(*relayerProxy).maxConcurrentVerifications = maxConcurrentVerifications
+	}
+}
@@ -6,21 +6,22 @@ import (
 	"sync"
 
 	"cosmossdk.io/depinject"
-	ringtypes "github.com/athanorlabs/go-dleq/types"
-	cosmosclient "github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
-	accounttypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"golang.org/x/sync/errgroup"
 
 	blocktypes "github.com/pokt-network/poktroll/pkg/client"
+	"github.com/pokt-network/poktroll/pkg/crypto"
+	"github.com/pokt-network/poktroll/pkg/crypto/rings"
 	"github.com/pokt-network/poktroll/pkg/observable/channel"
+	"github.com/pokt-network/poktroll/pkg/polylog"
 	"github.com/pokt-network/poktroll/pkg/relayer"
-	apptypes "github.com/pokt-network/poktroll/x/application/types"
 	"github.com/pokt-network/poktroll/x/service/types"
-	sessiontypes "github.com/pokt-network/poktroll/x/session/types"
-	suppliertypes "github.com/pokt-network/poktroll/x/supplier/types"
 )
 
+// DefaultMaxConcurrentVerifications is the default maximum number of relay
+// requests which the relayer proxy verifies concurrently.
+const DefaultMaxConcurrentVerifications = 256
+
 var _ relayer.RelayerProxy = (*relayerProxy)(nil)
 
 type (
@@ -35,6 +36,8 @@ type (
 // when the miner enters the claim/proof phase.
 // TODO_TEST: Have tests for the relayer proxy.
 type relayerProxy struct {
+	logger polylog.Logger
+
 	// signingKeyName is the supplier's key name in the Cosmos's keybase. It is used along with the keyring to
 	// get the supplier address and sign the relay responses.
 	signingKeyName string
@@ -44,21 +47,17 @@ type relayerProxy struct {
 	// and be notified of new incoming blocks. It is used to update the current session data.
 	blockClient blocktypes.BlockClient
 
-	// accountsQuerier is the querier used to get account data (e.g. app publicKey) from the blockchain,
-	// which, in the context of the RelayerProxy, is used to verify the relay request signatures.
-	accountsQuerier accounttypes.QueryClient
-
 	// supplierQuerier is the querier used to get the supplier's advertised information from the blockchain,
 	// which contains the supported services, RPC types, and endpoints, etc...
-	supplierQuerier suppliertypes.QueryClient
+	supplierQuerier blocktypes.SupplierQuerier
 
 	// sessionQuerier is the querier used to get the current session from the blockchain,
 	// which is needed to check if the relay proxy should be serving an incoming relay request.
-	sessionQuerier sessiontypes.QueryClient
+	sessionQuerier blocktypes.SessionQuerier
 
-	// applicationQuerier is the querier for the application module.
-	// It is used to get the ring for a given application address.
-	applicationQuerier apptypes.QueryClient
+	// ringClient is used to get the rings of applications, as of a given session,
+	// which the relay request signatures are verified against.
+	ringClient crypto.RingClient
 
 	// advertisedRelayServers is a map of the services provided by the relayer proxy. Each provided service
 	// has the necessary information to start the server that listens for incoming relay requests and
@@ -75,16 +74,35 @@ type relayerProxy struct {
 	// servedRelays observable can fan out the notifications to its subscribers.
 	servedRelaysPublishCh chan<- *types.Relay
 
-	// ringCache is a cache of the public keys used to create the ring for a given application
-	// they are stored in a map of application address to a slice of points on the secp256k1 curve
-	// TODO(@h5law): subscribe to on-chain events to update this cache as the ring changes over time
-	ringCache      map[string][]ringtypes.Point
-	ringCacheMutex *sync.RWMutex
+	// sessionCache is a cache of the sessions which relay requests are verified against,
+	// keyed by application address, service id and session start height. Each session is
+	// queried once and evicted when a block past its end is committed.
+	sessionCache   map[sessionCacheKey]*sessionCacheEntry
+	sessionCacheMu sync.Mutex
+
+	// relayerSessionsManager holds the session trees which the compute units served
+	// to each application are reserved in, against the maximum which it can be billed
+	// for in the respective session.
+	relayerSessionsManager relayer.RelayerSessionsManager
 
-	// clientCtx is the Cosmos' client context used to build the needed query clients and unmarshal their replies.
+	// sessionNonces tracks the nonces of the relay requests accepted in each session,
+	// keyed by session id, such that relay requests cannot be replayed within it.
+	sessionNonces   map[string]*sessionNonces
+	sessionNoncesMu sync.Mutex
+
+	// maxConcurrentVerifications is the maximum number of relay requests which are verified
+	// concurrently. Relay requests which exceed it wait for an ongoing verification to complete.
+	maxConcurrentVerifications int
+
+	// verificationSem is a semaphore with a capacity of maxConcurrentVerifications which
+	// bounds the number of concurrent relay request verifications.
+	verificationSem chan struct{}
+
+	// clientCtx is the Cosmos' client context whose keyring holds the supplier's key.
 	clientCtx relayer.QueryClientContext
 
-	// supplierAddress is the address of the supplier that the relayer proxy is running for.
+	// supplierAddress is the operator address of the supplier that the relayer
+	// proxy is running for, which its signing key corresponds to.
 	supplierAddress string
 }
 
@@ -92,38 +110,52 @@ type relayerProxy struct {
 // an error if the dependencies fail to resolve or the options are invalid.
 //
 // Required dependencies:
-//   - cosmosclient.Context
+//   - polylog.Logger
+//   - relayer.QueryClientContext
 //   - client.BlockClient
+//   - client.AccountQuerier
+//   - client.ApplicationQuerier
+//   - client.SupplierQuerier
+//   - client.SessionQuerier
+//   - relayer.RelayerSessionsManager
 //
 // Available options:
 //   - WithSigningKeyName
 //   - WithProxiedServicesEndpoints
+//   - WithMaxConcurrentVerifications
 func NewRelayerProxy(
 	deps depinject.Config,
 	opts ...relayer.RelayerProxyOption,
 ) (relayer.RelayerProxy, error) {
-	rp := &relayerProxy{}
+	rp := &relayerProxy{
+		sessionCache:               make(map[sessionCacheKey]*sessionCacheEntry),
+		sessionNonces:              make(map[string]*sessionNonces),
+		maxConcurrentVerifications: DefaultMaxConcurrentVerifications,
+	}
 
 	if err := depinject.Inject(
 		deps,
+		&rp.logger,
 		&rp.clientCtx,
 		&rp.blockClient,
+		&rp.supplierQuerier,
+		&rp.sessionQuerier,
+		&rp.relayerSessionsManager,
 	); err != nil {
 		return nil, err
 	}
 
-	clientCtx := cosmosclient.Context(rp.clientCtx)
+	ringClient, err := rings.NewRingClient(deps)
+	if err != nil {
+		return nil, err
+	}
+
 	servedRelays, servedRelaysProducer := channel.NewObservable[*types.Relay]()
 
+	rp.ringClient = ringClient
 	rp.servedRelays = servedRelays
 	rp.servedRelaysPublishCh = servedRelaysProducer
-	rp.accountsQuerier = accounttypes.NewQueryClient(clientCtx)
-	rp.supplierQuerier = suppliertypes.NewQueryClient(clientCtx)
-	rp.sessionQuerier = sessiontypes.NewQueryClient(clientCtx)
-	rp.applicationQuerier = apptypes.NewQueryClient(clientCtx)
 	rp.keyring = rp.clientCtx.Keyring
-	rp.ringCache = make(map[string][]ringtypes.Point) // the key is the appAddress
-	rp.ringCacheMutex = &sync.RWMutex{}
 
 	for _, opt := range opts {
 		opt(rp)
@@ -133,6 +165,8 @@ func NewRelayerProxy(
 		return nil, err
 	}
 
+	rp.verificationSem = make(chan struct{}, rp.maxConcurrentVerifications)
+
 	return rp, nil
 }
 
@@ -148,6 +182,8 @@ func (rp *relayerProxy) Start(ctx context.Context) error {
 		return err
 	}
 
+	rp.evictSessionsOnNewBlocks(ctx)
+
 	startGroup, ctx := errgroup.WithContext(ctx)
 
 	for _, relayServer := range rp.advertisedRelayServers {
@@ -193,5 +229,11 @@ func (rp *relayerProxy) validateConfig() error {
 		return ErrRelayerProxyUndefinedProxiedServicesEndpoints
 	}
 
+	if rp.maxConcurrentVerifications <= 0 {
+		return ErrRelayerProxyInvalidMaxConcurrentVerifications.Wrapf(
+			"must be positive, got %d", rp.maxConcurrentVerifications,
+		)
+	}
+
 	return nil
 }
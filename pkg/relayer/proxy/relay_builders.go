@@ -2,11 +2,9 @@ package proxy
 
 import (
 	"io"
-	"log"
 	"net/http"
 
 	"github.com/pokt-network/poktroll/x/service/types"
-	sessiontypes "github.com/pokt-network/poktroll/x/session/types"
 )
 
 // newRelayRequest builds a RelayRequest from an http.Request.
@@ -16,7 +14,7 @@ func (sync *synchronousRPCServer) newRelayRequest(request *http.Request) (*types
 		return nil, err
 	}
 
-	log.Printf("DEBUG: Unmarshaling relay request...")
+	sync.logger.Debug().Msg("unmarshaling relay request")
 	var relayReq types.RelayRequest
 	if err := relayReq.Unmarshal(requestBz); err != nil {
 		return nil, err
@@ -25,15 +23,25 @@ func (sync *synchronousRPCServer) newRelayRequest(request *http.Request) (*types
 	return &relayReq, nil
 }
 
-// newRelayResponse builds a RelayResponse from an http.Response and a SessionHeader.
+// newRelayResponse builds a RelayResponse from an http.Response and the RelayRequest
+// which it answers. It references the RelayRequest by its hash, and uses its
+// SessionHeader, which must have been verified to be valid.
 // It also signs the RelayResponse and assigns it to RelayResponse.Meta.SupplierSignature.
 // The response's Body is passed directly into the RelayResponse.Payload field.
 func (sync *synchronousRPCServer) newRelayResponse(
 	response *http.Response,
-	sessionHeader *sessiontypes.SessionHeader,
+	relayRequest *types.RelayRequest,
 ) (*types.RelayResponse, error) {
+	relayRequestHash, err := relayRequest.GetSignableBytesHash()
+	if err != nil {
+		return nil, err
+	}
+
 	relayResponse := &types.RelayResponse{
-		Meta: &types.RelayResponseMetadata{SessionHeader: sessionHeader},
+		Meta: &types.RelayResponseMetadata{
+			SessionHeader:    relayRequest.Meta.SessionHeader,
+			RelayRequestHash: relayRequestHash,
+		},
 	}
 
 	responseBz, err := io.ReadAll(response.Body)
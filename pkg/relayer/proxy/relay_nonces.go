@@ -0,0 +1,60 @@
+package proxy
+
+import (
+	sessiontypes "github.com/pokt-network/poktroll/x/session/types"
+)
+
+// sessionNonces tracks the nonces of the relay requests which the relayer proxy
+// has accepted in a given session.
+type sessionNonces struct {
+	// session is the session which the nonces are for. It is used to determine
+	// when they can be evicted.
+	session *sessiontypes.Session
+	// nonces is the set of accepted relay request nonces.
+	nonces map[string]struct{}
+}
+
+// consumeRelayNonce records the given relay request nonce as accepted in the
+// given session. It returns an ErrRelayerProxyDuplicateRelayNonce error if it
+// has already been accepted; i.e. the relay request is a replay and must not be
+// served.
+func (rp *relayerProxy) consumeRelayNonce(
+	session *sessiontypes.Session,
+	nonce []byte,
+) error {
+	rp.sessionNoncesMu.Lock()
+	defer rp.sessionNoncesMu.Unlock()
+
+	nonces, ok := rp.sessionNonces[session.GetSessionId()]
+	if !ok {
+		nonces = &sessionNonces{
+			session: session,
+			nonces:  make(map[string]struct{}),
+		}
+		rp.sessionNonces[session.GetSessionId()] = nonces
+	}
+
+	if _, ok := nonces.nonces[string(nonce)]; ok {
+		return ErrRelayerProxyDuplicateRelayNonce.Wrapf(
+			"nonce %x has already been used in session %s",
+			nonce, session.GetSessionId(),
+		)
+	}
+	nonces.nonces[string(nonce)] = struct{}{}
+
+	return nil
+}
+
+// evictEndedSessionNonces removes the nonces of the sessions which have ended as
+// of the given height. Relay requests for ended sessions are rejected, so their
+// nonces no longer need to be tracked.
+func (rp *relayerProxy) evictEndedSessionNonces(height int64) {
+	rp.sessionNoncesMu.Lock()
+	defer rp.sessionNoncesMu.Unlock()
+
+	for sessionId, nonces := range rp.sessionNonces {
+		if nonces.session.IsEnded(height) {
+			delete(rp.sessionNonces, sessionId)
+		}
+	}
+}
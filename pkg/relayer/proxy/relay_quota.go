@@ -0,0 +1,58 @@
+package proxy
+
+import (
+	servicetypes "github.com/pokt-network/poktroll/x/service/types"
+	sessiontypes "github.com/pokt-network/poktroll/x/session/types"
+	"github.com/pokt-network/poktroll/x/shared/helpers"
+)
+
+// reserveRelayQuota reserves the compute units of a relay (see:
+// helpers.ComputeUnitsPerRelay) in the session tree of the given session, against
+// the maximum which its application can be billed for in the session (see:
+// helpers.MaxSessionComputeUnits). It returns an ErrRelayerProxyRelayQuotaExceeded
+// error, without reserving them, if the application can't be billed for them;
+// i.e. the relay must not be served. The reserved compute units are released if
+// the relay fails to be served (see: ReleaseRelayRequest) such that the
+// application is only billed for served relays.
+func (rp *relayerProxy) reserveRelayQuota(session *sessiontypes.Session) error {
+	maxComputeUnits, err := helpers.MaxSessionComputeUnits(
+		session.GetApplication().GetStake(),
+		len(session.GetSuppliers()),
+	)
+	if err != nil {
+		return ErrRelayerProxyRelayQuotaExceeded.Wrapf(
+			"unable to determine quota of application %s in session %s: %s",
+			session.GetApplication().GetAddress(), session.GetSessionId(), err,
+		)
+	}
+
+	sessionTree, err := rp.relayerSessionsManager.EnsureSessionTree(session.GetHeader())
+	if err != nil {
+		return err
+	}
+
+	if err := sessionTree.ReserveComputeUnits(helpers.ComputeUnitsPerRelay, maxComputeUnits); err != nil {
+		return ErrRelayerProxyRelayQuotaExceeded.Wrapf(
+			"application %s in session %s: %s",
+			session.GetApplication().GetAddress(), session.GetSessionId(), err,
+		)
+	}
+
+	return nil
+}
+
+// ReleaseRelayRequest releases the compute units which were reserved for the
+// given relay request when it was verified, such that its application isn't
+// billed for it. It is expected to be called if the relay fails to be served.
+func (rp *relayerProxy) ReleaseRelayRequest(relayRequest *servicetypes.RelayRequest) error {
+	sessionTree, err := rp.relayerSessionsManager.EnsureSessionTree(
+		relayRequest.GetMeta().GetSessionHeader(),
+	)
+	if err != nil {
+		return err
+	}
+
+	sessionTree.ReleaseComputeUnits(helpers.ComputeUnitsPerRelay)
+
+	return nil
+}
@@ -2,7 +2,6 @@ package proxy
 
 import (
 	"context"
-	"log"
 
 	sdkerrors "cosmossdk.io/errors"
 	ring_secp256k1 "github.com/athanorlabs/go-dleq/secp256k1"
@@ -20,16 +19,51 @@ func (rp *relayerProxy) VerifyRelayRequest(
 	relayRequest *types.RelayRequest,
 	service *sharedtypes.Service,
 ) error {
+	session, err := rp.verifyRelayRequestSignatureAndSession(ctx, relayRequest, service)
+	if err != nil {
+		return err
+	}
+
+	// Check that the relay request isn't a replay of one which was already
+	// accepted in the session.
+	if err := rp.consumeRelayNonce(session, relayRequest.Meta.Nonce); err != nil {
+		return err
+	}
+
+	// Check that the application can be billed for the relay and reserve its
+	// compute units, which are released if it fails to be served.
+	return rp.reserveRelayQuota(session)
+}
+
+// verifyRelayRequestSignatureAndSession checks that the relay request is for the
+// current session, which this supplier is part of, and that it is signed by the
+// ring of its application as of that session. The session is validated against
+// the on-chain state first, so that the ring is only fetched for sessions which
+// exist. It returns the current session. The number of relay
+// requests which are verified concurrently is bounded such that cache misses
+// don't overwhelm the full node with queries.
+func (rp *relayerProxy) verifyRelayRequestSignatureAndSession(
+	ctx context.Context,
+	relayRequest *types.RelayRequest,
+	service *sharedtypes.Service,
+) (*sessiontypes.Session, error) {
+	select {
+	case rp.verificationSem <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	defer func() { <-rp.verificationSem }()
+
 	// extract the relay request's ring signature
-	log.Printf("DEBUG: Verifying relay request signature...")
+	rp.logger.Debug().Msg("verifying relay request signature")
 	if relayRequest.Meta == nil {
-		return ErrRelayerProxyEmptyRelayRequestSignature.Wrapf(
+		return nil, ErrRelayerProxyEmptyRelayRequestSignature.Wrapf(
 			"request payload: %s", relayRequest.Payload,
 		)
 	}
 	signature := relayRequest.Meta.Signature
 	if signature == nil {
-		return sdkerrors.Wrapf(
+		return nil, sdkerrors.Wrapf(
 			ErrRelayerProxyInvalidRelayRequest,
 			"missing signature from relay request: %v", relayRequest,
 		)
@@ -37,17 +71,68 @@ func (rp *relayerProxy) VerifyRelayRequest(
 
 	ringSig := new(ring.RingSig)
 	if err := ringSig.Deserialize(ring_secp256k1.NewCurve(), signature); err != nil {
-		return sdkerrors.Wrapf(
+		return nil, sdkerrors.Wrapf(
 			ErrRelayerProxyInvalidRelayRequestSignature,
 			"error deserializing ring signature: %v", err,
 		)
 	}
 
-	// get the ring for the application address of the relay request
-	appAddress := relayRequest.Meta.SessionHeader.ApplicationAddress
-	appRing, err := rp.getRingForAppAddress(ctx, appAddress)
+	// The signed relay request must be for this supplier such that relay requests
+	// which were signed for other suppliers cannot be replayed to it.
+	if relayRequest.Meta.SupplierAddress != rp.supplierAddress {
+		return nil, ErrRelayerProxyInvalidSupplier.Wrapf(
+			"relay request is for supplier %q", relayRequest.Meta.SupplierAddress,
+		)
+	}
+	if len(relayRequest.Meta.Nonce) != types.RelayRequestNonceSize {
+		return nil, sdkerrors.Wrapf(
+			ErrRelayerProxyInvalidRelayRequest,
+			"relay request nonce must be %d bytes, got %d",
+			types.RelayRequestNonceSize, len(relayRequest.Meta.Nonce),
+		)
+	}
+
+	// Get the current session to check if relayRequest sessionId matches it.
+	rp.logger.Debug().Msg("verifying relay request session")
+	session, err := rp.getSessionForRelayRequest(ctx, relayRequest.Meta.SessionHeader, service)
+	if err != nil {
+		return nil, err
+	}
+
+	// Since the retrieved sessionId was in terms of:
+	// - the current block height (which is not provided by the relayRequest)
+	// - serviceId (which is not provided by the relayRequest)
+	// - applicationAddress (which is used to to verify the relayRequest signature)
+	// we can reduce the session validity check to checking if the retrieved session's sessionId
+	// matches the relayRequest sessionId.
+	// TODO_INVESTIGATE: Revisit the assumptions above at some point in the future, but good enough for now.
+	// The session end height is also checked since the relay request's session
+	// header identifies the session tree which its compute units are reserved in.
+	if session.SessionId != relayRequest.Meta.SessionHeader.SessionId ||
+		session.GetHeader().GetSessionEndBlockHeight() != relayRequest.Meta.SessionHeader.SessionEndBlockHeight {
+		return nil, ErrRelayerProxyInvalidSession.Wrapf("%+v", session)
+	}
+
+	// Check if the relayRequest is allowed to be served by the relayer proxy.
+	isSessionSupplier := false
+	for _, supplier := range session.Suppliers {
+		if supplier.OperatorAddress == rp.supplierAddress {
+			isSessionSupplier = true
+			break
+		}
+	}
+	if !isSessionSupplier {
+		return nil, ErrRelayerProxyInvalidSupplier
+	}
+
+	// get the ring for the application address of the relay request, as of the
+	// session which was validated against the on-chain state above, since
+	// delegation changes only take effect at session boundaries.
+	appAddress := session.GetHeader().GetApplicationAddress()
+	sessionStartHeight := session.GetHeader().GetSessionStartBlockHeight()
+	appRing, err := rp.ringClient.GetRingForAddress(ctx, appAddress, sessionStartHeight)
 	if err != nil {
-		return sdkerrors.Wrapf(
+		return nil, sdkerrors.Wrapf(
 			ErrRelayerProxyInvalidRelayRequest,
 			"error getting ring for application address %s: %v", appAddress, err,
 		)
@@ -55,7 +140,7 @@ func (rp *relayerProxy) VerifyRelayRequest(
 
 	// verify the ring signature against the ring
 	if !ringSig.Ring().Equals(appRing) {
-		return sdkerrors.Wrapf(
+		return nil, sdkerrors.Wrapf(
 			ErrRelayerProxyInvalidRelayRequestSignature,
 			"ring signature does not match ring for application address %s", appAddress,
 		)
@@ -64,7 +149,7 @@ func (rp *relayerProxy) VerifyRelayRequest(
 	// get and hash the signable bytes of the relay request
 	signableBz, err := relayRequest.GetSignableBytes()
 	if err != nil {
-		return sdkerrors.Wrapf(ErrRelayerProxyInvalidRelayRequest, "error getting signable bytes: %v", err)
+		return nil, sdkerrors.Wrapf(ErrRelayerProxyInvalidRelayRequest, "error getting signable bytes: %v", err)
 	}
 
 	hash := crypto.Sha256(signableBz)
@@ -73,44 +158,11 @@ func (rp *relayerProxy) VerifyRelayRequest(
 
 	// verify the relay request's signature
 	if valid := ringSig.Verify(hash32); !valid {
-		return sdkerrors.Wrapf(
+		return nil, sdkerrors.Wrapf(
 			ErrRelayerProxyInvalidRelayRequestSignature,
 			"invalid ring signature",
 		)
 	}
 
-	// Query for the current session to check if relayRequest sessionId matches the current session.
-	log.Printf("DEBUG: Verifying relay request session...")
-	currentBlock := rp.blockClient.LatestBlock(ctx)
-	sessionQuery := &sessiontypes.QueryGetSessionRequest{
-		ApplicationAddress: appAddress,
-		Service:            service,
-		BlockHeight:        currentBlock.Height(),
-	}
-	sessionResponse, err := rp.sessionQuerier.GetSession(ctx, sessionQuery)
-	if err != nil {
-		return err
-	}
-
-	session := sessionResponse.Session
-
-	// Since the retrieved sessionId was in terms of:
-	// - the current block height (which is not provided by the relayRequest)
-	// - serviceId (which is not provided by the relayRequest)
-	// - applicationAddress (which is used to to verify the relayRequest signature)
-	// we can reduce the session validity check to checking if the retrieved session's sessionId
-	// matches the relayRequest sessionId.
-	// TODO_INVESTIGATE: Revisit the assumptions above at some point in the future, but good enough for now.
-	if session.SessionId != relayRequest.Meta.SessionHeader.SessionId {
-		return ErrRelayerProxyInvalidSession.Wrapf("%+v", session)
-	}
-
-	// Check if the relayRequest is allowed to be served by the relayer proxy.
-	for _, supplier := range session.Suppliers {
-		if supplier.Address == rp.supplierAddress {
-			return nil
-		}
-	}
-
-	return ErrRelayerProxyInvalidSupplier
+	return session, nil
 }
@@ -2,19 +2,17 @@ package proxy
 
 import (
 	"context"
-	"log"
 	"net/url"
 
 	"github.com/pokt-network/poktroll/pkg/relayer"
 	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
-	suppliertypes "github.com/pokt-network/poktroll/x/supplier/types"
 )
 
 // BuildProvidedServices builds the advertised relay servers from the supplier's on-chain advertised services.
 // It populates the relayerProxy's `advertisedRelayServers` map of servers for each service, where each server
 // is responsible for listening for incoming relay requests and relaying them to the supported proxied service.
 func (rp *relayerProxy) BuildProvidedServices(ctx context.Context) error {
-	// Get the supplier address from the keyring
+	// Get the supplier operator address from the keyring
 	supplierKey, err := rp.keyring.Key(rp.signingKeyName)
 	if err != nil {
 		return err
@@ -26,13 +24,12 @@ func (rp *relayerProxy) BuildProvidedServices(ctx context.Context) error {
 	}
 
 	// Get the supplier's advertised information from the blockchain
-	supplierQuery := &suppliertypes.QueryGetSupplierRequest{Address: supplierAddress.String()}
-	supplierQueryResponse, err := rp.supplierQuerier.Supplier(ctx, supplierQuery)
+	supplier, err := rp.supplierQuerier.GetSupplier(ctx, supplierAddress.String())
 	if err != nil {
 		return err
 	}
 
-	services := supplierQueryResponse.Supplier.Services
+	services := supplier.Services
 
 	// Build the advertised relay servers map. For each service's endpoint, create the appropriate RelayServer.
 	providedServices := make(relayServersMap)
@@ -50,10 +47,10 @@ func (rp *relayerProxy) BuildProvidedServices(ctx context.Context) error {
 
 			var server relayer.RelayServer
 
-			log.Printf(
-				"INFO: starting relay server for service %s at endpoint %s",
-				service.Id, endpoint.Url,
-			)
+			rp.logger.Info().
+				Str("service_id", service.Id).
+				Str("endpoint_url", endpoint.Url).
+				Msg("starting relay server")
 
 			// Switch to the RPC type
 			// TODO(@h5law): Implement a switch that handles all synchronous
@@ -62,6 +59,7 @@ func (rp *relayerProxy) BuildProvidedServices(ctx context.Context) error {
 			switch endpoint.RpcType {
 			case sharedtypes.RPCType_JSON_RPC:
 				server = NewSynchronousServer(
+					rp.logger,
 					service,
 					supplierEndpointHost,
 					proxiedServicesEndpoints,
@@ -79,7 +77,7 @@ func (rp *relayerProxy) BuildProvidedServices(ctx context.Context) error {
 	}
 
 	rp.advertisedRelayServers = providedServices
-	rp.supplierAddress = supplierQueryResponse.Supplier.Address
+	rp.supplierAddress = supplierQueryResponse.Supplier.OperatorAddress
 
 	return nil
 }
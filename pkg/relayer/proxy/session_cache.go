@@ -0,0 +1,161 @@
+package proxy
+
+import (
+	"context"
+
+	"github.com/pokt-network/poktroll/pkg/client"
+	"github.com/pokt-network/poktroll/pkg/observable"
+	"github.com/pokt-network/poktroll/pkg/observable/channel"
+	sessiontypes "github.com/pokt-network/poktroll/x/session/types"
+	sharedhelpers "github.com/pokt-network/poktroll/x/shared/helpers"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+)
+
+// sessionCacheKey identifies a session by the application it is for, the
+// service it is for and the height at which it starts.
+type sessionCacheKey struct {
+	appAddress         string
+	serviceId          string
+	sessionStartHeight int64
+}
+
+// sessionCacheEntry holds the result of the query for a session. It is inserted
+// into the cache before the query is made such that concurrent relay requests
+// for the same session wait for the same query instead of making their own.
+type sessionCacheEntry struct {
+	// ready is closed once session and err are set.
+	ready   chan struct{}
+	session *sessiontypes.Session
+	err     error
+}
+
+// getSessionForRelayRequest returns the current session of the application and
+// service identified by the given session header, which is expected to be the
+// one that the relay request claims to be for. Sessions are cached by the start
+// height of the current session, which is derived from the latest block, and
+// relay requests which claim to be for any other session are rejected without
+// querying. Sessions are queried once per session and cached until a block which
+// is past their end is committed (see: evictSessionsOnNewBlocks).
+func (rp *relayerProxy) getSessionForRelayRequest(
+	ctx context.Context,
+	sessionHeader *sessiontypes.SessionHeader,
+	service *sharedtypes.Service,
+) (*sessiontypes.Session, error) {
+	currentHeight := rp.blockClient.LatestBlock(ctx).Height()
+	key := sessionCacheKey{
+		appAddress:         sessionHeader.GetApplicationAddress(),
+		serviceId:          service.GetId(),
+		sessionStartHeight: sharedhelpers.GetSessionStartBlockHeight(currentHeight),
+	}
+
+	if sessionHeader.GetSessionStartBlockHeight() != key.sessionStartHeight {
+		return nil, ErrRelayerProxyInvalidSession.Wrapf(
+			"relay request session starts at height %d but the current session starts at height %d",
+			sessionHeader.GetSessionStartBlockHeight(), key.sessionStartHeight,
+		)
+	}
+
+	rp.sessionCacheMu.Lock()
+	entry, ok := rp.sessionCache[key]
+	if !ok {
+		entry = &sessionCacheEntry{ready: make(chan struct{})}
+		rp.sessionCache[key] = entry
+	}
+	rp.sessionCacheMu.Unlock()
+
+	if !ok {
+		rp.logger.Debug().
+			Str("app_address", key.appAddress).
+			Str("service_id", key.serviceId).
+			Int64("session_start_height", key.sessionStartHeight).
+			Msg("session not found in cache; querying session module")
+		rp.fillSessionCacheEntry(ctx, key, entry, service, currentHeight)
+	}
+
+	select {
+	case <-entry.ready:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	if entry.err != nil {
+		return nil, entry.err
+	}
+
+	// The cached session may have ended since it was queried if the block which
+	// evicts it hasn't been received yet.
+	if entry.session.IsEnded(currentHeight) {
+		return nil, ErrRelayerProxyInvalidSession.Wrapf(
+			"session %s ended before height %d",
+			entry.session.GetSessionId(), currentHeight,
+		)
+	}
+
+	return entry.session, nil
+}
+
+// fillSessionCacheEntry queries for the session at the given height and sets the
+// result on the given entry. If the query fails, or the queried session doesn't
+// start at the height the entry is keyed by, the entry is resolved with an error
+// and removed from the cache such that it isn't served to subsequent relay requests.
+func (rp *relayerProxy) fillSessionCacheEntry(
+	ctx context.Context,
+	key sessionCacheKey,
+	entry *sessionCacheEntry,
+	service *sharedtypes.Service,
+	currentHeight int64,
+) {
+	defer close(entry.ready)
+
+	session, err := rp.sessionQuerier.GetSession(ctx, key.appAddress, service.GetId(), currentHeight)
+	if err == nil && session.GetHeader().GetSessionStartBlockHeight() != key.sessionStartHeight {
+		err = ErrRelayerProxyInvalidSession.Wrapf(
+			"session %s starts at height %d, expected %d",
+			session.GetSessionId(), session.GetHeader().GetSessionStartBlockHeight(), key.sessionStartHeight,
+		)
+	}
+
+	rp.sessionCacheMu.Lock()
+	defer rp.sessionCacheMu.Unlock()
+
+	if err != nil {
+		entry.err = err
+		delete(rp.sessionCache, key)
+		return
+	}
+
+	entry.session = session
+}
+
+// evictSessionsOnNewBlocks removes sessions which have ended, and their relay
+// nonces, from the respective caches whenever a new block is committed.
+func (rp *relayerProxy) evictSessionsOnNewBlocks(ctx context.Context) {
+	channel.ForEach(
+		ctx,
+		observable.Observable[client.Block](rp.blockClient.CommittedBlocksSequence(ctx)),
+		func(_ context.Context, block client.Block) {
+			rp.evictEndedSessions(block.Height())
+			rp.evictEndedSessionNonces(block.Height())
+		},
+	)
+}
+
+// evictEndedSessions removes the sessions which have ended as of the given
+// height from the session cache. Entries whose query is still in flight are
+// left in place.
+func (rp *relayerProxy) evictEndedSessions(height int64) {
+	rp.sessionCacheMu.Lock()
+	defer rp.sessionCacheMu.Unlock()
+
+	for key, entry := range rp.sessionCache {
+		select {
+		case <-entry.ready:
+		default:
+			continue
+		}
+
+		if entry.err != nil || entry.session.IsEnded(height) {
+			delete(rp.sessionCache, key)
+		}
+	}
+}
@@ -4,10 +4,10 @@ import (
 	"bytes"
 	"context"
 	"io"
-	"log"
 	"net/http"
 	"net/url"
 
+	"github.com/pokt-network/poktroll/pkg/polylog"
 	"github.com/pokt-network/poktroll/pkg/relayer"
 	"github.com/pokt-network/poktroll/x/service/types"
 	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
@@ -19,6 +19,8 @@ var _ relayer.RelayServer = (*synchronousRPCServer)(nil)
 // RPC server. It is used to listen for and respond to relay requests where
 // there is a one-to-one correspondence between relay requests and relay responses.
 type synchronousRPCServer struct {
+	logger polylog.Logger
+
 	// service is the service that the server is responsible for.
 	service *sharedtypes.Service
 
@@ -41,6 +43,7 @@ type synchronousRPCServer struct {
 // It takes the serviceId, endpointUrl, and the main RelayerProxy as arguments
 // and returns a RelayServer that listens to incoming RelayRequests.
 func NewSynchronousServer(
+	logger polylog.Logger,
 	service *sharedtypes.Service,
 	supplierEndpointHost string,
 	proxiedServiceEndpoint *url.URL,
@@ -48,6 +51,7 @@ func NewSynchronousServer(
 	proxy relayer.RelayerProxy,
 ) relayer.RelayServer {
 	return &synchronousRPCServer{
+		logger:                 logger,
 		service:                service,
 		server:                 &http.Server{Addr: supplierEndpointHost},
 		relayerProxy:           proxy,
@@ -88,14 +92,14 @@ func (sync *synchronousRPCServer) Service() *sharedtypes.Service {
 func (sync *synchronousRPCServer) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
 	ctx := request.Context()
 
-	log.Printf("DEBUG: Serving synchronous relay request...")
+	sync.logger.Debug().Msg("serving synchronous relay request")
 
 	// Extract the relay request from the request body.
-	log.Printf("DEBUG: Extracting relay request from request body...")
+	sync.logger.Debug().Msg("extracting relay request from request body")
 	relayRequest, err := sync.newRelayRequest(request)
 	if err != nil {
 		sync.replyWithError(relayRequest.Payload, writer, err)
-		log.Printf("WARN: failed serving relay request: %s", err)
+		sync.logger.Warn().Err(err).Msg("failed serving relay request")
 		return
 	}
 
@@ -104,24 +108,24 @@ func (sync *synchronousRPCServer) ServeHTTP(writer http.ResponseWriter, request
 	if err != nil {
 		// Reply with an error if the relay could not be served.
 		sync.replyWithError(relayRequest.Payload, writer, err)
-		log.Printf("WARN: failed serving relay request: %s", err)
+		sync.logger.Warn().Err(err).Msg("failed serving relay request")
 		return
 	}
 
 	// Send the relay response to the client.
 	if err := sync.sendRelayResponse(relay.Res, writer); err != nil {
+		sync.releaseRelayRequest(relayRequest)
 		sync.replyWithError(relayRequest.Payload, writer, err)
-		log.Printf("WARN: failed sending relay response: %s", err)
+		sync.logger.Warn().Err(err).Msg("failed sending relay response")
 		return
 	}
 
-	log.Printf(
-		"INFO: relay request served successfully for application %s, service %s, session start block height %d, proxied service %s",
-		relay.Res.Meta.SessionHeader.ApplicationAddress,
-		relay.Res.Meta.SessionHeader.Service.Id,
-		relay.Res.Meta.SessionHeader.SessionStartBlockHeight,
-		sync.server.Addr,
-	)
+	sync.logger.Info().
+		Str("application_address", relay.Res.Meta.SessionHeader.ApplicationAddress).
+		Str("service_id", relay.Res.Meta.SessionHeader.Service.Id).
+		Int64("session_start_height", relay.Res.Meta.SessionHeader.SessionStartBlockHeight).
+		Str("server_addr", sync.server.Addr).
+		Msg("relay request served successfully")
 
 	// Emit the relay to the servedRelays observable.
 	sync.servedRelaysProducer <- relay
@@ -140,30 +144,52 @@ func (sync *synchronousRPCServer) serveHTTP(
 	// request signature verification, session verification, and response signature.
 	// This would help in separating concerns and improving code maintainability.
 	// See https://github.com/pokt-network/poktroll/issues/160
+	//
+	// The relay request is verified, and its nonce and quota checked, before it
+	// is forwarded such that requests which are unsigned, unauthorized or over
+	// quota never reach the proxied service.
 	if err := sync.relayerProxy.VerifyRelayRequest(ctx, relayRequest, sync.service); err != nil {
 		return nil, err
 	}
 
+	relay, err := sync.forwardRelayRequest(ctx, request, relayRequest)
+	if err != nil {
+		// The relay wasn't served, so the application must not be billed for it.
+		sync.releaseRelayRequest(relayRequest)
+		return nil, err
+	}
+
+	return relay, nil
+}
+
+// forwardRelayRequest sends the verified relay request to the proxied service
+// and builds the relay from its response.
+func (sync *synchronousRPCServer) forwardRelayRequest(
+	ctx context.Context,
+	request *http.Request,
+	relayRequest *types.RelayRequest,
+) (*types.Relay, error) {
 	// Get the relayRequest payload's `io.ReadCloser` to add it to the http.Request
 	// that will be sent to the proxied (i.e. staked for) service.
 	// (see https://pkg.go.dev/net/http#Request) Body field type.
 	requestBodyReader := io.NopCloser(bytes.NewBuffer(relayRequest.Payload))
-	log.Printf("DEBUG: Relay request payload: %s", string(relayRequest.Payload))
+	sync.logger.Debug().
+		Str("relay_request_payload", string(relayRequest.Payload)).
+		Msg("relay request payload")
 
 	// Build the request to be sent to the native service by substituting
 	// the destination URL's host with the native service's listen address.
-	log.Printf(
-		"DEBUG: Building relay request to native service %s...",
-		sync.proxiedServiceEndpoint.String(),
-	)
+	sync.logger.Debug().
+		Str("destination_url", sync.proxiedServiceEndpoint.String()).
+		Msg("building relay request to native service")
 
-	relayHTTPRequest := &http.Request{
+	relayHTTPRequest := (&http.Request{
 		Method: request.Method,
 		Header: request.Header,
 		URL:    &sync.proxiedServiceEndpoint,
 		Host:   sync.proxiedServiceEndpoint.Host,
 		Body:   requestBodyReader,
-	}
+	}).WithContext(ctx)
 
 	// Send the relay request to the native service.
 	httpResponse, err := http.DefaultClient.Do(relayHTTPRequest)
@@ -174,8 +200,8 @@ func (sync *synchronousRPCServer) serveHTTP(
 	// Build the relay response from the native service response
 	// Use relayRequest.Meta.SessionHeader on the relayResponse session header since it was verified to be valid
 	// and has to be the same as the relayResponse session header.
-	log.Printf("DEBUG: Building relay response from native service response...")
-	relayResponse, err := sync.newRelayResponse(httpResponse, relayRequest.Meta.SessionHeader)
+	sync.logger.Debug().Msg("building relay response from native service response")
+	relayResponse, err := sync.newRelayResponse(httpResponse, relayRequest)
 	if err != nil {
 		return nil, err
 	}
@@ -183,6 +209,14 @@ func (sync *synchronousRPCServer) serveHTTP(
 	return &types.Relay{Req: relayRequest, Res: relayResponse}, nil
 }
 
+// releaseRelayRequest releases the compute units which were reserved for the
+// given relay request when it was verified, logging any error.
+func (sync *synchronousRPCServer) releaseRelayRequest(relayRequest *types.RelayRequest) {
+	if err := sync.relayerProxy.ReleaseRelayRequest(relayRequest); err != nil {
+		sync.logger.Warn().Err(err).Msg("failed releasing relay request compute units")
+	}
+}
+
 // sendRelayResponse marshals the relay response and sends it to the client.
 func (sync *synchronousRPCServer) sendRelayResponse(
 	relayResponse *types.RelayResponse,
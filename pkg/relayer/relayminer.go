@@ -2,9 +2,10 @@ package relayer
 
 import (
 	"context"
-	"log"
 
 	"cosmossdk.io/depinject"
+
+	"github.com/pokt-network/poktroll/pkg/polylog"
 )
 
 // relayMiner is the main struct that encapsulates the relayer's responsibilities (i.e. Relay Mining).
@@ -46,20 +47,22 @@ func NewRelayMiner(ctx context.Context, deps depinject.Config) (*relayMiner, err
 // This method is blocking while the relayer proxy is running and returns when Stop is called
 // or when the relayer proxy fails to start.
 func (rel *relayMiner) Start(ctx context.Context) error {
+	logger := polylog.Ctx(ctx)
+
 	// relayerSessionsManager.Start does not block.
 	// Set up the session (proof/claim) lifecycle pipeline.
-	log.Println("INFO: Starting relayer sessions manager...")
+	logger.Info().Msg("starting relayer sessions manager")
 	rel.relayerSessionsManager.Start(ctx)
 
 	// Start the flow of relays by starting relayer proxy.
 	// This is a blocking call as it waits for the waitgroup in relayerProxy.Start()
 	// that starts all the relay servers to be done.
-	log.Println("INFO: Starting relayer proxy...")
+	logger.Info().Msg("starting relayer proxy")
 	if err := rel.relayerProxy.Start(ctx); err != nil {
 		return err
 	}
 
-	log.Println("INFO: Relayer proxy stopped; exiting")
+	logger.Info().Msg("relayer proxy stopped; exiting")
 	return nil
 }
 
@@ -2,7 +2,6 @@ package session
 
 import (
 	"context"
-	"log"
 
 	"github.com/pokt-network/poktroll/pkg/either"
 	"github.com/pokt-network/poktroll/pkg/observable"
@@ -11,6 +10,7 @@ import (
 	"github.com/pokt-network/poktroll/pkg/observable/logging"
 	"github.com/pokt-network/poktroll/pkg/relayer"
 	"github.com/pokt-network/poktroll/pkg/relayer/protocol"
+	servicetypes "github.com/pokt-network/poktroll/x/service/types"
 )
 
 // createClaims maps over the sessionsToClaimObs observable. For each claim, it:
@@ -78,15 +78,21 @@ func (rs *relayerSessionsManager) waitForEarliestCreateClaimHeight(
 
 	// we wait for createClaimWindowStartHeight to be received before proceeding since we need its hash
 	// to know where this servicer's claim submission window starts.
-	log.Printf("waiting & blocking for global earliest claim submission createClaimWindowStartBlock height: %d", createClaimWindowStartHeight)
+	rs.logger.Info().
+		Int64("create_claim_window_start_height", createClaimWindowStartHeight).
+		Msg("waiting & blocking for global earliest claim submission height")
 	createClaimWindowStartBlock := rs.waitForBlock(ctx, createClaimWindowStartHeight)
 
-	log.Printf("received earliest claim submission createClaimWindowStartBlock height: %d, use its hash to have a random submission for the servicer", createClaimWindowStartBlock.Height())
+	rs.logger.Info().
+		Int64("create_claim_window_start_height", createClaimWindowStartBlock.Height()).
+		Msg("received global earliest claim submission height; using its hash for a random submission height")
 
 	earliestCreateClaimHeight :=
-		protocol.GetEarliestCreateClaimHeight(createClaimWindowStartBlock)
+		protocol.GetEarliestCreateClaimHeight(ctx, createClaimWindowStartBlock)
 
-	log.Printf("earliest claim submission createClaimWindowStartBlock height for this supplier: %d", earliestCreateClaimHeight)
+	rs.logger.Info().
+		Int64("earliest_create_claim_height", earliestCreateClaimHeight).
+		Msg("waiting & blocking for earliest claim submission height for this supplier")
 	_ = rs.waitForBlock(ctx, earliestCreateClaimHeight)
 }
 
@@ -106,8 +112,28 @@ func (rs *relayerSessionsManager) newMapClaimSessionFn(
 			return either.Error[relayer.SessionTree](err), false
 		}
 
+		// There is nothing to claim if no relays were mined in the session; e.g.
+		// if all of the relays which were reserved for it failed to be served.
+		numMinedRelays, err := servicetypes.RelaySMSTRootSum(claimRoot)
+		if err != nil {
+			return either.Error[relayer.SessionTree](err), false
+		}
+		if numMinedRelays == 0 {
+			rs.logger.Info().
+				Str("session_id", session.GetSessionHeader().GetSessionId()).
+				Msg("no relays mined in session; skipping claim")
+
+			if err := session.Delete(); err != nil {
+				return either.Error[relayer.SessionTree](err), false
+			}
+			return either.SessionTree{}, true
+		}
+
 		latestBlock := rs.blockClient.LatestBlock(ctx)
-		log.Printf("INFO: currentBlock: %d, submitting claim", latestBlock.Height()+1)
+		rs.logger.Info().
+			Int64("current_height", latestBlock.Height()+1).
+			Str("session_id", session.GetSessionHeader().GetSessionId()).
+			Msg("submitting claim")
 
 		sessionHeader := session.GetSessionHeader()
 		if err := rs.supplierClient.CreateClaim(ctx, *sessionHeader, claimRoot); err != nil {
@@ -2,7 +2,6 @@ package session
 
 import (
 	"context"
-	"log"
 	"sync"
 
 	"cosmossdk.io/depinject"
@@ -11,7 +10,9 @@ import (
 	"github.com/pokt-network/poktroll/pkg/observable"
 	"github.com/pokt-network/poktroll/pkg/observable/channel"
 	"github.com/pokt-network/poktroll/pkg/observable/logging"
+	"github.com/pokt-network/poktroll/pkg/polylog"
 	"github.com/pokt-network/poktroll/pkg/relayer"
+	servicetypes "github.com/pokt-network/poktroll/x/service/types"
 	sessiontypes "github.com/pokt-network/poktroll/x/session/types"
 )
 
@@ -22,6 +23,8 @@ type sessionsTreesMap = map[int64]map[string]relayer.SessionTree
 // relayerSessionsManager is an implementation of the RelayerSessions interface.
 // TODO_TEST: Add tests to the relayerSessionsManager.
 type relayerSessionsManager struct {
+	logger polylog.Logger
+
 	relayObs relayer.MinedRelaysObservable
 
 	// sessionsToClaimObs notifies about sessions that are ready to be claimed.
@@ -58,6 +61,7 @@ func NewRelayerSessions(
 	opts ...relayer.RelayerSessionsManagerOption,
 ) (relayer.RelayerSessionsManager, error) {
 	rs := &relayerSessionsManager{
+		logger:          polylog.Ctx(ctx),
 		sessionsTrees:   make(sessionsTreesMap),
 		sessionsTreesMu: &sync.Mutex{},
 	}
@@ -124,8 +128,17 @@ func (rs *relayerSessionsManager) InsertRelays(relays relayer.MinedRelaysObserva
 	rs.relayObs = relays
 }
 
-// ensureSessionTree returns the SessionTree for a given session.
+// EnsureSessionTree returns the SessionTree for a given session.
 // If no tree for the session exists, a new SessionTree is created before returning.
+func (rs *relayerSessionsManager) EnsureSessionTree(sessionHeader *sessiontypes.SessionHeader) (relayer.SessionTree, error) {
+	rs.sessionsTreesMu.Lock()
+	defer rs.sessionsTreesMu.Unlock()
+
+	return rs.ensureSessionTree(sessionHeader)
+}
+
+// ensureSessionTree is the implementation of EnsureSessionTree. The caller is
+// expected to hold sessionsTreesMu.
 func (rs *relayerSessionsManager) ensureSessionTree(sessionHeader *sessiontypes.SessionHeader) (relayer.SessionTree, error) {
 	sessionsTrees, ok := rs.sessionsTrees[sessionHeader.SessionEndBlockHeight]
 
@@ -189,7 +202,9 @@ func (rs *relayerSessionsManager) removeFromRelayerSessions(sessionHeader *sessi
 
 	sessionsTreesEndingAtBlockHeight, ok := rs.sessionsTrees[sessionHeader.SessionEndBlockHeight]
 	if !ok {
-		log.Printf("DEBUG: no session tree found for sessions ending at height %d", sessionHeader.SessionEndBlockHeight)
+		rs.logger.Debug().
+			Int64("session_end_height", sessionHeader.SessionEndBlockHeight).
+			Msg("no session tree found for sessions ending at height")
 		return
 	}
 
@@ -242,12 +257,13 @@ func (rs *relayerSessionsManager) mapAddMinedRelayToSessionTree(
 	sessionHeader := relay.GetReq().GetMeta().GetSessionHeader()
 	smst, err := rs.ensureSessionTree(sessionHeader)
 	if err != nil {
-		log.Printf("ERROR: failed to ensure session tree: %s\n", err)
+		rs.logger.Error().Err(err).Msg("failed to ensure session tree")
 		return err, false
 	}
 
-	if err := smst.Update(relay.Hash, relay.Bytes, 1); err != nil {
-		log.Printf("ERROR: failed to update smt: %s\n", err)
+	key, value, weight := servicetypes.RelaySMSTLeaf(relay.Bytes)
+	if err := smst.Update(key, value, weight); err != nil {
+		rs.logger.Error().Err(err).Msg("failed to update smt")
 		return err, false
 	}
 
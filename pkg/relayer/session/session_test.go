@@ -11,7 +11,6 @@ import (
 	"github.com/pokt-network/poktroll/pkg/client"
 	"github.com/pokt-network/poktroll/pkg/observable/channel"
 	"github.com/pokt-network/poktroll/pkg/relayer"
-	"github.com/pokt-network/poktroll/pkg/relayer/miner"
 	"github.com/pokt-network/poktroll/pkg/relayer/session"
 	"github.com/pokt-network/poktroll/testutil/testclient/testblock"
 	"github.com/pokt-network/poktroll/testutil/testclient/testsupplier"
@@ -100,11 +99,10 @@ func newMinedRelay(
 		Res: &servicetypes.RelayResponse{},
 	}
 
-	// TODO_BLOCKER: use canonical codec to serialize the relay
-	relayBz, err := relay.Marshal()
+	relayBz, err := relay.GetCanonicalBytes()
 	require.NoError(t, err)
 
-	relayHash := testrelayer.HashBytes(t, miner.DefaultRelayHasher, relayBz)
+	relayHash := testrelayer.HashBytes(t, servicetypes.NewRelayHasher, relayBz)
 
 	return &relayer.MinedRelay{
 		Relay: relay,
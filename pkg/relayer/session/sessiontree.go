@@ -2,7 +2,6 @@ package session
 
 import (
 	"bytes"
-	"crypto/sha256"
 	"os"
 	"path/filepath"
 	"sync"
@@ -10,6 +9,7 @@ import (
 	"github.com/pokt-network/smt"
 
 	"github.com/pokt-network/poktroll/pkg/relayer"
+	servicetypes "github.com/pokt-network/poktroll/x/service/types"
 	sessiontypes "github.com/pokt-network/poktroll/x/session/types"
 )
 
@@ -42,6 +42,12 @@ type sessionTree struct {
 	// treeStore is the KVStore used to store the SMST.
 	treeStore smt.KVStore
 
+	// reservedComputeUnits is the number of compute units which have been reserved
+	// for the relays served in the session, excluding those which failed to be
+	// served. It is bounded by the number of compute units which the application
+	// can be billed for in the session.
+	reservedComputeUnits uint64
+
 	// storePath is the path to the KVStore used to store the SMST.
 	// It is created from the storePrefix and the session.sessionId.
 	// We keep track of it so we can use it at the end of the claim/proof lifecycle
@@ -77,9 +83,9 @@ func NewSessionTree(
 		return nil, err
 	}
 
-	// Create the SMST from the KVStore and a nil value hasher so the proof would
-	// contain a non-hashed Relay that could be used to validate the proof on-chain.
-	tree := smt.NewSparseMerkleSumTree(treeStore, sha256.New(), smt.WithValueHasher(nil))
+	// Create the SMST from the KVStore with the canonical session SMST layout,
+	// such that the proof can be validated on-chain.
+	tree := servicetypes.NewRelaySMST(treeStore)
 
 	sessionTree := &sessionTree{
 		sessionHeader: sessionHeader,
@@ -115,6 +121,45 @@ func (st *sessionTree) Update(key, value []byte, weight uint64) error {
 	return st.tree.Update(key, value, weight)
 }
 
+// ReserveComputeUnits reserves the given number of compute units for a relay which
+// is about to be served in the session, such that no more than maxComputeUnits are
+// served in it. It returns an error, without reserving them, if the reservation
+// would exceed maxComputeUnits or if the SMST has been flushed to disk.
+func (st *sessionTree) ReserveComputeUnits(computeUnits, maxComputeUnits uint64) error {
+	st.sessionMu.Lock()
+	defer st.sessionMu.Unlock()
+
+	if st.claimedRoot != nil {
+		return ErrSessionTreeClosed
+	}
+
+	if st.reservedComputeUnits > maxComputeUnits ||
+		maxComputeUnits-st.reservedComputeUnits < computeUnits {
+		return ErrSessionTreeComputeUnitsExceeded.Wrapf(
+			"%d of %d compute units reserved; %d more requested",
+			st.reservedComputeUnits, maxComputeUnits, computeUnits,
+		)
+	}
+
+	st.reservedComputeUnits += computeUnits
+
+	return nil
+}
+
+// ReleaseComputeUnits releases the given number of compute units which were
+// reserved for a relay that failed to be served, such that the application
+// isn't billed for it.
+func (st *sessionTree) ReleaseComputeUnits(computeUnits uint64) {
+	st.sessionMu.Lock()
+	defer st.sessionMu.Unlock()
+
+	if computeUnits > st.reservedComputeUnits {
+		computeUnits = st.reservedComputeUnits
+	}
+
+	st.reservedComputeUnits -= computeUnits
+}
+
 // ProveClosest is a wrapper for the SMST's ProveClosest function. It returns a proof for the given path.
 // This function is intended to be called after a session has been claimed and needs to be proven.
 // If the proof has already been generated, it returns the cached proof.
@@ -144,7 +189,7 @@ func (st *sessionTree) ProveClosest(path []byte) (proof *smt.SparseMerkleClosest
 		return nil, err
 	}
 
-	st.tree = smt.ImportSparseMerkleSumTree(st.treeStore, sha256.New(), st.claimedRoot, smt.WithValueHasher(nil))
+	st.tree = servicetypes.ImportRelaySMST(st.treeStore, st.claimedRoot)
 
 	// Generate the proof and cache it along with the path for which it was generated.
 	st.proof, err = st.tree.ProveClosest(path)
@@ -194,12 +239,18 @@ func (st *sessionTree) Delete() error {
 
 	st.removeFromRelayerSessions(st.sessionHeader)
 
-	if err := st.treeStore.ClearAll(); err != nil {
-		return err
-	}
+	// The KVStore is stopped, and set to nil, once the SMST has been flushed.
+	if st.treeStore != nil {
+		if err := st.treeStore.ClearAll(); err != nil {
+			return err
+		}
 
-	if err := st.treeStore.Stop(); err != nil {
-		return err
+		if err := st.treeStore.Stop(); err != nil {
+			return err
+		}
+
+		st.treeStore = nil
+		st.tree = nil
 	}
 
 	// Delete the KVStore from disk
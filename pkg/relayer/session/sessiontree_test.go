@@ -1,3 +1,51 @@
 package session_test
 
-// TODO: Add tests to the sessionTree logic
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/pkg/relayer/session"
+	sessiontypes "github.com/pokt-network/poktroll/x/session/types"
+)
+
+func TestSessionTree_ReserveComputeUnits(t *testing.T) {
+	const maxComputeUnits = 10
+
+	sessionHeader := &sessiontypes.SessionHeader{
+		SessionId:               "session_id",
+		SessionStartBlockHeight: 1,
+		SessionEndBlockHeight:   2,
+	}
+	sessionTree, err := session.NewSessionTree(
+		sessionHeader,
+		t.TempDir(),
+		func(*sessiontypes.SessionHeader) {},
+	)
+	require.NoError(t, err)
+
+	// Reserve compute units up to the maximum.
+	require.NoError(t, sessionTree.ReserveComputeUnits(4, maxComputeUnits))
+	require.NoError(t, sessionTree.ReserveComputeUnits(6, maxComputeUnits))
+
+	// Reserving more compute units than the maximum fails.
+	err = sessionTree.ReserveComputeUnits(1, maxComputeUnits)
+	require.ErrorIs(t, err, session.ErrSessionTreeComputeUnitsExceeded)
+
+	// Released compute units can be reserved again.
+	sessionTree.ReleaseComputeUnits(4)
+	require.NoError(t, sessionTree.ReserveComputeUnits(4, maxComputeUnits))
+	err = sessionTree.ReserveComputeUnits(1, maxComputeUnits)
+	require.ErrorIs(t, err, session.ErrSessionTreeComputeUnitsExceeded)
+
+	// No compute units can be reserved once the session tree is flushed.
+	sessionTree.ReleaseComputeUnits(10)
+	_, err = sessionTree.Flush()
+	require.NoError(t, err)
+
+	err = sessionTree.ReserveComputeUnits(1, maxComputeUnits)
+	require.ErrorIs(t, err, session.ErrSessionTreeClosed)
+
+	// A flushed session tree can be deleted.
+	require.NoError(t, sessionTree.Delete())
+}
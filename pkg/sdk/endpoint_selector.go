@@ -1,8 +1,6 @@
-package appgateserver
+package sdk
 
 import (
-	"context"
-	"log"
 	"net/url"
 
 	sessiontypes "github.com/pokt-network/poktroll/x/session/types"
@@ -13,13 +11,13 @@ import (
 // first available supplier.
 // Future optimizations (e.g. Quality-of-Service) can be introduced here.
 // TODO(@h5law): Look into different endpoint selection depending on their suitability.
-// getRelayerUrl gets the URL of the relayer for the given service.
-func (app *appGateServer) getRelayerUrl(
-	ctx context.Context,
+// SelectSupplierEndpoint gets the endpoint of a supplier of the given session
+// which serves the given service over the given RPC type.
+func (sdk *poktrollSDK) SelectSupplierEndpoint(
+	session *sessiontypes.Session,
 	serviceId string,
 	rpcType sharedtypes.RPCType,
-	session *sessiontypes.Session,
-) (supplierUrl *url.URL, supplierAddress string, err error) {
+) (*SupplierEndpoint, error) {
 	for _, supplier := range session.Suppliers {
 		for _, service := range supplier.Services {
 			// Skip services that don't match the requested serviceId.
@@ -32,15 +30,21 @@ func (app *appGateServer) getRelayerUrl(
 				if endpoint.RpcType == rpcType {
 					supplierUrl, err := url.Parse(endpoint.Url)
 					if err != nil {
-						log.Printf("ERROR: error parsing url: %s", err)
+						sdk.logger.Error().Err(err).Msg("error parsing url")
 						continue
 					}
-					return supplierUrl, supplier.Address, nil
+					return &SupplierEndpoint{
+						Url:             supplierUrl,
+						RpcType:         endpoint.RpcType,
+						SupplierAddress: supplier.OperatorAddress,
+					}, nil
 				}
 			}
 		}
 	}
 
 	// Return an error if no relayer endpoints were found.
-	return nil, "", ErrAppGateNoRelayEndpoints
+	return nil, ErrSDKNoRelayEndpoints.Wrapf(
+		"service %q, RPC type %s, session %s", serviceId, rpcType, session.GetSessionId(),
+	)
 }
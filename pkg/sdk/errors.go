@@ -0,0 +1,15 @@
+package sdk
+
+import sdkerrors "cosmossdk.io/errors"
+
+var (
+	codespace                           = "poktrollsdk"
+	ErrSDKInvalidRelayResponseSignature = sdkerrors.Register(codespace, 1, "invalid relay response signature")
+	ErrSDKNoRelayEndpoints              = sdkerrors.Register(codespace, 2, "no relay endpoints found")
+	ErrSDKEmptyRelayResponseSignature   = sdkerrors.Register(codespace, 3, "empty relay response signature")
+	ErrSDKInvalidRelayResponseRequest   = sdkerrors.Register(codespace, 4, "relay response is not for the relay request")
+	ErrSDKMissingSigningKey             = sdkerrors.Register(codespace, 5, "missing signing key")
+	ErrSDKInvalidSigningKey             = sdkerrors.Register(codespace, 6, "invalid signing key")
+	ErrSDKUnsupportedRPCType            = sdkerrors.Register(codespace, 7, "unsupported RPC type")
+	ErrSDKHandleRelay                   = sdkerrors.Register(codespace, 8, "internal error handling relay request")
+)
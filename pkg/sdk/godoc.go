@@ -0,0 +1,13 @@
+// Package sdk provides an embeddable client for applications (and gateways)
+// which sign and send their own relays, without running an AppGate server.
+//
+// Given the key of an application (or of a gateway which it delegated to) and
+// the queriers of a query node, the POKTRollSDK gets the application's current
+// session, selects a supplier endpoint from it, builds and ring-signs the relay
+// request, sends it to the supplier over the endpoint's RPC type, and verifies
+// the supplier's signature on the relay response, as well as that the relay
+// response is for the relay request.
+//
+// The AppGate server is built on top of it, such that both share the same ring,
+// session and verification logic.
+package sdk
@@ -0,0 +1,25 @@
+package sdk
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+)
+
+// WithSigningKey sets the keyring and the name of the key in it which relay
+// requests are ring-signed with. It must be the key of the applications which
+// relays are sent for, or of a gateway which they delegated to.
+func WithSigningKey(kr keyring.Keyring, keyName string) POKTRollSDKOption {
+	return func(sdk *poktrollSDK) {
+		sdk.keyring = kr
+		sdk.signingKeyName = keyName
+	}
+}
+
+// WithHTTPClient sets the HTTP client which relay requests are sent with.
+// It defaults to http.DefaultClient.
+func WithHTTPClient(httpClient *http.Client) POKTRollSDKOption {
+	return func(sdk *poktrollSDK) {
+		sdk.httpClient = httpClient
+	}
+}
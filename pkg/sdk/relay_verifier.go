@@ -0,0 +1,60 @@
+package sdk
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/cometbft/cometbft/crypto"
+
+	"github.com/pokt-network/poktroll/x/service/types"
+)
+
+// verifyResponse verifies the relay response signature, and that the relay
+// response is for the given relay request.
+func (sdk *poktrollSDK) verifyResponse(
+	ctx context.Context,
+	supplierAddress string,
+	relayRequest *types.RelayRequest,
+	relayResponse *types.RelayResponse,
+) error {
+	// Get the supplier's public key.
+	supplierPubKey, err := sdk.accountQuerier.GetPubKeyFromAddress(ctx, supplierAddress)
+	if err != nil {
+		return err
+	}
+
+	// Extract the supplier's signature
+	if relayResponse.Meta == nil {
+		return ErrSDKEmptyRelayResponseSignature.Wrapf(
+			"response payload: %s", relayResponse.Payload,
+		)
+	}
+	supplierSignature := relayResponse.Meta.SupplierSignature
+
+	// Get the relay response signable bytes and hash them.
+	responseBz, err := relayResponse.GetSignableBytes()
+	if err != nil {
+		return err
+	}
+	hash := crypto.Sha256(responseBz)
+
+	// Verify the relay response signature.
+	if !supplierPubKey.VerifySignature(hash, supplierSignature) {
+		return ErrSDKInvalidRelayResponseSignature
+	}
+
+	// Verify that the signed relay response references the relay request, such
+	// that a supplier cannot answer it with a response to another relay request.
+	relayRequestHash, err := relayRequest.GetSignableBytesHash()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(relayResponse.Meta.RelayRequestHash, relayRequestHash) {
+		return ErrSDKInvalidRelayResponseRequest.Wrapf(
+			"expected relay request hash %x, got %x",
+			relayRequestHash, relayResponse.Meta.RelayRequestHash,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package sdk
+
+import (
+	"context"
+
+	"github.com/pokt-network/poktroll/pkg/signer"
+)
+
+// getRingSingerForAppAddress returns the RingSinger used to sign relays of the
+// session of the given block height, on behalf of the application with the
+// given address.
+func (sdk *poktrollSDK) getRingSingerForAppAddress(
+	ctx context.Context,
+	appAddress string,
+	blockHeight int64,
+) (*signer.RingSigner, error) {
+	ring, err := sdk.ringClient.GetRingForAddress(ctx, appAddress, blockHeight)
+	if err != nil {
+		sdk.logger.Error().
+			Str("app_address", appAddress).
+			Err(err).
+			Msg("unable to get ring for address")
+		return nil, err
+	}
+
+	// return the ring signer
+	return signer.NewRingSigner(ring, sdk.signingKey), nil
+}
@@ -0,0 +1,208 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"cosmossdk.io/depinject"
+	ring_secp256k1 "github.com/athanorlabs/go-dleq/secp256k1"
+	ringtypes "github.com/athanorlabs/go-dleq/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+
+	"github.com/pokt-network/poktroll/pkg/client"
+	"github.com/pokt-network/poktroll/pkg/crypto"
+	"github.com/pokt-network/poktroll/pkg/crypto/rings"
+	"github.com/pokt-network/poktroll/pkg/polylog"
+	"github.com/pokt-network/poktroll/x/service/types"
+	sessiontypes "github.com/pokt-network/poktroll/x/session/types"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+)
+
+var _ POKTRollSDK = (*poktrollSDK)(nil)
+
+// POKTRollSDK is the interface which applications (and gateways) use to send
+// relays to the suppliers of their sessions.
+type POKTRollSDK interface {
+	// GetSession returns the current session of the given application for the
+	// given service.
+	GetSession(
+		ctx context.Context,
+		appAddress string,
+		serviceId string,
+	) (*sessiontypes.Session, error)
+
+	// SelectSupplierEndpoint returns an endpoint of one of the given session's
+	// suppliers, which serves the given service over the given RPC type.
+	SelectSupplierEndpoint(
+		session *sessiontypes.Session,
+		serviceId string,
+		rpcType sharedtypes.RPCType,
+	) (*SupplierEndpoint, error)
+
+	// SendRelay builds a relay request, for the given session, whose payload is
+	// the body of the given request, and ring-signs it on behalf of the session's
+	// application. It then sends it to the given supplier endpoint, forwarding
+	// the given request's method and ForwardedHeaders, and returns the relay
+	// response once its supplier signature, and that it is for the relay
+	// request, are verified.
+	SendRelay(
+		ctx context.Context,
+		session *sessiontypes.Session,
+		supplierEndpoint *SupplierEndpoint,
+		request *http.Request,
+	) (*types.RelayResponse, error)
+}
+
+// POKTRollSDKOption defines a function type that modifies the POKTRollSDK.
+type POKTRollSDKOption func(*poktrollSDK)
+
+// SupplierEndpoint is an endpoint of a session's supplier which relays can be
+// sent to.
+type SupplierEndpoint struct {
+	// Url is the URL of the endpoint.
+	Url *url.URL
+	// RpcType is the RPC type which the endpoint serves relays over.
+	RpcType sharedtypes.RPCType
+	// SupplierAddress is the address of the supplier which the endpoint belongs
+	// to, and which is expected to sign the relay responses.
+	SupplierAddress string
+}
+
+// poktrollSDK implements the POKTRollSDK interface.
+type poktrollSDK struct {
+	logger polylog.Logger
+
+	// blockClient is used to get the current block height to get the current
+	// session for.
+	blockClient client.BlockClient
+
+	// accountQuerier is used to get the suppliers' public keys to verify the
+	// relay response signatures.
+	accountQuerier client.AccountQuerier
+
+	// ringClient is used to get the rings which relay requests are signed with
+	// on behalf of applications.
+	ringClient crypto.RingClient
+
+	// sessionQuerier is used to get the current session for a given application
+	// address and service.
+	sessionQuerier client.SessionQuerier
+
+	// sessionManager keeps the current session of each application and service
+	// which relays are sent for, and refreshes it as new sessions start.
+	sessionManager *sessionManager
+
+	// keyring and signingKeyName identify the key which relay requests are
+	// ring-signed with.
+	keyring        keyring.Keyring
+	signingKeyName string
+
+	// signingKey is the scalar on the secp256k1 curve corresponding to the
+	// private key of the signing key, which relay requests are ring-signed with.
+	signingKey ringtypes.Scalar
+
+	// httpClient is the client which relay requests are sent with.
+	httpClient *http.Client
+}
+
+// NewPOKTRollSDK creates a new POKTRollSDK with the given dependencies and
+// options. It returns an error if the dependencies fail to resolve or if the
+// signing key is missing or isn't a secp256k1 key. The sessions it has been
+// asked for are refreshed as new blocks are committed, until the given context
+// is done.
+//
+// Required dependencies:
+//   - polylog.Logger
+//   - client.BlockClient
+//   - client.AccountQuerier
+//   - client.ApplicationQuerier
+//   - client.SessionQuerier
+//
+// Available options:
+//   - WithSigningKey
+//   - WithHTTPClient
+func NewPOKTRollSDK(
+	ctx context.Context,
+	deps depinject.Config,
+	opts ...POKTRollSDKOption,
+) (POKTRollSDK, error) {
+	sdk := &poktrollSDK{httpClient: http.DefaultClient}
+
+	if err := depinject.Inject(
+		deps,
+		&sdk.logger,
+		&sdk.blockClient,
+		&sdk.accountQuerier,
+		&sdk.sessionQuerier,
+	); err != nil {
+		return nil, err
+	}
+
+	ringClient, err := rings.NewRingClient(deps)
+	if err != nil {
+		return nil, err
+	}
+	sdk.ringClient = ringClient
+
+	for _, opt := range opts {
+		opt(sdk)
+	}
+
+	if err := sdk.validateConfigAndSetDefaults(); err != nil {
+		return nil, err
+	}
+
+	sdk.sessionManager = newSessionManager(ctx, sdk.logger, sdk.blockClient, sdk.sessionQuerier)
+
+	return sdk, nil
+}
+
+// validateConfigAndSetDefaults retrieves the configured signing key from the
+// keyring and converts it to the scalar which relay requests are ring-signed
+// with. It returns an error if the key is missing or isn't a secp256k1 key.
+func (sdk *poktrollSDK) validateConfigAndSetDefaults() error {
+	if sdk.keyring == nil || sdk.signingKeyName == "" {
+		return ErrSDKMissingSigningKey
+	}
+
+	keyRecord, err := sdk.keyring.Key(sdk.signingKeyName)
+	if err != nil {
+		return ErrSDKMissingSigningKey.Wrapf("name %q: %s", sdk.signingKeyName, err)
+	}
+
+	// Convert the key record to a private key and return the scalar
+	// point on the secp256k1 curve that it corresponds to.
+	// If the key is not a secp256k1 key, this will return an error.
+	signingKey, err := recordLocalToScalar(keyRecord.GetLocal())
+	if err != nil {
+		return ErrSDKInvalidSigningKey.Wrapf("name %q: %s", sdk.signingKeyName, err)
+	}
+	sdk.signingKey = signingKey
+
+	return nil
+}
+
+// recordLocalToScalar converts the private key obtained from a
+// key record to a scalar point on the secp256k1 curve
+func recordLocalToScalar(local *keyring.Record_Local) (ringtypes.Scalar, error) {
+	if local == nil {
+		return nil, fmt.Errorf("cannot extract private key from key record: nil")
+	}
+	priv, ok := local.PrivKey.GetCachedValue().(cryptotypes.PrivKey)
+	if !ok {
+		return nil, fmt.Errorf("cannot extract private key from key record: %T", local.PrivKey.GetCachedValue())
+	}
+	if _, ok := priv.(*secp256k1.PrivKey); !ok {
+		return nil, fmt.Errorf("unexpected private key type: %T, want %T", priv, &secp256k1.PrivKey{})
+	}
+	crv := ring_secp256k1.NewCurve()
+	privKey, err := crv.DecodeToScalar(priv.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode private key: %w", err)
+	}
+	return privKey, nil
+}
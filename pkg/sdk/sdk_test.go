@@ -0,0 +1,387 @@
+package sdk_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"cosmossdk.io/depinject"
+	"github.com/cometbft/cometbft/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/pkg/client"
+	"github.com/pokt-network/poktroll/pkg/observable/channel"
+	"github.com/pokt-network/poktroll/pkg/polylog"
+	"github.com/pokt-network/poktroll/pkg/sdk"
+	"github.com/pokt-network/poktroll/testutil/mockclient"
+	"github.com/pokt-network/poktroll/testutil/testclient/testblock"
+	"github.com/pokt-network/poktroll/testutil/testclient/testkeyring"
+	servicetypes "github.com/pokt-network/poktroll/x/service/types"
+	sessiontypes "github.com/pokt-network/poktroll/x/session/types"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+)
+
+const (
+	testSigningKeyName = "app"
+	testServiceId      = "svc1"
+)
+
+func TestNewPOKTRollSDK(t *testing.T) {
+	kr, _ := testkeyring.NewTestKeyringWithKey(t, testSigningKeyName)
+
+	tests := []struct {
+		desc        string
+		opts        []sdk.POKTRollSDKOption
+		expectedErr error
+	}{
+		{
+			desc: "valid signing key",
+			opts: []sdk.POKTRollSDKOption{sdk.WithSigningKey(kr, testSigningKeyName)},
+		},
+		{
+			desc:        "missing signing key",
+			expectedErr: sdk.ErrSDKMissingSigningKey,
+		},
+		{
+			desc:        "signing key not in keyring",
+			opts:        []sdk.POKTRollSDKOption{sdk.WithSigningKey(kr, "unknown")},
+			expectedErr: sdk.ErrSDKMissingSigningKey,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			ctx, cancelCtx := context.WithCancel(context.Background())
+			t.Cleanup(cancelCtx)
+
+			deps := newTestSDKDeps(ctx, t, nil)
+			poktrollSDK, err := sdk.NewPOKTRollSDK(ctx, deps, test.opts...)
+			if test.expectedErr != nil {
+				require.ErrorIs(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, poktrollSDK)
+		})
+	}
+}
+
+func TestPOKTRollSDK_SelectSupplierEndpoint(t *testing.T) {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	t.Cleanup(cancelCtx)
+
+	poktrollSDK, _ := newTestSDK(ctx, t, nil)
+
+	session := &sessiontypes.Session{
+		SessionId: "session_id",
+		Suppliers: []*sharedtypes.Supplier{
+			newTestSupplier("supplier1", "svc2", "http://supplier1:8545", sharedtypes.RPCType_JSON_RPC),
+			newTestSupplier("supplier2", testServiceId, "%invalid_url", sharedtypes.RPCType_JSON_RPC),
+			newTestSupplier("supplier3", testServiceId, "http://supplier3:8545", sharedtypes.RPCType_GRPC),
+			newTestSupplier("supplier4", testServiceId, "http://supplier4:8545", sharedtypes.RPCType_JSON_RPC),
+		},
+	}
+
+	tests := []struct {
+		desc                    string
+		serviceId               string
+		rpcType                 sharedtypes.RPCType
+		expectedSupplierAddress string
+		expectedUrl             string
+		expectedErr             error
+	}{
+		{
+			desc:                    "first valid endpoint of the service and RPC type",
+			serviceId:               testServiceId,
+			rpcType:                 sharedtypes.RPCType_JSON_RPC,
+			expectedSupplierAddress: "supplier4",
+			expectedUrl:             "http://supplier4:8545",
+		},
+		{
+			desc:                    "endpoint of another RPC type",
+			serviceId:               testServiceId,
+			rpcType:                 sharedtypes.RPCType_GRPC,
+			expectedSupplierAddress: "supplier3",
+			expectedUrl:             "http://supplier3:8545",
+		},
+		{
+			desc:        "no endpoint of the RPC type",
+			serviceId:   testServiceId,
+			rpcType:     sharedtypes.RPCType_WEBSOCKET,
+			expectedErr: sdk.ErrSDKNoRelayEndpoints,
+		},
+		{
+			desc:        "no endpoint of the service",
+			serviceId:   "svc3",
+			rpcType:     sharedtypes.RPCType_JSON_RPC,
+			expectedErr: sdk.ErrSDKNoRelayEndpoints,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			endpoint, err := poktrollSDK.SelectSupplierEndpoint(session, test.serviceId, test.rpcType)
+			if test.expectedErr != nil {
+				require.ErrorIs(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expectedSupplierAddress, endpoint.SupplierAddress)
+			require.Equal(t, test.expectedUrl, endpoint.Url.String())
+			require.Equal(t, test.rpcType, endpoint.RpcType)
+		})
+	}
+}
+
+func TestPOKTRollSDK_SendRelay(t *testing.T) {
+	var (
+		supplierPrivKey = secp256k1.GenPrivKey()
+		supplierAddr    = cosmostypes.AccAddress(supplierPrivKey.PubKey().Address()).String()
+		otherPrivKey    = secp256k1.GenPrivKey()
+		requestPayload  = `{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"}`
+		responsePayload = []byte(`{"jsonrpc":"2.0","id":1,"result":"0x1"}`)
+	)
+
+	tests := []struct {
+		desc string
+		// newRelayResponse returns the relay response which the supplier answers
+		// the given relay request with.
+		newRelayResponse func(t *testing.T, relayRequest *servicetypes.RelayRequest) *servicetypes.RelayResponse
+		rpcType          sharedtypes.RPCType
+		expectedErr      error
+	}{
+		{
+			desc: "valid relay response",
+			newRelayResponse: func(t *testing.T, relayRequest *servicetypes.RelayRequest) *servicetypes.RelayResponse {
+				return newSignedRelayResponse(t, supplierPrivKey, relayRequest, responsePayload)
+			},
+			rpcType: sharedtypes.RPCType_JSON_RPC,
+		},
+		{
+			desc: "relay response signed by another key",
+			newRelayResponse: func(t *testing.T, relayRequest *servicetypes.RelayRequest) *servicetypes.RelayResponse {
+				return newSignedRelayResponse(t, otherPrivKey, relayRequest, responsePayload)
+			},
+			rpcType:     sharedtypes.RPCType_JSON_RPC,
+			expectedErr: sdk.ErrSDKInvalidRelayResponseSignature,
+		},
+		{
+			desc: "relay response for another relay request",
+			newRelayResponse: func(t *testing.T, relayRequest *servicetypes.RelayRequest) *servicetypes.RelayResponse {
+				otherRelayRequest := &servicetypes.RelayRequest{
+					Meta:    relayRequest.GetMeta(),
+					Payload: []byte(`{"jsonrpc":"2.0","id":2,"method":"eth_chainId"}`),
+				}
+				return newSignedRelayResponse(t, supplierPrivKey, otherRelayRequest, responsePayload)
+			},
+			rpcType:     sharedtypes.RPCType_JSON_RPC,
+			expectedErr: sdk.ErrSDKInvalidRelayResponseRequest,
+		},
+		{
+			desc: "unsigned relay response",
+			newRelayResponse: func(t *testing.T, relayRequest *servicetypes.RelayRequest) *servicetypes.RelayResponse {
+				return &servicetypes.RelayResponse{Payload: responsePayload}
+			},
+			rpcType:     sharedtypes.RPCType_JSON_RPC,
+			expectedErr: sdk.ErrSDKEmptyRelayResponseSignature,
+		},
+		{
+			desc:        "unsupported RPC type",
+			rpcType:     sharedtypes.RPCType_GRPC,
+			expectedErr: sdk.ErrSDKUnsupportedRPCType,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			ctx, cancelCtx := context.WithCancel(context.Background())
+			t.Cleanup(cancelCtx)
+
+			pubKeys := map[string]cryptotypes.PubKey{supplierAddr: supplierPrivKey.PubKey()}
+			poktrollSDK, appAddr := newTestSDK(ctx, t, pubKeys)
+
+			session := &sessiontypes.Session{
+				SessionId: "session_id",
+				Header: &sessiontypes.SessionHeader{
+					ApplicationAddress:      appAddr,
+					Service:                 &sharedtypes.Service{Id: testServiceId},
+					SessionId:               "session_id",
+					SessionStartBlockHeight: 4,
+					SessionEndBlockHeight:   8,
+				},
+			}
+
+			// The supplier answers relay requests, whose ring signature it
+			// expects to be set, with the relay response of the test case.
+			supplierServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				relayRequestBz, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+
+				relayRequest := new(servicetypes.RelayRequest)
+				require.NoError(t, relayRequest.Unmarshal(relayRequestBz))
+				require.Equal(t, []byte(requestPayload), relayRequest.Payload)
+				require.Equal(t, supplierAddr, relayRequest.GetMeta().GetSupplierAddress())
+				require.NotEmpty(t, relayRequest.GetMeta().GetSignature())
+				// Only the allow-listed headers are forwarded.
+				require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+				require.Empty(t, r.Header.Get("X-Api-Key"))
+				require.Empty(t, r.Header.Get("Authorization"))
+
+				relayResponseBz, err := test.newRelayResponse(t, relayRequest).Marshal()
+				require.NoError(t, err)
+				_, err = w.Write(relayResponseBz)
+				require.NoError(t, err)
+			}))
+			t.Cleanup(supplierServer.Close)
+
+			supplier := newTestSupplier(supplierAddr, testServiceId, supplierServer.URL, test.rpcType)
+			session.Suppliers = []*sharedtypes.Supplier{supplier}
+
+			endpoint, err := poktrollSDK.SelectSupplierEndpoint(session, testServiceId, test.rpcType)
+			require.NoError(t, err)
+
+			request := httptest.NewRequest(http.MethodPost, "/"+testServiceId, strings.NewReader(requestPayload))
+			request.Header.Set("Content-Type", "application/json")
+			request.Header.Set("X-Api-Key", "secret")
+			request.Header.Set("Authorization", "Bearer secret")
+			relayResponse, err := poktrollSDK.SendRelay(ctx, session, endpoint, request)
+			if test.expectedErr != nil {
+				require.ErrorIs(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, responsePayload, relayResponse.Payload)
+		})
+	}
+}
+
+// newTestSDK returns a POKTRollSDK which signs relay requests with a new key,
+// on behalf of the application of that key, along with the application address.
+// The application isn't delegated to any gateway, and the given public keys, as
+// well as the application's, are returned by the mocked account querier.
+func newTestSDK(
+	ctx context.Context,
+	t *testing.T,
+	pubKeys map[string]cryptotypes.PubKey,
+) (sdk.POKTRollSDK, string) {
+	t.Helper()
+
+	kr, keyRecord := testkeyring.NewTestKeyringWithKey(t, testSigningKeyName)
+	appAddr, err := keyRecord.GetAddress()
+	require.NoError(t, err)
+	appPubKey, err := keyRecord.GetPubKey()
+	require.NoError(t, err)
+
+	allPubKeys := map[string]cryptotypes.PubKey{appAddr.String(): appPubKey}
+	for address, pubKey := range pubKeys {
+		allPubKeys[address] = pubKey
+	}
+
+	deps := newTestSDKDeps(ctx, t, allPubKeys)
+	poktrollSDK, err := sdk.NewPOKTRollSDK(ctx, deps, sdk.WithSigningKey(kr, testSigningKeyName))
+	require.NoError(t, err)
+
+	return poktrollSDK, appAddr.String()
+}
+
+// newTestSDKDeps returns the dependencies of the POKTRollSDK, whose account
+// querier returns the given public keys. Applications aren't delegated to any
+// gateway, so their rings are made up of their own address only.
+func newTestSDKDeps(
+	ctx context.Context,
+	t *testing.T,
+	pubKeys map[string]cryptotypes.PubKey,
+) depinject.Config {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+
+	blocksObs, _ := channel.NewReplayObservable[client.Block](ctx, 1)
+	blockClient := testblock.NewAnyTimesCommittedBlocksSequenceBlockClient(t, blocksObs)
+
+	accountQuerier := mockclient.NewMockAccountQuerier(ctrl)
+	accountQuerier.EXPECT().
+		GetPubKeyFromAddress(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, address string) (cryptotypes.PubKey, error) {
+			pubKey, ok := pubKeys[address]
+			if !ok {
+				return nil, sdk.ErrSDKHandleRelay.Wrapf("unknown address %s", address)
+			}
+			return pubKey, nil
+		}).
+		AnyTimes()
+
+	applicationQuerier := mockclient.NewMockApplicationQuerier(ctrl)
+	applicationQuerier.EXPECT().
+		GetApplicationRing(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, appAddress string, _ int64) ([]string, error) {
+			return []string{appAddress}, nil
+		}).
+		AnyTimes()
+
+	sessionQuerier := mockclient.NewMockSessionQuerier(ctrl)
+
+	return depinject.Supply(
+		polylog.NewNoopLogger(),
+		blockClient,
+		accountQuerier,
+		applicationQuerier,
+		sessionQuerier,
+	)
+}
+
+// newTestSupplier returns a supplier with the given address which serves the
+// given service over the given RPC type at the given URL.
+func newTestSupplier(
+	supplierAddr string,
+	serviceId string,
+	url string,
+	rpcType sharedtypes.RPCType,
+) *sharedtypes.Supplier {
+	return &sharedtypes.Supplier{
+		OperatorAddress: supplierAddr,
+		Services: []*sharedtypes.SupplierServiceConfig{
+			{
+				Service:   &sharedtypes.Service{Id: serviceId},
+				Endpoints: []*sharedtypes.SupplierEndpoint{{Url: url, RpcType: rpcType}},
+			},
+		},
+	}
+}
+
+// newSignedRelayResponse returns a relay response with the given payload, for
+// the given relay request, signed with the given private key.
+func newSignedRelayResponse(
+	t *testing.T,
+	privKey cryptotypes.PrivKey,
+	relayRequest *servicetypes.RelayRequest,
+	payload []byte,
+) *servicetypes.RelayResponse {
+	t.Helper()
+
+	relayRequestHash, err := relayRequest.GetSignableBytesHash()
+	require.NoError(t, err)
+
+	relayResponse := &servicetypes.RelayResponse{
+		Meta: &servicetypes.RelayResponseMetadata{
+			SessionHeader:    relayRequest.GetMeta().GetSessionHeader(),
+			RelayRequestHash: relayRequestHash,
+		},
+		Payload: payload,
+	}
+
+	signableBz, err := relayResponse.GetSignableBytes()
+	require.NoError(t, err)
+	signature, err := privKey.Sign(crypto.Sha256(signableBz))
+	require.NoError(t, err)
+	relayResponse.Meta.SupplierSignature = signature
+
+	return relayResponse
+}
@@ -0,0 +1,143 @@
+package sdk
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/cometbft/cometbft/crypto"
+
+	"github.com/pokt-network/poktroll/x/service/types"
+	sessiontypes "github.com/pokt-network/poktroll/x/session/types"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+)
+
+// ForwardedHeaders are the headers of the requests which relays are sent for
+// that are forwarded to suppliers along with the relay requests. Other headers
+// are dropped.
+var ForwardedHeaders = []string{
+	"Accept",
+	"Content-Type",
+}
+
+// SendRelay builds, ring-signs and sends a relay request, whose payload is the
+// body of the given request, to the given supplier endpoint. It blocks until the
+// relay response is received, and returns it once its supplier signature, and
+// that it is for the relay request, are verified.
+// Only synchronous RPC types, where there is a one-to-one correspondence between
+// the request and response, are currently supported.
+func (sdk *poktrollSDK) SendRelay(
+	ctx context.Context,
+	session *sessiontypes.Session,
+	supplierEndpoint *SupplierEndpoint,
+	request *http.Request,
+) (*types.RelayResponse, error) {
+	// TODO(@h5law, @red0ne): Add support for asynchronous relays.
+	if supplierEndpoint.RpcType != sharedtypes.RPCType_JSON_RPC {
+		return nil, ErrSDKUnsupportedRPCType.Wrapf("%s", supplierEndpoint.RpcType)
+	}
+
+	payloadBz, err := io.ReadAll(request.Body)
+	if err != nil {
+		return nil, ErrSDKHandleRelay.Wrapf("reading request body: %s", err)
+	}
+
+	// Create the relay request, bound to the selected supplier and made unique
+	// within the session by a random nonce such that it cannot be replayed.
+	nonce, err := types.NewRelayRequestNonce()
+	if err != nil {
+		return nil, ErrSDKHandleRelay.Wrapf("generating relay request nonce: %s", err)
+	}
+	relayRequest := &types.RelayRequest{
+		Meta: &types.RelayRequestMetadata{
+			SessionHeader:   session.Header,
+			Signature:       nil, // signature added below
+			SupplierAddress: supplierEndpoint.SupplierAddress,
+			Nonce:           nonce,
+		},
+		Payload: payloadBz,
+	}
+
+	// Get the application's signer for the ring of the session.
+	appAddress := session.GetHeader().GetApplicationAddress()
+	sessionStartHeight := session.GetHeader().GetSessionStartBlockHeight()
+	signer, err := sdk.getRingSingerForAppAddress(ctx, appAddress, sessionStartHeight)
+	if err != nil {
+		return nil, ErrSDKHandleRelay.Wrapf("getting signer: %s", err)
+	}
+
+	// Hash and sign the request's signable bytes.
+	signableBz, err := relayRequest.GetSignableBytes()
+	if err != nil {
+		return nil, ErrSDKHandleRelay.Wrapf("getting signable bytes: %s", err)
+	}
+
+	hash := crypto.Sha256(signableBz)
+	signature, err := signer.Sign(hash)
+	if err != nil {
+		return nil, ErrSDKHandleRelay.Wrapf("signing relay: %s", err)
+	}
+	relayRequest.Meta.Signature = signature
+
+	// Marshal the relay request to bytes and create a reader to be used as an HTTP request body.
+	relayRequestBz, err := relayRequest.Marshal()
+	if err != nil {
+		return nil, ErrSDKHandleRelay.Wrapf("marshaling relay request: %s", err)
+	}
+	relayRequestReader := io.NopCloser(bytes.NewReader(relayRequestBz))
+
+	// Create the HTTP request to send the request to the relayer. Only the
+	// allow-listed headers are forwarded, such that credentials meant for the
+	// sender (e.g. API keys) aren't leaked to suppliers.
+	relayHTTPRequest := &http.Request{
+		Method: request.Method,
+		Header: forwardedHeaders(request.Header),
+		URL:    supplierEndpoint.Url,
+		Body:   relayRequestReader,
+	}
+
+	sdk.logger.Debug().
+		Str("supplier_url", supplierEndpoint.Url.String()).
+		Msg("sending signed relay request")
+	relayHTTPResponse, err := sdk.httpClient.Do(relayHTTPRequest.WithContext(ctx))
+	if err != nil {
+		return nil, ErrSDKHandleRelay.Wrapf("sending relay request: %s", err)
+	}
+	defer relayHTTPResponse.Body.Close()
+
+	// Read the response body bytes.
+	relayResponseBz, err := io.ReadAll(relayHTTPResponse.Body)
+	if err != nil {
+		return nil, ErrSDKHandleRelay.Wrapf("reading relay response body: %s", err)
+	}
+
+	// Unmarshal the response bytes into a RelayResponse.
+	relayResponse := &types.RelayResponse{}
+	if err := relayResponse.Unmarshal(relayResponseBz); err != nil {
+		return nil, ErrSDKHandleRelay.Wrapf("unmarshaling relay response: %s", err)
+	}
+
+	// Verify the response signature. We use the supplier address of the selected
+	// endpoint since this is the address we are expecting to sign the response.
+	// TODO_TECHDEBT: if the RelayResponse is an internal error response, we should not verify the signature
+	// as in some relayer early failures, it may not be signed by the supplier.
+	// TODO_IMPROVE: Add more logging & telemetry so we can get visibility and signal into
+	// failed responses.
+	if err := sdk.verifyResponse(ctx, supplierEndpoint.SupplierAddress, relayRequest, relayResponse); err != nil {
+		return nil, err
+	}
+
+	return relayResponse, nil
+}
+
+// forwardedHeaders returns a copy of the ForwardedHeaders of the given header.
+func forwardedHeaders(header http.Header) http.Header {
+	forwarded := make(http.Header)
+	for _, key := range ForwardedHeaders {
+		for _, value := range header.Values(key) {
+			forwarded.Add(key, value)
+		}
+	}
+	return forwarded
+}
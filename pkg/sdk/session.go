@@ -0,0 +1,272 @@
+package sdk
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pokt-network/poktroll/pkg/client"
+	"github.com/pokt-network/poktroll/pkg/observable"
+	"github.com/pokt-network/poktroll/pkg/observable/channel"
+	"github.com/pokt-network/poktroll/pkg/polylog"
+	sessiontypes "github.com/pokt-network/poktroll/x/session/types"
+)
+
+// sessionKey identifies the sessions of an application for a service.
+type sessionKey struct {
+	appAddress string
+	serviceId  string
+}
+
+// maxConcurrentSessionPrefetches is the maximum number of sessions which are
+// queried concurrently when prefetching the next sessions.
+const maxConcurrentSessionPrefetches = 8
+
+// sessionEntry is the current session of an application for a service, as
+// tracked by the sessionManager.
+type sessionEntry struct {
+	// session is the latest session known for the application and service.
+	// Sessions are never mutated once stored; a new session replaces the
+	// pointer, so in-flight requests keep using the session they started with.
+	session *sessiontypes.Session
+	// nextSession is the session following the current one, once it has been
+	// prefetched at the last block of the current one.
+	nextSession *sessiontypes.Session
+	// lastRequestedHeight is the latest block height at which the session was
+	// requested. It is used to stop refreshing the sessions which are no longer
+	// requested.
+	lastRequestedHeight int64
+	// isPrefetching is true while the next session is being queried, so that it
+	// isn't queried again on the following blocks until the query completes.
+	isPrefetching bool
+}
+
+// sessionAt returns the tracked session which contains the given height, if
+// any, making the next session the current one once it has started.
+func (entry *sessionEntry) sessionAt(height int64) *sessiontypes.Session {
+	if entry.nextSession != nil && entry.nextSession.ContainsHeight(height) {
+		entry.session, entry.nextSession = entry.nextSession, nil
+	}
+	if entry.session != nil && entry.session.ContainsHeight(height) {
+		return entry.session
+	}
+	return nil
+}
+
+// sessionManager keeps the current session of each application and service
+// which is requested through it. It observes the committed blocks to prefetch
+// the next session of each of them at the last block of the current one, so
+// that requests don't have to wait for it to be queried once it starts.
+type sessionManager struct {
+	logger         polylog.Logger
+	blockClient    client.BlockClient
+	sessionQuerier client.SessionQuerier
+
+	sessionsMu sync.Mutex
+	// sessions maps each (application, service) pair to its current session.
+	sessions map[sessionKey]*sessionEntry
+}
+
+// newSessionManager returns a new sessionManager which refreshes its sessions
+// on each committed block until the given context is done.
+func newSessionManager(
+	ctx context.Context,
+	logger polylog.Logger,
+	blockClient client.BlockClient,
+	sessionQuerier client.SessionQuerier,
+) *sessionManager {
+	sm := &sessionManager{
+		logger:         logger,
+		blockClient:    blockClient,
+		sessionQuerier: sessionQuerier,
+		sessions:       make(map[sessionKey]*sessionEntry),
+	}
+
+	channel.ForEach(
+		ctx,
+		observable.Observable[client.Block](blockClient.CommittedBlocksSequence(ctx)),
+		sm.refreshSessions,
+	)
+
+	return sm
+}
+
+// GetSession gets the current session for the given application and service.
+// It only queries for it if the session manager hasn't already fetched it.
+func (sdk *poktrollSDK) GetSession(
+	ctx context.Context,
+	appAddress, serviceId string,
+) (*sessiontypes.Session, error) {
+	return sdk.sessionManager.getSession(ctx, appAddress, serviceId)
+}
+
+// getSession returns the session of the given application and service at the
+// latest block height, querying for it if it isn't the one already tracked.
+func (sm *sessionManager) getSession(
+	ctx context.Context,
+	appAddress, serviceId string,
+) (*sessiontypes.Session, error) {
+	key := sessionKey{appAddress: appAddress, serviceId: serviceId}
+	height := sm.blockClient.LatestBlock(ctx).Height()
+
+	sm.sessionsMu.Lock()
+	entry, ok := sm.sessions[key]
+	if ok {
+		if height > entry.lastRequestedHeight {
+			entry.lastRequestedHeight = height
+		}
+		if session := entry.sessionAt(height); session != nil {
+			sm.sessionsMu.Unlock()
+			return session, nil
+		}
+	}
+	sm.sessionsMu.Unlock()
+
+	session, err := sm.sessionQuerier.GetSession(ctx, appAddress, serviceId, height)
+	if err != nil {
+		return nil, err
+	}
+
+	sm.storeSession(key, session, height)
+
+	return session, nil
+}
+
+// storeSession tracks the given session as the current one of the given key,
+// unless a more recent session is already tracked; e.g. when it was refreshed
+// concurrently.
+func (sm *sessionManager) storeSession(
+	key sessionKey,
+	session *sessiontypes.Session,
+	requestedHeight int64,
+) {
+	sm.sessionsMu.Lock()
+	defer sm.sessionsMu.Unlock()
+
+	entry, ok := sm.sessions[key]
+	if !ok {
+		sm.sessions[key] = &sessionEntry{
+			session:             session,
+			lastRequestedHeight: requestedHeight,
+		}
+		return
+	}
+
+	if requestedHeight > entry.lastRequestedHeight {
+		entry.lastRequestedHeight = requestedHeight
+	}
+	if getSessionStartHeight(session) >= getSessionStartHeight(entry.session) {
+		entry.session = session
+	}
+	if entry.nextSession != nil &&
+		getSessionStartHeight(entry.nextSession) <= getSessionStartHeight(entry.session) {
+		entry.nextSession = nil
+	}
+}
+
+// storeNextSession tracks the given prefetched session as the next one of the
+// given key, unless it isn't more recent than its current session.
+func (sm *sessionManager) storeNextSession(key sessionKey, session *sessiontypes.Session) {
+	sm.sessionsMu.Lock()
+	defer sm.sessionsMu.Unlock()
+
+	entry, ok := sm.sessions[key]
+	if !ok {
+		return
+	}
+
+	entry.isPrefetching = false
+	if session != nil && getSessionStartHeight(session) > getSessionStartHeight(entry.session) {
+		entry.nextSession = session
+	}
+}
+
+// refreshSessions prefetches the next session of each tracked application and
+// service whose session ends with the block following the given one, or which
+// already ended without its next session having been fetched. Sessions which
+// weren't requested during the session which ends are no longer tracked.
+// The sessions are queried in the background, at most
+// maxConcurrentSessionPrefetches at a time, so that blocks aren't held up.
+func (sm *sessionManager) refreshSessions(ctx context.Context, block client.Block) {
+	height := block.Height()
+
+	// The next session can be queried from the last block of the current one,
+	// at the height of its first block.
+	prefetchHeights := make(map[sessionKey]int64)
+
+	sm.sessionsMu.Lock()
+	for key, entry := range sm.sessions {
+		if entry.isPrefetching {
+			continue
+		}
+
+		// The next session is already known if it was prefetched.
+		current := entry.session
+		if entry.nextSession != nil {
+			current = entry.nextSession
+		}
+		if !current.IsEnded(height + 1) {
+			continue
+		}
+
+		if entry.lastRequestedHeight < getSessionStartHeight(current) {
+			delete(sm.sessions, key)
+			continue
+		}
+
+		if current.IsEnded(height) {
+			// The next session has already started; e.g. if prefetching it failed.
+			prefetchHeights[key] = height
+		} else {
+			prefetchHeights[key] = height + 1
+		}
+		entry.isPrefetching = true
+	}
+	sm.sessionsMu.Unlock()
+
+	if len(prefetchHeights) == 0 {
+		return
+	}
+
+	go sm.prefetchSessions(ctx, prefetchHeights)
+}
+
+// prefetchSessions queries the session of each of the given keys at its given
+// height, using at most maxConcurrentSessionPrefetches concurrent queries, and
+// tracks them as the next sessions of their keys.
+func (sm *sessionManager) prefetchSessions(
+	ctx context.Context,
+	prefetchHeights map[sessionKey]int64,
+) {
+	var wg sync.WaitGroup
+	prefetchSem := make(chan struct{}, maxConcurrentSessionPrefetches)
+	for key, height := range prefetchHeights {
+		prefetchSem <- struct{}{}
+		wg.Add(1)
+		go func(key sessionKey, height int64) {
+			defer func() {
+				<-prefetchSem
+				wg.Done()
+			}()
+
+			session, err := sm.sessionQuerier.GetSession(ctx, key.appAddress, key.serviceId, height)
+			if err != nil {
+				sm.logger.Warn().
+					Err(err).
+					Str("application_address", key.appAddress).
+					Str("service_id", key.serviceId).
+					Int64("block_height", height).
+					Msg("failed to prefetch session")
+			}
+
+			// Prefetching isn't a request for the session, so it doesn't count
+			// towards keeping it tracked.
+			sm.storeNextSession(key, session)
+		}(key, height)
+	}
+	wg.Wait()
+}
+
+// getSessionStartHeight returns the start block height of the given session.
+func getSessionStartHeight(session *sessiontypes.Session) int64 {
+	return session.GetHeader().GetSessionStartBlockHeight()
+}
@@ -0,0 +1,324 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/pkg/client"
+	"github.com/pokt-network/poktroll/pkg/polylog"
+	"github.com/pokt-network/poktroll/testutil/mockclient"
+	"github.com/pokt-network/poktroll/testutil/sample"
+	sessiontypes "github.com/pokt-network/poktroll/x/session/types"
+	sharedhelpers "github.com/pokt-network/poktroll/x/shared/helpers"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+)
+
+const prefetchTimeout = 2 * time.Second
+
+func TestSessionManager_GetSession(t *testing.T) {
+	ctx := context.Background()
+	appAddr := sample.AccAddress()
+	sm, sessionQuerier, latestHeight := newTestSessionManager(t)
+
+	latestHeight.Store(5)
+	session, err := sm.getSession(ctx, appAddr, "svc1")
+	require.NoError(t, err)
+	require.Equal(t, int64(4), getSessionStartHeight(session))
+
+	// Concurrent requests within the same session are served the tracked session.
+	latestHeight.Store(7)
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			session, err := sm.getSession(ctx, appAddr, "svc1")
+			require.NoError(t, err)
+			require.Equal(t, int64(4), getSessionStartHeight(session))
+		}()
+	}
+	wg.Wait()
+	require.Equal(t, 1, sessionQuerier.getNumQueries())
+
+	// The session is queried again once it has ended.
+	latestHeight.Store(8)
+	session, err = sm.getSession(ctx, appAddr, "svc1")
+	require.NoError(t, err)
+	require.Equal(t, int64(8), getSessionStartHeight(session))
+	require.Equal(t, 1, sessionQuerier.getNumQueriesAt(8, 8))
+
+	// Sessions of other services are tracked separately.
+	_, err = sm.getSession(ctx, appAddr, "svc2")
+	require.NoError(t, err)
+	require.Equal(t, 2, sessionQuerier.getNumQueriesAt(8, 8))
+}
+
+func TestSessionManager_RefreshSessions(t *testing.T) {
+	ctx := context.Background()
+	appAddr := sample.AccAddress()
+	sm, sessionQuerier, latestHeight := newTestSessionManager(t)
+
+	latestHeight.Store(5)
+	_, err := sm.getSession(ctx, appAddr, "svc1")
+	require.NoError(t, err)
+
+	// Blocks before the last one of the session don't prefetch the next session.
+	sm.refreshSessions(ctx, newTestBlock(t, 6))
+	time.Sleep(10 * time.Millisecond)
+	require.Equal(t, 1, sessionQuerier.getNumQueries())
+
+	// The last block of the session prefetches the next one, at its first block.
+	sm.refreshSessions(ctx, newTestBlock(t, 7))
+	require.Eventually(t, func() bool {
+		return sessionQuerier.getNumQueriesAt(8, 8) == 1
+	}, prefetchTimeout, 10*time.Millisecond)
+
+	// Requests at the last block of the session still get the current session.
+	latestHeight.Store(7)
+	session, err := sm.getSession(ctx, appAddr, "svc1")
+	require.NoError(t, err)
+	require.Equal(t, int64(4), getSessionStartHeight(session))
+
+	// Requests at the first block of the next session get the prefetched session.
+	latestHeight.Store(8)
+	session, err = sm.getSession(ctx, appAddr, "svc1")
+	require.NoError(t, err)
+	require.Equal(t, int64(8), getSessionStartHeight(session))
+	require.Equal(t, 2, sessionQuerier.getNumQueries())
+
+	// Sessions which weren't requested during the session which ends are no
+	// longer tracked nor prefetched.
+	sm.refreshSessions(ctx, newTestBlock(t, 11))
+	require.Eventually(t, func() bool {
+		return sessionQuerier.getNumQueriesAt(12, 12) == 1
+	}, prefetchTimeout, 10*time.Millisecond)
+
+	sm.refreshSessions(ctx, newTestBlock(t, 15))
+	time.Sleep(10 * time.Millisecond)
+	require.Equal(t, 3, sessionQuerier.getNumQueries())
+	sm.sessionsMu.Lock()
+	require.Empty(t, sm.sessions)
+	sm.sessionsMu.Unlock()
+}
+
+func TestSessionManager_RefreshSessions_RetriesFailedPrefetches(t *testing.T) {
+	ctx := context.Background()
+	appAddr := sample.AccAddress()
+	sm, sessionQuerier, latestHeight := newTestSessionManager(t)
+
+	latestHeight.Store(5)
+	_, err := sm.getSession(ctx, appAddr, "svc1")
+	require.NoError(t, err)
+
+	sessionQuerier.setErr(fmt.Errorf("session query failed"))
+	sm.refreshSessions(ctx, newTestBlock(t, 7))
+	require.Eventually(t, func() bool {
+		return sessionQuerier.getNumQueriesAt(8, 8) == 1
+	}, prefetchTimeout, 10*time.Millisecond)
+
+	// The next session is queried at the block which started it.
+	sessionQuerier.setErr(nil)
+	require.Eventually(t, func() bool {
+		sm.refreshSessions(ctx, newTestBlock(t, 9))
+		sm.sessionsMu.Lock()
+		defer sm.sessionsMu.Unlock()
+
+		return sm.sessions[sessionKey{appAddress: appAddr, serviceId: "svc1"}].nextSession != nil
+	}, prefetchTimeout, 10*time.Millisecond)
+	require.Equal(t, 1, sessionQuerier.getNumQueriesAt(9, 9))
+
+	latestHeight.Store(9)
+	session, err := sm.getSession(ctx, appAddr, "svc1")
+	require.NoError(t, err)
+	require.Equal(t, int64(8), getSessionStartHeight(session))
+}
+
+func TestSessionManager_RefreshSessions_BoundsConcurrentPrefetches(t *testing.T) {
+	ctx := context.Background()
+	sm, sessionQuerier, latestHeight := newTestSessionManager(t)
+
+	latestHeight.Store(5)
+	numSessions := 3 * maxConcurrentSessionPrefetches
+	for i := 0; i < numSessions; i++ {
+		_, err := sm.getSession(ctx, sample.AccAddress(), "svc1")
+		require.NoError(t, err)
+	}
+
+	releaseQueries := sessionQuerier.blockQueries()
+	sm.refreshSessions(ctx, newTestBlock(t, 7))
+	require.Eventually(t, func() bool {
+		return sessionQuerier.getNumInFlightQueries() == maxConcurrentSessionPrefetches
+	}, prefetchTimeout, 10*time.Millisecond)
+
+	// Blocks committed while the prefetches are in flight don't query them again.
+	sm.refreshSessions(ctx, newTestBlock(t, 7))
+	time.Sleep(10 * time.Millisecond)
+	require.Equal(t, maxConcurrentSessionPrefetches, sessionQuerier.getNumInFlightQueries())
+
+	releaseQueries()
+	require.Eventually(t, func() bool {
+		return sessionQuerier.getNumQueriesAt(8, 8) == numSessions
+	}, prefetchTimeout, 10*time.Millisecond)
+	require.Equal(t, maxConcurrentSessionPrefetches, sessionQuerier.getMaxInFlightQueries())
+}
+
+// newTestSessionManager returns a sessionManager which queries the returned
+// fake session querier at the latest block height, which is set by storing it
+// in the returned value.
+func newTestSessionManager(
+	t *testing.T,
+) (*sessionManager, *fakeSessionQuerier, *atomic.Int64) {
+	t.Helper()
+
+	latestHeight := new(atomic.Int64)
+	ctrl := gomock.NewController(t)
+	blockClient := mockclient.NewMockBlockClient(ctrl)
+	blockClient.EXPECT().
+		LatestBlock(gomock.Any()).
+		DoAndReturn(func(_ context.Context) client.Block {
+			return newTestBlock(t, latestHeight.Load())
+		}).
+		AnyTimes()
+
+	sessionQuerier := &fakeSessionQuerier{queryHeights: make(map[int64]int)}
+	sm := &sessionManager{
+		logger:         polylog.NewNoopLogger(),
+		blockClient:    blockClient,
+		sessionQuerier: sessionQuerier,
+		sessions:       make(map[sessionKey]*sessionEntry),
+	}
+
+	return sm, sessionQuerier, latestHeight
+}
+
+// newTestBlock returns a mock block of the given height.
+func newTestBlock(t *testing.T, height int64) client.Block {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	block := mockclient.NewMockBlock(ctrl)
+	block.EXPECT().Height().Return(height).AnyTimes()
+	block.EXPECT().Hash().Return([]byte{}).AnyTimes()
+
+	return block
+}
+
+// fakeSessionQuerier is a client.SessionQuerier which serves sessions of
+// sharedhelpers.NumBlocksPerSession blocks for any application and service. It
+// records the heights it is queried at and can hold the queries until released.
+type fakeSessionQuerier struct {
+	mu                 sync.Mutex
+	queryHeights       map[int64]int
+	err                error
+	release            chan struct{}
+	numInFlightQueries int
+	maxInFlightQueries int
+}
+
+func (sq *fakeSessionQuerier) GetSession(
+	_ context.Context,
+	appAddress string,
+	serviceId string,
+	blockHeight int64,
+) (*sessiontypes.Session, error) {
+	sq.mu.Lock()
+	sq.queryHeights[blockHeight]++
+	sq.numInFlightQueries++
+	if sq.numInFlightQueries > sq.maxInFlightQueries {
+		sq.maxInFlightQueries = sq.numInFlightQueries
+	}
+	release, err := sq.release, sq.err
+	sq.mu.Unlock()
+
+	if release != nil {
+		<-release
+	}
+
+	sq.mu.Lock()
+	sq.numInFlightQueries--
+	sq.mu.Unlock()
+
+	if err != nil {
+		return nil, err
+	}
+
+	sessionStartHeight := sharedhelpers.GetSessionStartBlockHeight(blockHeight)
+	return &sessiontypes.Session{
+		Header: &sessiontypes.SessionHeader{
+			ApplicationAddress:      appAddress,
+			Service:                 &sharedtypes.Service{Id: serviceId},
+			SessionStartBlockHeight: sessionStartHeight,
+			SessionEndBlockHeight:   sessionStartHeight + sharedhelpers.NumBlocksPerSession,
+		},
+		NumBlocksPerSession: sharedhelpers.NumBlocksPerSession,
+	}, nil
+}
+
+// setErr makes the subsequent queries fail with the given error, if not nil.
+func (sq *fakeSessionQuerier) setErr(err error) {
+	sq.mu.Lock()
+	defer sq.mu.Unlock()
+
+	sq.err = err
+}
+
+// blockQueries holds the subsequent queries until the returned function is called.
+func (sq *fakeSessionQuerier) blockQueries() (releaseQueries func()) {
+	sq.mu.Lock()
+	defer sq.mu.Unlock()
+
+	release := make(chan struct{})
+	sq.release = release
+	return func() {
+		sq.mu.Lock()
+		sq.release = nil
+		sq.mu.Unlock()
+
+		close(release)
+	}
+}
+
+// getNumQueries returns the number of queries received at any height.
+func (sq *fakeSessionQuerier) getNumQueries() (numQueries int) {
+	sq.mu.Lock()
+	defer sq.mu.Unlock()
+
+	for _, numHeightQueries := range sq.queryHeights {
+		numQueries += numHeightQueries
+	}
+	return numQueries
+}
+
+// getNumQueriesAt returns the number of queries received at heights between
+// the given ones, inclusive.
+func (sq *fakeSessionQuerier) getNumQueriesAt(fromHeight, toHeight int64) (numQueries int) {
+	sq.mu.Lock()
+	defer sq.mu.Unlock()
+
+	for height := fromHeight; height <= toHeight; height++ {
+		numQueries += sq.queryHeights[height]
+	}
+	return numQueries
+}
+
+func (sq *fakeSessionQuerier) getNumInFlightQueries() int {
+	sq.mu.Lock()
+	defer sq.mu.Unlock()
+
+	return sq.numInFlightQueries
+}
+
+func (sq *fakeSessionQuerier) getMaxInFlightQueries() int {
+	sq.mu.Lock()
+	defer sq.mu.Unlock()
+
+	return sq.maxInFlightQueries
+}
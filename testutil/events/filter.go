@@ -0,0 +1,32 @@
+package events
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+)
+
+// FilterTypedEvents returns the typed events of type T, decoded from the given
+// events, in the order they were emitted. Events of other types are ignored.
+func FilterTypedEvents[T proto.Message](t *testing.T, events sdk.Events) []T {
+	t.Helper()
+
+	var zero T
+	eventType := proto.MessageName(zero)
+
+	var typedEvents []T
+	for _, event := range events {
+		if event.Type != eventType {
+			continue
+		}
+
+		typedEvent, err := sdk.ParseTypedEvent(abci.Event(event))
+		require.NoError(t, err)
+
+		typedEvents = append(typedEvents, typedEvent.(T))
+	}
+	return typedEvents
+}
@@ -0,0 +1,49 @@
+package events
+
+import (
+	"encoding/json"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	comettypes "github.com/cometbft/cometbft/types"
+	cosmosclient "github.com/cosmos/cosmos-sdk/client"
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// NewTxEventBz returns the bytes of a JSON-RPC events query subscription message
+// for a committed tx event of a transaction containing the given messages at
+// the given height, which resulted in the given code.
+func NewTxEventBz(
+	t *testing.T,
+	txConfig cosmosclient.TxConfig,
+	height int64,
+	code uint32,
+	msgs ...cosmostypes.Msg,
+) []byte {
+	t.Helper()
+
+	txBuilder := txConfig.NewTxBuilder()
+	err := txBuilder.SetMsgs(msgs...)
+	require.NoError(t, err)
+
+	txBz, err := txConfig.TxEncoder()(txBuilder.GetTx())
+	require.NoError(t, err)
+
+	resultEvent := &coretypes.ResultEvent{
+		Query: "tm.event='Tx'",
+		Data: comettypes.EventDataTx{TxResult: abci.TxResult{
+			Height: height,
+			Tx:     txBz,
+			Result: abci.ResponseDeliverTx{Code: code},
+		}},
+	}
+	rpcResponse := rpctypes.NewRPCSuccessResponse(rpctypes.JSONRPCIntID(0), resultEvent)
+
+	eventBz, err := json.Marshal(rpcResponse)
+	require.NoError(t, err)
+
+	return eventBz
+}
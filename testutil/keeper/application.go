@@ -11,6 +11,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/store"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	typesparams "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -42,8 +44,30 @@ func ApplicationKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 
 	ctrl := gomock.NewController(t)
 	mockBankKeeper := mocks.NewMockBankKeeper(ctrl)
-	mockBankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), gomock.Any(), types.ModuleName, gomock.Any()).AnyTimes()
-	mockBankKeeper.EXPECT().UndelegateCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, gomock.Any(), gomock.Any()).AnyTimes()
+	// The balance of the application module account is tracked such that the module
+	// invariants can be checked.
+	moduleBalance := sdk.NewCoins()
+	mockBankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), gomock.Any(), types.ModuleName, gomock.Any()).DoAndReturn(
+		func(_ sdk.Context, _ sdk.AccAddress, _ string, amt sdk.Coins) error {
+			moduleBalance = moduleBalance.Add(amt...)
+			return nil
+		},
+	).AnyTimes()
+	mockBankKeeper.EXPECT().UndelegateCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ sdk.Context, _ string, _ sdk.AccAddress, amt sdk.Coins) error {
+			newModuleBalance, isNegative := moduleBalance.SafeSub(amt...)
+			if isNegative {
+				return sdkerrors.ErrInsufficientFunds
+			}
+			moduleBalance = newModuleBalance
+			return nil
+		},
+	).AnyTimes()
+	mockBankKeeper.EXPECT().GetAllBalances(gomock.Any(), authtypes.NewModuleAddress(types.ModuleName)).DoAndReturn(
+		func(_ sdk.Context, _ sdk.AccAddress) sdk.Coins {
+			return moduleBalance
+		},
+	).AnyTimes()
 
 	mockAccountKeeper := mocks.NewMockAccountKeeper(ctrl)
 	mockAccountKeeper.EXPECT().GetAccount(gomock.Any(), gomock.Any()).AnyTimes()
@@ -31,10 +31,12 @@ var (
 	TestServiceId11 = "svc11" // staked for by app1
 
 	TestServiceId2  = "svc2"  // staked for by app2 & supplier1
-	TestServiceId22 = "svc22" // staked for by app2
+	TestServiceId22 = "svc22" // staked for by app2 & supplier2 (from TestSupplier2PendingUpdateSessionNumber)
 
 	TestServiceId12 = "svc12" // staked for by app1, app2 & supplier1
 
+	TestServiceId3 = "svc3" // staked for by app2 & supplier2 (until TestSupplier2PendingUpdateSessionNumber)
+
 	TestApp1Address = "pokt1mdccn4u38eyjdxkk4h0jaddw4n3c72u82m5m9e" // Generated via sample.AccAddress()
 	TestApp1        = apptypes.Application{
 		Address: TestApp1Address,
@@ -54,7 +56,7 @@ var (
 
 	TestApp2Address = "pokt133amv5suh75zwkxxcq896azvmmwszg99grvk9f" // Generated via sample.AccAddress()
 	TestApp2        = apptypes.Application{
-		Address: TestApp1Address,
+		Address: TestApp2Address,
 		Stake:   &sdk.Coin{Denom: "upokt", Amount: sdk.NewInt(100)},
 		ServiceConfigs: []*sharedtypes.ApplicationServiceConfig{
 			{
@@ -66,14 +68,18 @@ var (
 			{
 				Service: &sharedtypes.Service{Id: TestServiceId12},
 			},
+			{
+				Service: &sharedtypes.Service{Id: TestServiceId3},
+			},
 		},
 	}
 
 	TestSupplierUrl     = "http://olshansky.info"
 	TestSupplierAddress = sample.AccAddress()
 	TestSupplier        = sharedtypes.Supplier{
-		Address: TestSupplierAddress,
-		Stake:   &sdk.Coin{Denom: "upokt", Amount: sdk.NewInt(100)},
+		OwnerAddress:    TestSupplierAddress,
+		OperatorAddress: TestSupplierAddress,
+		Stake:           &sdk.Coin{Denom: "upokt", Amount: sdk.NewInt(100)},
 		Services: []*sharedtypes.SupplierServiceConfig{
 			{
 				Service: &sharedtypes.Service{Id: TestServiceId1},
@@ -107,6 +113,49 @@ var (
 			},
 		},
 	}
+
+	// TestSupplier2 is staked for svc3 until the session number
+	// TestSupplier2PendingUpdateSessionNumber, from which it's staked for svc22
+	// and operated by TestSupplier2PendingOperatorAddress.
+	TestSupplier2PendingUpdateSessionNumber = int64(26)
+	TestSupplier2Address                    = sample.AccAddress()
+	TestSupplier2PendingOperatorAddress     = sample.AccAddress()
+	TestSupplier2                           = sharedtypes.Supplier{
+		OwnerAddress:    TestSupplier2Address,
+		OperatorAddress: TestSupplier2Address,
+		Stake:           &sdk.Coin{Denom: "upokt", Amount: sdk.NewInt(100)},
+		Services: []*sharedtypes.SupplierServiceConfig{
+			{
+				Service: &sharedtypes.Service{Id: TestServiceId3},
+				Endpoints: []*sharedtypes.SupplierEndpoint{
+					{
+						Url:     TestSupplierUrl,
+						RpcType: sharedtypes.RPCType_JSON_RPC,
+						Configs: make([]*sharedtypes.ConfigOption, 0),
+					},
+				},
+			},
+		},
+		PendingServiceConfigUpdate: &sharedtypes.SupplierServiceConfigUpdate{
+			Services: []*sharedtypes.SupplierServiceConfig{
+				{
+					Service: &sharedtypes.Service{Id: TestServiceId22},
+					Endpoints: []*sharedtypes.SupplierEndpoint{
+						{
+							Url:     TestSupplierUrl,
+							RpcType: sharedtypes.RPCType_JSON_RPC,
+							Configs: make([]*sharedtypes.ConfigOption, 0),
+						},
+					},
+				},
+			},
+			EffectiveSessionNumber: TestSupplier2PendingUpdateSessionNumber,
+		},
+		PendingOperatorUpdate: &sharedtypes.SupplierOperatorUpdate{
+			NewOperatorAddress:     TestSupplier2PendingOperatorAddress,
+			EffectiveSessionNumber: TestSupplier2PendingUpdateSessionNumber,
+		},
+	}
 )
 
 func SessionKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
@@ -170,8 +219,21 @@ func defaultAppKeeperMock(t testing.TB) types.ApplicationKeeper {
 		}
 	}
 
+	getAppsByServiceFn := func(_ context.Context, serviceId string) (apps []apptypes.Application) {
+		for _, app := range []apptypes.Application{TestApp2, TestApp1} {
+			for _, serviceConfig := range app.ServiceConfigs {
+				if serviceConfig.Service.Id == serviceId {
+					apps = append(apps, app)
+					break
+				}
+			}
+		}
+		return apps
+	}
+
 	mockAppKeeper := mocks.NewMockApplicationKeeper(ctrl)
 	mockAppKeeper.EXPECT().GetApplication(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(getAppFn)
+	mockAppKeeper.EXPECT().GetApplicationsByService(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(getAppsByServiceFn)
 	mockAppKeeper.EXPECT().GetApplication(gomock.Any(), TestApp1Address).AnyTimes().Return(TestApp1, true)
 
 	return mockAppKeeper
@@ -181,9 +243,22 @@ func defaultSupplierKeeperMock(t testing.TB) types.SupplierKeeper {
 	t.Helper()
 	ctrl := gomock.NewController(t)
 
-	allSuppliers := []sharedtypes.Supplier{TestSupplier}
+	allSuppliers := []sharedtypes.Supplier{TestSupplier, TestSupplier2}
+
+	getSuppliersByServiceFn := func(_ context.Context, serviceId string) (suppliers []sharedtypes.Supplier) {
+		for _, supplier := range allSuppliers {
+			for _, serviceConfig := range supplier.Services {
+				if serviceConfig.Service.Id == serviceId {
+					suppliers = append(suppliers, supplier)
+					break
+				}
+			}
+		}
+		return suppliers
+	}
 
 	mockSupplierKeeper := mocks.NewMockSupplierKeeper(ctrl)
+	mockSupplierKeeper.EXPECT().GetSuppliersByService(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(getSuppliersByServiceFn)
 	mockSupplierKeeper.EXPECT().GetAllSupplier(gomock.Any()).AnyTimes().Return(allSuppliers)
 
 	return mockSupplierKeeper
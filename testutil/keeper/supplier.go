@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"context"
+	"fmt"
 	"testing"
 
 	tmdb "github.com/cometbft/cometbft-db"
@@ -11,15 +13,36 @@ import (
 	"github.com/cosmos/cosmos-sdk/store"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	typesparams "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/golang/mock/gomock"
+	"github.com/pokt-network/smt"
 	"github.com/stretchr/testify/require"
 
+	"github.com/pokt-network/poktroll/testutil/sample"
 	mocks "github.com/pokt-network/poktroll/testutil/supplier/mocks"
+	apptypes "github.com/pokt-network/poktroll/x/application/types"
+	servicetypes "github.com/pokt-network/poktroll/x/service/types"
+	sessiontypes "github.com/pokt-network/poktroll/x/session/types"
+	sharedhelpers "github.com/pokt-network/poktroll/x/shared/helpers"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
 	"github.com/pokt-network/poktroll/x/supplier/keeper"
 	"github.com/pokt-network/poktroll/x/supplier/types"
 )
 
+// SupplierSessionsMap is used to mock the on-chain sessions, keyed by session ID,
+// for use in the supplier's mocked session keeper. This enables the tester to
+// control which sessions claims can be created for and which suppliers are in them.
+// WARNING: Using this map may cause issues if running multiple tests in parallel
+var SupplierSessionsMap = make(map[string]*sessiontypes.Session)
+
+// SupplierApplicationsMap is used to mock the staked applications, keyed by
+// address, for use in the supplier's mocked application keeper. This enables
+// the tester to control the stakes which claims are settled against.
+// WARNING: Using this map may cause issues if running multiple tests in parallel
+var SupplierApplicationsMap = make(map[string]apptypes.Application)
+
 func SupplierKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 	storeKey := sdk.NewKVStoreKey(types.StoreKey)
 	memStoreKey := storetypes.NewMemoryStoreKey(types.MemStoreKey)
@@ -35,8 +58,70 @@ func SupplierKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 
 	ctrl := gomock.NewController(t)
 	mockBankKeeper := mocks.NewMockBankKeeper(ctrl)
-	mockBankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), gomock.Any(), types.ModuleName, gomock.Any()).AnyTimes()
-	mockBankKeeper.EXPECT().UndelegateCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, gomock.Any(), gomock.Any()).AnyTimes()
+	// The balance of the supplier module account is tracked such that the module
+	// invariants can be checked.
+	moduleBalance := sdk.NewCoins()
+	mockBankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), gomock.Any(), types.ModuleName, gomock.Any()).DoAndReturn(
+		func(_ sdk.Context, _ sdk.AccAddress, _ string, amt sdk.Coins) error {
+			moduleBalance = moduleBalance.Add(amt...)
+			return nil
+		},
+	).AnyTimes()
+	mockBankKeeper.EXPECT().UndelegateCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ sdk.Context, _ string, _ sdk.AccAddress, amt sdk.Coins) error {
+			newModuleBalance, isNegative := moduleBalance.SafeSub(amt...)
+			if isNegative {
+				return sdkerrors.ErrInsufficientFunds
+			}
+			moduleBalance = newModuleBalance
+			return nil
+		},
+	).AnyTimes()
+	mockBankKeeper.EXPECT().GetAllBalances(gomock.Any(), authtypes.NewModuleAddress(types.ModuleName)).DoAndReturn(
+		func(_ sdk.Context, _ sdk.AccAddress) sdk.Coins {
+			return moduleBalance
+		},
+	).AnyTimes()
+
+	mockBankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), apptypes.ModuleName, gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	mockServiceKeeper := mocks.NewMockServiceKeeper(ctrl)
+	mockServiceKeeper.EXPECT().SessionRelayDifficultyBits(gomock.Any(), gomock.Any(), gomock.Any()).Return(uint64(0), nil).AnyTimes()
+	mockServiceKeeper.EXPECT().AddProvenMinedRelays(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	mockApplicationKeeper := mocks.NewMockApplicationKeeper(ctrl)
+	mockApplicationKeeper.EXPECT().GetApplication(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ sdk.Context, appAddr string) (apptypes.Application, bool) {
+			app, ok := SupplierApplicationsMap[appAddr]
+			return app, ok
+		},
+	).AnyTimes()
+	mockApplicationKeeper.EXPECT().SetApplication(gomock.Any(), gomock.Any()).Do(
+		func(_ sdk.Context, app apptypes.Application) {
+			SupplierApplicationsMap[app.Address] = app
+		},
+	).AnyTimes()
+	mockApplicationKeeper.EXPECT().RemoveApplication(gomock.Any(), gomock.Any()).Do(
+		func(_ sdk.Context, appAddr string) {
+			delete(SupplierApplicationsMap, appAddr)
+		},
+	).AnyTimes()
+
+	mockSessionKeeper := mocks.NewMockSessionKeeper(ctrl)
+	mockSessionKeeper.EXPECT().GetSession(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *sessiontypes.QueryGetSessionRequest) (*sessiontypes.QueryGetSessionResponse, error) {
+			sessionStartHeight := sharedhelpers.GetSessionStartBlockHeight(req.GetBlockHeight())
+			for _, session := range SupplierSessionsMap {
+				sessionHeader := session.GetHeader()
+				if sessionHeader.GetApplicationAddress() == req.GetApplicationAddress() &&
+					sessionHeader.GetService().GetId() == req.GetService().GetId() &&
+					sessionHeader.GetSessionStartBlockHeight() == sessionStartHeight {
+					return &sessiontypes.QueryGetSessionResponse{Session: session}, nil
+				}
+			}
+			return nil, sessiontypes.ErrSessionHydration.Wrapf("session not found for request: %+v", req)
+		},
+	).AnyTimes()
 
 	paramsSubspace := typesparams.NewSubspace(cdc,
 		types.Amino,
@@ -51,6 +136,9 @@ func SupplierKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 		paramsSubspace,
 
 		mockBankKeeper,
+		mockServiceKeeper,
+		mockSessionKeeper,
+		mockApplicationKeeper,
 	)
 
 	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
@@ -60,3 +148,85 @@ func SupplierKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 
 	return k, ctx
 }
+
+// AddSupplierSession adds a session, of an application with the given stake, to
+// the sessions of the mocked session keeper, with the given supplier and any
+// other suppliers in it. It returns the header of the session.
+func AddSupplierSession(
+	t *testing.T,
+	supplierAddr string,
+	appStakeAmount int64,
+	otherSupplierAddrs ...string,
+) *sessiontypes.SessionHeader {
+	t.Helper()
+
+	appStake := sdk.NewCoin("upokt", sdk.NewInt(appStakeAmount))
+	sessionHeader := &sessiontypes.SessionHeader{
+		ApplicationAddress:      sample.AccAddress(),
+		Service:                 &sharedtypes.Service{Id: "svc1"},
+		SessionId:               fmt.Sprintf("session_id_%s", supplierAddr),
+		SessionStartBlockHeight: 4,
+		SessionEndBlockHeight:   8,
+	}
+
+	// The suppliers are operated by their owners.
+	suppliers := []*sharedtypes.Supplier{{OperatorAddress: supplierAddr, OwnerAddress: supplierAddr}}
+	for _, otherSupplierAddr := range otherSupplierAddrs {
+		suppliers = append(suppliers, &sharedtypes.Supplier{OperatorAddress: otherSupplierAddr, OwnerAddress: otherSupplierAddr})
+	}
+
+	app := apptypes.Application{
+		Address: sessionHeader.ApplicationAddress,
+		Stake:   &appStake,
+	}
+	SupplierApplicationsMap[app.Address] = app
+	SupplierSessionsMap[sessionHeader.SessionId] = &sessiontypes.Session{
+		Header:      sessionHeader,
+		SessionId:   sessionHeader.SessionId,
+		Application: &app,
+		Suppliers:   suppliers,
+	}
+	t.Cleanup(func() {
+		delete(SupplierSessionsMap, sessionHeader.SessionId)
+		delete(SupplierApplicationsMap, app.Address)
+	})
+
+	return sessionHeader
+}
+
+// NewSupplierSessionTree returns the committed session SMST of the given number of relays
+// serviced by the given supplier in the given session.
+func NewSupplierSessionTree(
+	t *testing.T,
+	supplierAddr string,
+	sessionHeader *sessiontypes.SessionHeader,
+	numRelays int,
+) *smt.SMST {
+	t.Helper()
+
+	treeStore, err := smt.NewKVStore("")
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, treeStore.Stop()) })
+
+	tree := servicetypes.NewRelaySMST(treeStore)
+	for i := 0; i < numRelays; i++ {
+		relay := &servicetypes.Relay{
+			Req: &servicetypes.RelayRequest{
+				Meta: &servicetypes.RelayRequestMetadata{
+					SessionHeader:   sessionHeader,
+					SupplierAddress: supplierAddr,
+				},
+				Payload: []byte(fmt.Sprintf("request_%d", i)),
+			},
+			Res: &servicetypes.RelayResponse{},
+		}
+		relayBz, err := relay.GetCanonicalBytes()
+		require.NoError(t, err)
+
+		key, value, weight := servicetypes.RelaySMSTLeaf(relayBz)
+		require.NoError(t, tree.Update(key, value, weight))
+	}
+	require.NoError(t, tree.Commit())
+
+	return tree
+}
@@ -151,9 +151,11 @@ func DefaultSupplierModuleGenesisState(t *testing.T, n int) *suppliertypes.Genes
 	state := suppliertypes.DefaultGenesis()
 	for i := 0; i < n; i++ {
 		stake := sdk.NewCoin("upokt", sdk.NewInt(int64(i)))
+		supplierAddress := sample.AccAddress()
 		supplier := sharedtypes.Supplier{
-			Address: sample.AccAddress(),
-			Stake:   &stake,
+			OwnerAddress:    supplierAddress,
+			OperatorAddress: supplierAddress,
+			Stake:           &stake,
 			Services: []*sharedtypes.SupplierServiceConfig{
 				{
 					Service: &sharedtypes.Service{Id: fmt.Sprintf("svc%d", i)},
@@ -179,8 +181,9 @@ func SupplierModuleGenesisStateWithAccounts(t *testing.T, addresses []string) *s
 	state := suppliertypes.DefaultGenesis()
 	for _, addr := range addresses {
 		supplier := sharedtypes.Supplier{
-			Address: addr,
-			Stake:   &sdk.Coin{Denom: "upokt", Amount: sdk.NewInt(10000)},
+			OwnerAddress:    addr,
+			OperatorAddress: addr,
+			Stake:           &sdk.Coin{Denom: "upokt", Amount: sdk.NewInt(10000)},
 			Services: []*sharedtypes.SupplierServiceConfig{
 				{
 					Service: &sharedtypes.Service{Id: "svc1"},
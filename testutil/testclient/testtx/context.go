@@ -25,6 +25,10 @@ import (
 	"github.com/pokt-network/poktroll/testutil/testclient"
 )
 
+// DefaultSimulatedGasUsed is the amount of gas which mock transaction contexts
+// report as consumed when simulating a transaction.
+const DefaultSimulatedGasUsed = uint64(100000)
+
 // NewLocalnetContext creates and returns a new transaction context configured
 // for use with the localnet sequencer.
 func NewLocalnetContext(t *testing.T) client.TxContext {
@@ -203,7 +207,7 @@ func NewOneTimeTxTxContext(
 }
 
 // NewBaseTxContext creates a mock transaction context that's configured to expect
-// calls to NewTxBuilder, SignTx, and EncodeTx methods, any number of times.
+// calls to NewTxBuilder, SignTx, EncodeTx, and Simulate methods, any number of times.
 // EncodeTx is used to intercept the encoded transaction bytes and store them in
 // the expectedTx output parameter. Each of these methods proxies to the corresponding
 // method on a real transaction context.
@@ -222,7 +226,8 @@ func NewBaseTxContext(
 	txCtxMock.EXPECT().SignTx(
 		gomock.Eq(signingKeyName),
 		gomock.AssignableToTypeOf(txCtx.NewTxBuilder()),
-		gomock.Eq(false), gomock.Eq(false),
+		gomock.Any(), gomock.Any(),
+		gomock.Any(),
 	).DoAndReturn(txCtx.SignTx).AnyTimes()
 	txCtxMock.EXPECT().EncodeTx(gomock.Any()).
 		DoAndReturn(
@@ -233,6 +238,13 @@ func NewBaseTxContext(
 				return expectedTx.Bytes(), nil
 			},
 		).AnyTimes()
+	// intercept #Simulate() call to mock response and prevent actual simulation
+	txCtxMock.EXPECT().Simulate(
+		gomock.Any(),
+		gomock.Eq(signingKeyName),
+		gomock.Any(),
+		gomock.Any(),
+	).Return(DefaultSimulatedGasUsed, nil).AnyTimes()
 
 	return txCtxMock
 }
@@ -271,6 +283,9 @@ func NewAnyTimesTxTxContext(
 	require.NoError(t, err)
 	txCtxMock := mockclient.NewMockTxContext(ctrl)
 	txCtxMock.EXPECT().GetKeyring().Return(keyring).AnyTimes()
+	txCtxMock.EXPECT().GetAccountNumberSequence(gomock.Any()).
+		DoAndReturn(txCtx.GetAccountNumberSequence).
+		AnyTimes()
 
 	return txCtxMock, txCtx
 }
@@ -27,6 +27,9 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdQueryParams())
 	cmd.AddCommand(CmdListApplication())
 	cmd.AddCommand(CmdShowApplication())
+	cmd.AddCommand(CmdListApplicationsByService())
+	cmd.AddCommand(CmdListApplicationsByGateway())
+	cmd.AddCommand(CmdShowApplicationRing())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -44,6 +44,82 @@ func CmdListApplication() *cobra.Command {
 	return cmd
 }
 
+func CmdListApplicationsByService() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-applications-by-service <service_id>",
+		Short: "list all applications staked for a service",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryApplicationsByServiceRequest{
+				ServiceId:  args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ApplicationsByService(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdListApplicationsByGateway() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-applications-by-gateway <gateway_address>",
+		Short: "list all applications delegated to a gateway",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryApplicationsByGatewayRequest{
+				GatewayAddress: args[0],
+				Pagination:     pageReq,
+			}
+
+			res, err := queryClient.ApplicationsByGateway(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func CmdShowApplication() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "show-application <application_address>",
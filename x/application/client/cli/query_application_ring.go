@@ -0,0 +1,63 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/pokt-network/poktroll/x/application/types"
+)
+
+func CmdShowApplicationRing() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-application-ring <application_address> [block_height]",
+		Short: "shows the ring of an application",
+		Long: `Query the addresses which make up the ring of an application as of a given height.
+
+The ring is made up of the application's address followed by the addresses of the gateways it was delegated to during the session of the given height.
+Delegation changes take effect at the start of the session following the one they were made in.
+
+[block_height] is optional. If unspecified, or set to 0, it defaults to the latest height of the node being queried.
+
+Example:
+$ poktrolld --home=$(POKTROLLD_HOME) q application show-application-ring pokt1mrqt5f7qh8uxs27cjm9t7v9e74a9vvdnq5jva4 42 --node $(POCKET_NODE)`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			appAddressString := args[0]
+			blockHeightString := "0" // 0 will default to latest height
+			if len(args) == 2 {
+				blockHeightString = args[1]
+			}
+
+			blockHeight, err := strconv.ParseInt(blockHeightString, 10, 64)
+			if err != nil {
+				return fmt.Errorf("couldn't convert block height to int: %s; (%v)", blockHeightString, err)
+			}
+
+			getRingReq := types.NewQueryGetApplicationRingRequest(appAddressString, blockHeight)
+			if err := getRingReq.ValidateBasic(); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			getRingRes, err := queryClient.ApplicationRing(cmd.Context(), getRingReq)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(getRingRes)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -35,6 +35,7 @@ func GetTxCmd() *cobra.Command {
 
 	cmd.AddCommand(CmdDelegateToGateway())
 	cmd.AddCommand(CmdUndelegateFromGateway())
+	cmd.AddCommand(CmdDecreaseApplicationStake())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -7,13 +7,33 @@ import (
 	"github.com/pokt-network/poktroll/x/application/types"
 )
 
-// SetApplication set a specific application in the store from its index
+// SetApplication set a specific application in the store from its index, and
+// updates the service and gateway indexes to match it.
 func (k Keeper) SetApplication(ctx sdk.Context, application types.Application) {
+	// Drop the index entries of the application being overwritten, if any,
+	// since its services and delegatee gateways may have changed.
+	if prevApp, isAppFound := k.GetApplication(ctx, application.Address); isAppFound {
+		k.removeApplicationIndexes(ctx, prevApp)
+	}
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ApplicationKeyPrefix))
 	b := k.cdc.MustMarshal(&application)
-	store.Set(types.ApplicationKey(
+	primaryKey := types.ApplicationKey(
 		application.Address,
-	), b)
+	)
+	store.Set(primaryKey, b)
+
+	// Update the service index: serviceId -> [ApplicationKey]
+	serviceStoreIndex := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ApplicationServicePrefix))
+	for _, serviceConfig := range application.ServiceConfigs {
+		serviceStoreIndex.Set(types.ApplicationIndexKey(serviceConfig.GetService().GetId(), primaryKey), primaryKey)
+	}
+
+	// Update the gateway index: gatewayAddress -> [ApplicationKey]
+	gatewayStoreIndex := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ApplicationGatewayPrefix))
+	for _, gatewayAddr := range application.DelegateeGatewayAddresses {
+		gatewayStoreIndex.Set(types.ApplicationIndexKey(gatewayAddr, primaryKey), primaryKey)
+	}
 }
 
 // GetApplication returns a application from its index
@@ -41,12 +61,33 @@ func (k Keeper) RemoveApplication(
 	appAddr string,
 
 ) {
+	app, isAppFound := k.GetApplication(ctx, appAddr)
+	if !isAppFound {
+		return
+	}
+	k.removeApplicationIndexes(ctx, app)
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ApplicationKeyPrefix))
 	store.Delete(types.ApplicationKey(
 		appAddr,
 	))
 }
 
+// removeApplicationIndexes removes the service and gateway index entries of the given application
+func (k Keeper) removeApplicationIndexes(ctx sdk.Context, app types.Application) {
+	primaryKey := types.ApplicationKey(app.Address)
+
+	serviceStoreIndex := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ApplicationServicePrefix))
+	for _, serviceConfig := range app.ServiceConfigs {
+		serviceStoreIndex.Delete(types.ApplicationIndexKey(serviceConfig.GetService().GetId(), primaryKey))
+	}
+
+	gatewayStoreIndex := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ApplicationGatewayPrefix))
+	for _, gatewayAddr := range app.DelegateeGatewayAddresses {
+		gatewayStoreIndex.Delete(types.ApplicationIndexKey(gatewayAddr, primaryKey))
+	}
+}
+
 // GetAllApplication returns all application
 func (k Keeper) GetAllApplication(ctx sdk.Context) (apps []types.Application) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ApplicationKeyPrefix))
@@ -62,3 +103,44 @@ func (k Keeper) GetAllApplication(ctx sdk.Context) (apps []types.Application) {
 
 	return
 }
+
+// GetApplicationsByService returns all applications staked for the given service
+func (k Keeper) GetApplicationsByService(ctx sdk.Context, serviceId string) []types.Application {
+	return k.getApplicationsByIndex(ctx, types.ApplicationServicePrefix, serviceId)
+}
+
+// GetApplicationsByGateway returns all applications delegated to the given
+// gateway, including those whose delegation is still pending.
+func (k Keeper) GetApplicationsByGateway(ctx sdk.Context, gatewayAddr string) []types.Application {
+	return k.getApplicationsByIndex(ctx, types.ApplicationGatewayPrefix, gatewayAddr)
+}
+
+// getApplicationsByIndex returns all applications indexed under the given value
+// in the index with the given prefix.
+func (k Keeper) getApplicationsByIndex(ctx sdk.Context, indexPrefix, indexValue string) (apps []types.Application) {
+	storeIndex := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(indexPrefix))
+
+	iterator := sdk.KVStorePrefixIterator(storeIndex, types.ApplicationIndexKeyPrefix(indexValue))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		primaryKey := iterator.Value()
+		app, isAppFound := k.getApplicationByPrimaryKey(ctx, primaryKey)
+		if isAppFound {
+			apps = append(apps, app)
+		}
+	}
+
+	return apps
+}
+
+// getApplicationByPrimaryKey is a helper that retrieves, if exists, the Application associated with the key provided
+func (k Keeper) getApplicationByPrimaryKey(ctx sdk.Context, primaryKey []byte) (app types.Application, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ApplicationKeyPrefix))
+	b := store.Get(primaryKey)
+	if b == nil {
+		return app, false
+	}
+	k.cdc.MustUnmarshal(b, &app)
+	return app, true
+}
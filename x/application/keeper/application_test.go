@@ -78,6 +78,60 @@ func TestApplicationGetAll(t *testing.T) {
 	)
 }
 
+func TestApplicationGetByService(t *testing.T) {
+	keeper, ctx := keepertest.ApplicationKeeper(t)
+	// Staking 11 applications makes sure that "svc1" doesn't match "svc10"
+	apps := createNApplication(keeper, ctx, 11)
+
+	appsFound := keeper.GetApplicationsByService(ctx, "svc1")
+	require.Len(t, appsFound, 1)
+	require.Equal(t, nullify.Fill(&apps[1]), nullify.Fill(&appsFound[0]))
+
+	// Replacing the services of an application updates the index
+	app := apps[1]
+	app.ServiceConfigs = apps[2].ServiceConfigs
+	keeper.SetApplication(ctx, app)
+	require.Empty(t, keeper.GetApplicationsByService(ctx, "svc1"))
+	require.ElementsMatch(t,
+		nullify.Fill([]types.Application{apps[2], app}),
+		nullify.Fill(keeper.GetApplicationsByService(ctx, "svc2")),
+	)
+
+	// Removing an application removes it from the index
+	keeper.RemoveApplication(ctx, app.Address)
+	appsFound = keeper.GetApplicationsByService(ctx, "svc2")
+	require.Len(t, appsFound, 1)
+	require.Equal(t, nullify.Fill(&apps[2]), nullify.Fill(&appsFound[0]))
+}
+
+func TestApplicationGetByGateway(t *testing.T) {
+	keeper, ctx := keepertest.ApplicationKeeper(t)
+	apps := createNApplication(keeper, ctx, 3)
+	gatewayAddr1 := sample.AccAddress()
+	gatewayAddr2 := sample.AccAddress()
+
+	// Delegate the first two applications to the first gateway
+	for i := 0; i < 2; i++ {
+		apps[i].DelegateeGatewayAddresses = []string{gatewayAddr1}
+		keeper.SetApplication(ctx, apps[i])
+	}
+	require.ElementsMatch(t,
+		nullify.Fill(apps[:2]),
+		nullify.Fill(keeper.GetApplicationsByGateway(ctx, gatewayAddr1)),
+	)
+	require.Empty(t, keeper.GetApplicationsByGateway(ctx, gatewayAddr2))
+
+	// Redelegating an application updates the index
+	apps[0].DelegateeGatewayAddresses = []string{gatewayAddr2}
+	keeper.SetApplication(ctx, apps[0])
+	appsFound := keeper.GetApplicationsByGateway(ctx, gatewayAddr1)
+	require.Len(t, appsFound, 1)
+	require.Equal(t, nullify.Fill(&apps[1]), nullify.Fill(&appsFound[0]))
+	appsFound = keeper.GetApplicationsByGateway(ctx, gatewayAddr2)
+	require.Len(t, appsFound, 1)
+	require.Equal(t, nullify.Fill(&apps[0]), nullify.Fill(&appsFound[0]))
+}
+
 // The application module address is derived off of its semantic name.
 // This test is a helper for us to easily identify the underlying address.
 func TestApplicationModuleAddress(t *testing.T) {
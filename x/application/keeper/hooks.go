@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/pokt-network/poktroll/x/application/types"
+	gatewaytypes "github.com/pokt-network/poktroll/x/gateway/types"
+	sharedhelpers "github.com/pokt-network/poktroll/x/shared/helpers"
+)
+
+var _ gatewaytypes.GatewayHooks = Hooks{}
+
+// Hooks implements the gateway hooks through which the application module keeps
+// the delegations of the applications to gateways in sync with their stakes.
+type Hooks struct {
+	k Keeper
+}
+
+// Hooks returns the gateway hooks of the application module.
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// AfterGatewayUnstaked undelegates all the applications delegated to the gateway
+// which unstaked. Like an undelegation message, the gateway remains in the ring
+// of the applications until the start of the next session.
+func (h Hooks) AfterGatewayUnstaked(ctx sdk.Context, gatewayAddress string) error {
+	logger := h.k.Logger(ctx).With("method", "AfterGatewayUnstaked")
+	currentSessionNumber := sharedhelpers.GetSessionNumber(ctx.BlockHeight())
+
+	for _, app := range h.k.GetApplicationsByGateway(ctx, gatewayAddress) {
+		delegateeIdx := -1
+		for i, delegateeGatewayAddr := range app.DelegateeGatewayAddresses {
+			if delegateeGatewayAddr == gatewayAddress {
+				delegateeIdx = i
+				break
+			}
+		}
+		if delegateeIdx == -1 {
+			continue
+		}
+
+		app.DelegateeGatewayAddresses = append(app.DelegateeGatewayAddresses[:delegateeIdx], app.DelegateeGatewayAddresses[delegateeIdx+1:]...)
+		app.RecordDelegationChange(gatewayAddress, true, currentSessionNumber)
+		h.k.SetApplication(ctx, app)
+		logger.Info("Undelegated application %s from unstaked gateway %s", app.Address, gatewayAddress)
+
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventDelegationChanged{
+			ApplicationAddress: app.Address,
+			DelegationChange:   app.DelegationChanges[len(app.DelegationChanges)-1],
+		}); err != nil {
+			logger.Error("failed to emit EventDelegationChanged: %v", err)
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/testutil/events"
+	keepertest "github.com/pokt-network/poktroll/testutil/keeper"
+	"github.com/pokt-network/poktroll/testutil/sample"
+	"github.com/pokt-network/poktroll/x/application/keeper"
+	"github.com/pokt-network/poktroll/x/application/types"
+	sharedhelpers "github.com/pokt-network/poktroll/x/shared/helpers"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+)
+
+func TestHooks_AfterGatewayUnstaked(t *testing.T) {
+	k, ctx := keepertest.ApplicationKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	stake := sdk.NewCoin("upokt", sdk.NewInt(100))
+	services := []*sharedtypes.ApplicationServiceConfig{
+		{Service: &sharedtypes.Service{Id: "svc1"}},
+	}
+
+	// Mock the gateways being staked via the staked gateway map
+	unstakedGatewayAddr := sample.AccAddress()
+	otherGatewayAddr := sample.AccAddress()
+	keepertest.StakedGatewayMap[unstakedGatewayAddr] = struct{}{}
+	keepertest.StakedGatewayMap[otherGatewayAddr] = struct{}{}
+	t.Cleanup(func() {
+		delete(keepertest.StakedGatewayMap, unstakedGatewayAddr)
+		delete(keepertest.StakedGatewayMap, otherGatewayAddr)
+	})
+
+	// Delegate an application to both gateways and another one to the other
+	// gateway only
+	delegatedAppAddr := sample.AccAddress()
+	otherAppAddr := sample.AccAddress()
+	for _, appAddr := range []string{delegatedAppAddr, otherAppAddr} {
+		_, err := srv.StakeApplication(wctx, types.NewMsgStakeApplication(appAddr, stake, services))
+		require.NoError(t, err)
+		_, err = srv.DelegateToGateway(wctx, types.NewMsgDelegateToGateway(appAddr, otherGatewayAddr))
+		require.NoError(t, err)
+	}
+	_, err := srv.DelegateToGateway(wctx, types.NewMsgDelegateToGateway(delegatedAppAddr, unstakedGatewayAddr))
+	require.NoError(t, err)
+
+	// Unstake the gateway in a later session
+	ctx = ctx.WithBlockHeight(sharedhelpers.NumBlocksPerSession).WithEventManager(sdk.NewEventManager())
+	delete(keepertest.StakedGatewayMap, unstakedGatewayAddr)
+	err = k.Hooks().AfterGatewayUnstaked(ctx, unstakedGatewayAddr)
+	require.NoError(t, err)
+
+	// The application delegated to the gateway is undelegated from it, which
+	// keeps the delegations invariant
+	delegatedApp, isAppFound := k.GetApplication(ctx, delegatedAppAddr)
+	require.True(t, isAppFound)
+	require.Equal(t, []string{otherGatewayAddr}, delegatedApp.DelegateeGatewayAddresses)
+	require.Empty(t, k.GetApplicationsByGateway(ctx, unstakedGatewayAddr))
+	_, isBroken := keeper.DelegatedGatewaysInvariant(*k)(ctx)
+	require.False(t, isBroken)
+
+	// The gateway remains in the application's ring until the next session
+	require.ElementsMatch(t,
+		[]string{otherGatewayAddr, unstakedGatewayAddr},
+		delegatedApp.GetDelegateeGatewayAddressesAtSession(1),
+	)
+	require.Equal(t, []string{otherGatewayAddr}, delegatedApp.GetDelegateeGatewayAddressesAtSession(2))
+
+	// The other application's delegations are left untouched
+	otherApp, isAppFound := k.GetApplication(ctx, otherAppAddr)
+	require.True(t, isAppFound)
+	require.Equal(t, []string{otherGatewayAddr}, otherApp.DelegateeGatewayAddresses)
+
+	// Only the undelegation of the delegated application is emitted
+	delegationEvents := events.FilterTypedEvents[*types.EventDelegationChanged](t, ctx.EventManager().Events())
+	require.Len(t, delegationEvents, 1)
+	require.Equal(t, delegatedAppAddr, delegationEvents[0].ApplicationAddress)
+	require.Equal(t, unstakedGatewayAddr, delegationEvents[0].DelegationChange.GatewayAddress)
+	require.True(t, delegationEvents[0].DelegationChange.IsUndelegation)
+}
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/pokt-network/poktroll/x/application/types"
+	sharedhelpers "github.com/pokt-network/poktroll/x/shared/helpers"
+)
+
+// RegisterInvariants registers the application module invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "module-account-balance", ModuleAccountBalanceInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "positive-stake", PositiveStakeInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "delegated-gateways", DelegatedGatewaysInvariant(k))
+}
+
+// ModuleAccountBalanceInvariant checks that the balance of the application module
+// account is the sum of the stakes of all applications.
+func ModuleAccountBalanceInvariant(k Keeper) sdk.Invariant {
+	return sharedhelpers.ModuleAccountBalanceInvariant(types.ModuleName, "application", k.bankKeeper, k.getApplicationStakes)
+}
+
+// PositiveStakeInvariant checks that every application has a positive stake.
+func PositiveStakeInvariant(k Keeper) sdk.Invariant {
+	return sharedhelpers.PositiveStakeInvariant(types.ModuleName, "application", k.getApplicationStakes)
+}
+
+// DelegatedGatewaysInvariant checks that every application is delegated to at
+// most MaxDelegatedGateways gateways, all of which are staked.
+// The applications delegated to a gateway are undelegated from it when it
+// unstakes, through the gateway hooks.
+// TODO_BLOCKER: Lowering the MaxDelegatedGateways param below the number of
+// delegatees of an application breaks this invariant.
+func DelegatedGatewaysInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg           string
+			numBrokenApps int
+		)
+		maxDelegatedGateways := k.GetParams(ctx).MaxDelegatedGateways
+		for _, app := range k.GetAllApplication(ctx) {
+			if int64(len(app.DelegateeGatewayAddresses)) > maxDelegatedGateways {
+				numBrokenApps++
+				msg += fmt.Sprintf("\tapplication %s is delegated to %d gateways, more than the maximum of %d\n",
+					app.Address, len(app.DelegateeGatewayAddresses), maxDelegatedGateways)
+			}
+			for _, gatewayAddr := range app.DelegateeGatewayAddresses {
+				if _, isGatewayFound := k.gatewayKeeper.GetGateway(ctx, gatewayAddr); !isGatewayFound {
+					numBrokenApps++
+					msg += fmt.Sprintf("\tapplication %s is delegated to gateway %s which is not staked\n", app.Address, gatewayAddr)
+				}
+			}
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "delegated gateways",
+			fmt.Sprintf("found %d broken application delegations\n%s", numBrokenApps, msg),
+		), numBrokenApps != 0
+	}
+}
+
+// getApplicationStakes returns the stakes of all applications.
+func (k Keeper) getApplicationStakes(ctx sdk.Context) (stakes []sharedhelpers.ActorStake) {
+	for _, app := range k.GetAllApplication(ctx) {
+		stakes = append(stakes, sharedhelpers.ActorStake{Address: app.Address, Stake: app.Stake})
+	}
+	return stakes
+}
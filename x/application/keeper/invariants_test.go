@@ -0,0 +1,131 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/pokt-network/poktroll/testutil/keeper"
+	"github.com/pokt-network/poktroll/testutil/sample"
+	"github.com/pokt-network/poktroll/x/application/keeper"
+	"github.com/pokt-network/poktroll/x/application/types"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+)
+
+func TestModuleAccountBalanceInvariant(t *testing.T) {
+	k, ctx := keepertest.ApplicationKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	stake := sdk.NewCoin("upokt", sdk.NewInt(100))
+	services := []*sharedtypes.ApplicationServiceConfig{
+		{Service: &sharedtypes.Service{Id: "svc1"}},
+	}
+
+	// The invariant holds for applications which are staked and unstaked by messages
+	for i := 0; i < 3; i++ {
+		_, err := srv.StakeApplication(wctx, types.NewMsgStakeApplication(sample.AccAddress(), stake, services))
+		require.NoError(t, err)
+	}
+	unstakedAddr := sample.AccAddress()
+	_, err := srv.StakeApplication(wctx, types.NewMsgStakeApplication(unstakedAddr, stake, services))
+	require.NoError(t, err)
+	_, err = srv.UnstakeApplication(wctx, types.NewMsgUnstakeApplication(unstakedAddr))
+	require.NoError(t, err)
+
+	_, isBroken := keeper.ModuleAccountBalanceInvariant(*k)(ctx)
+	require.False(t, isBroken)
+
+	// It is broken by a stake which wasn't sent to the module account
+	k.SetApplication(ctx, types.Application{
+		Address:        unstakedAddr,
+		Stake:          &stake,
+		ServiceConfigs: services,
+	})
+
+	_, isBroken = keeper.ModuleAccountBalanceInvariant(*k)(ctx)
+	require.True(t, isBroken)
+}
+
+func TestPositiveStakeInvariant(t *testing.T) {
+	k, ctx := keepertest.ApplicationKeeper(t)
+	services := []*sharedtypes.ApplicationServiceConfig{
+		{Service: &sharedtypes.Service{Id: "svc1"}},
+	}
+
+	stake := sdk.NewCoin("upokt", sdk.NewInt(100))
+	k.SetApplication(ctx, types.Application{
+		Address:        sample.AccAddress(),
+		Stake:          &stake,
+		ServiceConfigs: services,
+	})
+
+	_, isBroken := keeper.PositiveStakeInvariant(*k)(ctx)
+	require.False(t, isBroken)
+
+	// It is broken by an application with a zero stake
+	zeroStake := sdk.NewCoin("upokt", sdk.ZeroInt())
+	k.SetApplication(ctx, types.Application{
+		Address:        sample.AccAddress(),
+		Stake:          &zeroStake,
+		ServiceConfigs: services,
+	})
+
+	_, isBroken = keeper.PositiveStakeInvariant(*k)(ctx)
+	require.True(t, isBroken)
+}
+
+func TestDelegatedGatewaysInvariant(t *testing.T) {
+	k, ctx := keepertest.ApplicationKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	stake := sdk.NewCoin("upokt", sdk.NewInt(100))
+	services := []*sharedtypes.ApplicationServiceConfig{
+		{Service: &sharedtypes.Service{Id: "svc1"}},
+	}
+
+	// Mock the gateways being staked via the staked gateway map
+	maxDelegatedGateways := k.GetParams(ctx).MaxDelegatedGateways
+	gatewayAddrs := make([]string, maxDelegatedGateways+1)
+	for i := range gatewayAddrs {
+		gatewayAddrs[i] = sample.AccAddress()
+		keepertest.StakedGatewayMap[gatewayAddrs[i]] = struct{}{}
+	}
+	t.Cleanup(func() {
+		for _, gatewayAddr := range gatewayAddrs {
+			delete(keepertest.StakedGatewayMap, gatewayAddr)
+		}
+	})
+
+	// The invariant holds for an application delegated to the maximum number of
+	// gateways by messages
+	appAddr := sample.AccAddress()
+	_, err := srv.StakeApplication(wctx, types.NewMsgStakeApplication(appAddr, stake, services))
+	require.NoError(t, err)
+	for _, gatewayAddr := range gatewayAddrs[:maxDelegatedGateways] {
+		_, err = srv.DelegateToGateway(wctx, types.NewMsgDelegateToGateway(appAddr, gatewayAddr))
+		require.NoError(t, err)
+	}
+
+	_, isBroken := keeper.DelegatedGatewaysInvariant(*k)(ctx)
+	require.False(t, isBroken)
+
+	// It is broken by an application delegated to more than the maximum number
+	// of gateways
+	app, isAppFound := k.GetApplication(ctx, appAddr)
+	require.True(t, isAppFound)
+	app.DelegateeGatewayAddresses = gatewayAddrs
+	k.SetApplication(ctx, app)
+
+	_, isBroken = keeper.DelegatedGatewaysInvariant(*k)(ctx)
+	require.True(t, isBroken)
+
+	// It is broken by an application delegated to a gateway which is not staked
+	app.DelegateeGatewayAddresses = []string{sample.AccAddress()}
+	k.SetApplication(ctx, app)
+
+	_, isBroken = keeper.DelegatedGatewaysInvariant(*k)(ctx)
+	require.True(t, isBroken)
+}
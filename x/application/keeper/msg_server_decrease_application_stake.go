@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/pokt-network/poktroll/x/application/types"
+	sharedhelpers "github.com/pokt-network/poktroll/x/shared/helpers"
+)
+
+// DecreaseApplicationStake lowers the stake of an application without unstaking
+// it, returning the difference to the application.
+func (k msgServer) DecreaseApplicationStake(
+	goCtx context.Context,
+	msg *types.MsgDecreaseApplicationStake,
+) (*types.MsgDecreaseApplicationStakeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	logger := k.Logger(ctx).With("method", "DecreaseApplicationStake")
+	logger.Info("About to decrease application stake with msg: %v", msg)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	app, isAppFound := k.GetApplication(ctx, msg.Address)
+	if !isAppFound {
+		logger.Info("Application not found. Cannot decrease the stake of address %s", msg.Address)
+		return nil, types.ErrAppNotFound
+	}
+
+	if err := sharedhelpers.DecreaseStake(
+		ctx, k.bankKeeper, types.ModuleName, msg.Address,
+		*app.Stake, *msg.Stake, k.GetParams(ctx).ValidateStake, types.ErrAppInvalidStake,
+	); err != nil {
+		logger.Error("could not decrease the stake of application %s: %v", msg.Address, err)
+		return nil, err
+	}
+
+	// Update the Application in the store
+	app.Stake = msg.Stake
+	k.SetApplication(ctx, app)
+	logger.Info("Successfully decreased stake for application: %+v", app)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventApplicationStakeDecreased{
+		Application: app,
+	}); err != nil {
+		logger.Error("failed to emit EventApplicationStakeDecreased: %v", err)
+		return nil, err
+	}
+
+	return &types.MsgDecreaseApplicationStakeResponse{}, nil
+}
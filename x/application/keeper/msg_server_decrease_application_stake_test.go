@@ -0,0 +1,65 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/testutil/events"
+	keepertest "github.com/pokt-network/poktroll/testutil/keeper"
+	"github.com/pokt-network/poktroll/testutil/sample"
+	"github.com/pokt-network/poktroll/x/application/keeper"
+	"github.com/pokt-network/poktroll/x/application/types"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+)
+
+func TestMsgServer_DecreaseApplicationStake_Success(t *testing.T) {
+	k, ctx := keepertest.ApplicationKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	// Stake the application
+	addr := sample.AccAddress()
+	initialStake := sdk.NewCoin("upokt", sdk.NewInt(100))
+	_, err := srv.StakeApplication(wctx, &types.MsgStakeApplication{
+		Address: addr,
+		Stake:   &initialStake,
+		Services: []*sharedtypes.ApplicationServiceConfig{
+			{
+				Service: &sharedtypes.Service{Id: "svc1"},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	// Decrease the stake of the application
+	decreasedStake := sdk.NewCoin("upokt", sdk.NewInt(60))
+	_, err = srv.DecreaseApplicationStake(wctx, types.NewMsgDecreaseApplicationStake(addr, decreasedStake))
+	require.NoError(t, err)
+
+	// Verify that the application is still staked with the decreased stake
+	foundApp, isAppFound := k.GetApplication(ctx, addr)
+	require.True(t, isAppFound)
+	require.Equal(t, decreasedStake.Amount, foundApp.Stake.Amount)
+
+	// Verify that the stake decrease was emitted
+	stakeDecreasedEvents := events.FilterTypedEvents[*types.EventApplicationStakeDecreased](t, ctx.EventManager().Events())
+	require.Len(t, stakeDecreasedEvents, 1)
+	require.Equal(t, addr, stakeDecreasedEvents[0].Application.Address)
+	require.Equal(t, decreasedStake.Amount, stakeDecreasedEvents[0].Application.Stake.Amount)
+}
+
+func TestMsgServer_DecreaseApplicationStake_FailIfNotStaked(t *testing.T) {
+	k, ctx := keepertest.ApplicationKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	addr := sample.AccAddress()
+	decreasedStake := sdk.NewCoin("upokt", sdk.NewInt(60))
+	_, err := srv.DecreaseApplicationStake(wctx, types.NewMsgDecreaseApplicationStake(addr, decreasedStake))
+	require.ErrorIs(t, err, types.ErrAppNotFound)
+
+	_, isAppFound := k.GetApplication(ctx, addr)
+	require.False(t, isAppFound)
+}
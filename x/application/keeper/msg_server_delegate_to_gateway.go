@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/pokt-network/poktroll/x/application/types"
+	sharedhelpers "github.com/pokt-network/poktroll/x/shared/helpers"
 
 	sdkerrors "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -53,9 +54,21 @@ func (k msgServer) DelegateToGateway(goCtx context.Context, msg *types.MsgDelega
 	app.DelegateeGatewayAddresses = append(app.DelegateeGatewayAddresses, msg.GatewayAddress)
 	logger.Info("Successfully added delegatee public key to application")
 
+	// Record the delegation such that it only takes effect, i.e. the gateway is
+	// only added to the application's ring, from the start of the next session.
+	app.RecordDelegationChange(msg.GatewayAddress, false, sharedhelpers.GetSessionNumber(ctx.BlockHeight()))
+
 	// Update the application store with the new delegation
 	k.SetApplication(ctx, app)
 	logger.Info("Successfully delegated application to gateway for app: %+v", app)
 
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventDelegationChanged{
+		ApplicationAddress: app.Address,
+		DelegationChange:   app.DelegationChanges[len(app.DelegationChanges)-1],
+	}); err != nil {
+		logger.Error("failed to emit EventDelegationChanged: %v", err)
+		return nil, err
+	}
+
 	return &types.MsgDelegateToGatewayResponse{}, nil
 }
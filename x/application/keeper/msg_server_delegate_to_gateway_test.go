@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
+	"github.com/pokt-network/poktroll/testutil/events"
 	keepertest "github.com/pokt-network/poktroll/testutil/keeper"
 	"github.com/pokt-network/poktroll/testutil/sample"
 	"github.com/pokt-network/poktroll/x/application/keeper"
@@ -78,6 +79,15 @@ func TestMsgServer_DelegateToGateway_SuccessfullyDelegate(t *testing.T) {
 	require.Equal(t, 2, len(foundApp.DelegateeGatewayAddresses))
 	require.Equal(t, gatewayAddr1, foundApp.DelegateeGatewayAddresses[0])
 	require.Equal(t, gatewayAddr2, foundApp.DelegateeGatewayAddresses[1])
+
+	// Verify that both delegations were emitted
+	delegationChangedEvents := events.FilterTypedEvents[*types.EventDelegationChanged](t, ctx.EventManager().Events())
+	require.Len(t, delegationChangedEvents, 2)
+	for i, gatewayAddr := range []string{gatewayAddr1, gatewayAddr2} {
+		require.Equal(t, appAddr, delegationChangedEvents[i].ApplicationAddress)
+		require.Equal(t, gatewayAddr, delegationChangedEvents[i].DelegationChange.GatewayAddress)
+		require.False(t, delegationChangedEvents[i].DelegationChange.IsUndelegation)
+	}
 }
 
 func TestMsgServer_DelegateToGateway_FailDuplicate(t *testing.T) {
@@ -40,6 +40,10 @@ func (k msgServer) StakeApplication(
 		coinsToDelegate = (*msg.Stake).Sub(currAppStake)
 	}
 
+	if err = k.GetParams(ctx).ValidateStake(*app.Stake); err != nil {
+		return nil, err
+	}
+
 	// Retrieve the address of the application
 	appAddress, err := sdk.AccAddressFromBech32(msg.Address)
 	if err != nil {
@@ -59,6 +63,13 @@ func (k msgServer) StakeApplication(
 	k.SetApplication(ctx, app)
 	logger.Info("Successfully updated application stake for app: %+v", app)
 
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventApplicationStaked{
+		Application: app,
+	}); err != nil {
+		logger.Error("failed to emit EventApplicationStaked: %v", err)
+		return nil, err
+	}
+
 	return &types.MsgStakeApplicationResponse{}, nil
 }
 
@@ -71,6 +82,7 @@ func (k msgServer) createApplication(
 		Stake:                     msg.Stake,
 		ServiceConfigs:            msg.Services,
 		DelegateeGatewayAddresses: make([]string, 0),
+		DelegationChanges:         make([]types.DelegationChange, 0),
 	}
 }
 
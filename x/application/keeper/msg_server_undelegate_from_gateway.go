@@ -7,6 +7,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/pokt-network/poktroll/x/application/types"
+	sharedhelpers "github.com/pokt-network/poktroll/x/shared/helpers"
 )
 
 func (k msgServer) UndelegateFromGateway(goCtx context.Context, msg *types.MsgUndelegateFromGateway) (*types.MsgUndelegateFromGatewayResponse, error) {
@@ -43,9 +44,22 @@ func (k msgServer) UndelegateFromGateway(goCtx context.Context, msg *types.MsgUn
 	// Remove the gateway from the application's delegatee gateway public keys
 	app.DelegateeGatewayAddresses = append(app.DelegateeGatewayAddresses[:foundIdx], app.DelegateeGatewayAddresses[foundIdx+1:]...)
 
+	// Record the undelegation as pending such that the gateway remains in the
+	// application's ring until the start of the next session; i.e. relays which
+	// were signed with it in the current session remain valid.
+	app.RecordDelegationChange(msg.GatewayAddress, true, sharedhelpers.GetSessionNumber(ctx.BlockHeight()))
+
 	// Update the application store with the new delegation
 	k.SetApplication(ctx, app)
 	logger.Info("Successfully undelegated application from gateway for app: %+v", app)
 
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventDelegationChanged{
+		ApplicationAddress: app.Address,
+		DelegationChange:   app.DelegationChanges[len(app.DelegationChanges)-1],
+	}); err != nil {
+		logger.Error("failed to emit EventDelegationChanged: %v", err)
+		return nil, err
+	}
+
 	return &types.MsgUndelegateFromGatewayResponse{}, nil
 }
@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
+	"github.com/pokt-network/poktroll/testutil/events"
 	keepertest "github.com/pokt-network/poktroll/testutil/keeper"
 	"github.com/pokt-network/poktroll/testutil/sample"
 	"github.com/pokt-network/poktroll/x/application/keeper"
@@ -88,6 +89,14 @@ func TestMsgServer_UndelegateFromGateway_SuccessfullyUndelegate(t *testing.T) {
 	for i, gatewayAddr := range gatewayAddresses {
 		require.Equal(t, gatewayAddr, foundApp.DelegateeGatewayAddresses[i])
 	}
+
+	// Verify that the undelegation was emitted after the delegations
+	delegationChangedEvents := events.FilterTypedEvents[*types.EventDelegationChanged](t, ctx.EventManager().Events())
+	require.Len(t, delegationChangedEvents, int(maxDelegatedGateways)+1)
+	undelegationEvent := delegationChangedEvents[len(delegationChangedEvents)-1]
+	require.Equal(t, appAddr, undelegationEvent.ApplicationAddress)
+	require.Equal(t, undelegateMsg.GatewayAddress, undelegationEvent.DelegationChange.GatewayAddress)
+	require.True(t, undelegationEvent.DelegationChange.IsUndelegation)
 }
 
 func TestMsgServer_UndelegateFromGateway_FailNotDelegated(t *testing.T) {
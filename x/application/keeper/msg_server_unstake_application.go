@@ -37,7 +37,7 @@ func (k msgServer) UnstakeApplication(
 	// Send the coins from the application pool back to the application
 	err = k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.ModuleName, appAddress, []sdk.Coin{*app.Stake})
 	if err != nil {
-		logger.Error("could not send %v coins from %s module to %s account due to %v", app.Stake, appAddress, types.ModuleName, err)
+		logger.Error("could not send %v coins from %s module to %s account due to %v", app.Stake, types.ModuleName, appAddress, err)
 		return nil, err
 	}
 
@@ -45,5 +45,12 @@ func (k msgServer) UnstakeApplication(
 	k.RemoveApplication(ctx, appAddress.String())
 	logger.Info("Successfully removed the application: %+v", app)
 
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventApplicationUnstaked{
+		Application: app,
+	}); err != nil {
+		logger.Error("failed to emit EventApplicationUnstaked: %v", err)
+		return nil, err
+	}
+
 	return &types.MsgUnstakeApplicationResponse{}, nil
 }
@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
+	"github.com/pokt-network/poktroll/testutil/events"
 	keepertest "github.com/pokt-network/poktroll/testutil/keeper"
 	"github.com/pokt-network/poktroll/testutil/sample"
 	"github.com/pokt-network/poktroll/x/application/keeper"
@@ -56,6 +57,15 @@ func TestMsgServer_UnstakeApplication_Success(t *testing.T) {
 	// Make sure the app can no longer be found after unstaking
 	_, isAppFound = k.GetApplication(ctx, addr)
 	require.False(t, isAppFound)
+
+	// Verify that the stake and unstake were emitted
+	stakedEvents := events.FilterTypedEvents[*types.EventApplicationStaked](t, ctx.EventManager().Events())
+	require.Len(t, stakedEvents, 1)
+	require.Equal(t, addr, stakedEvents[0].Application.Address)
+	unstakedEvents := events.FilterTypedEvents[*types.EventApplicationUnstaked](t, ctx.EventManager().Events())
+	require.Len(t, unstakedEvents, 1)
+	require.Equal(t, addr, unstakedEvents[0].Application.Address)
+	require.Equal(t, initialStake.Amount, unstakedEvents[0].Application.Stake.Amount)
 }
 
 func TestMsgServer_UnstakeApplication_FailIfNotStaked(t *testing.T) {
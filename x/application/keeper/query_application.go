@@ -41,6 +41,70 @@ func (k Keeper) ApplicationAll(goCtx context.Context, req *types.QueryAllApplica
 	return &types.QueryAllApplicationResponse{Application: applications, Pagination: pageRes}, nil
 }
 
+func (k Keeper) ApplicationsByService(goCtx context.Context, req *types.QueryApplicationsByServiceRequest) (*types.QueryApplicationsByServiceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.ServiceId == "" {
+		return nil, status.Error(codes.InvalidArgument, "service id is required")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	applications, pageRes, err := k.paginateApplicationsByIndex(ctx, types.ApplicationServicePrefix, req.ServiceId, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryApplicationsByServiceResponse{Application: applications, Pagination: pageRes}, nil
+}
+
+func (k Keeper) ApplicationsByGateway(goCtx context.Context, req *types.QueryApplicationsByGatewayRequest) (*types.QueryApplicationsByGatewayResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.GatewayAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "gateway address is required")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	applications, pageRes, err := k.paginateApplicationsByIndex(ctx, types.ApplicationGatewayPrefix, req.GatewayAddress, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryApplicationsByGatewayResponse{Application: applications, Pagination: pageRes}, nil
+}
+
+// paginateApplicationsByIndex returns the page of applications indexed under
+// the given value in the index with the given prefix.
+func (k Keeper) paginateApplicationsByIndex(
+	ctx sdk.Context,
+	indexPrefix, indexValue string,
+	pageReq *query.PageRequest,
+) ([]types.Application, *query.PageResponse, error) {
+	var applications []types.Application
+
+	// The index points to the primary keys of the applications, which need to
+	// be retrieved before being decoded.
+	keyPrefix := types.KeyPrefix(indexPrefix)
+	keyPrefix = append(keyPrefix, types.ApplicationIndexKeyPrefix(indexValue)...)
+	storeIndex := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+
+	pageRes, err := query.Paginate(storeIndex, pageReq, func(key []byte, value []byte) error {
+		application, isAppFound := k.getApplicationByPrimaryKey(ctx, value)
+		if isAppFound {
+			applications = append(applications, application)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return applications, pageRes, nil
+}
+
 func (k Keeper) Application(goCtx context.Context, req *types.QueryGetApplicationRequest) (*types.QueryGetApplicationResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pokt-network/poktroll/x/application/types"
+	sharedhelpers "github.com/pokt-network/poktroll/x/shared/helpers"
+)
+
+// ApplicationRing returns the addresses which make up the ring of an application
+// as of the given block height; i.e. the application's address followed by the
+// (sorted) addresses of the gateways it was delegated to during the session of
+// that height. Since delegation changes only take effect at the start of the
+// next session, the ring of a session doesn't change once it has started.
+func (k Keeper) ApplicationRing(goCtx context.Context, req *types.QueryGetApplicationRingRequest) (*types.QueryGetApplicationRingResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// If block height is not specified, use the current (context's latest) block height
+	blockHeight := req.BlockHeight
+	if blockHeight == 0 {
+		blockHeight = ctx.BlockHeight()
+	}
+	if blockHeight > ctx.BlockHeight() {
+		return nil, sdkerrors.Wrapf(types.ErrAppInvalidBlockHeight, "block height %d is ahead of the current block height %d", blockHeight, ctx.BlockHeight())
+	}
+
+	// Only the delegation changes of recent sessions are retained.
+	sessionNumber := sharedhelpers.GetSessionNumber(blockHeight)
+	oldestSessionNumber := sharedhelpers.GetSessionNumber(ctx.BlockHeight()) - types.NumSessionsDelegationChangesRetained
+	if sessionNumber < oldestSessionNumber {
+		return nil, sdkerrors.Wrapf(types.ErrAppInvalidBlockHeight, "block height %d is prior to the oldest session %d whose ring is retained", blockHeight, oldestSessionNumber)
+	}
+
+	app, found := k.GetApplication(ctx, req.Address)
+	if !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("application not found: address %s", req.Address))
+	}
+
+	ringAddresses := []string{app.Address}
+	ringAddresses = append(ringAddresses, app.GetDelegateeGatewayAddressesAtSession(sessionNumber)...)
+
+	return &types.QueryGetApplicationRingResponse{RingAddresses: ringAddresses}, nil
+}
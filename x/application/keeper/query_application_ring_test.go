@@ -0,0 +1,94 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/pokt-network/poktroll/testutil/keeper"
+	"github.com/pokt-network/poktroll/testutil/sample"
+	"github.com/pokt-network/poktroll/x/application/keeper"
+	"github.com/pokt-network/poktroll/x/application/types"
+	sharedhelpers "github.com/pokt-network/poktroll/x/shared/helpers"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+)
+
+func TestApplicationRing_DelegationChangesTakeEffectAtSessionStart(t *testing.T) {
+	k, ctx := keepertest.ApplicationKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+
+	// Generate an address for the application and gateway
+	appAddr := sample.AccAddress()
+	gatewayAddr := sample.AccAddress()
+	// Mock the gateway being staked via the staked gateway map
+	keepertest.StakedGatewayMap[gatewayAddr] = struct{}{}
+	t.Cleanup(func() {
+		delete(keepertest.StakedGatewayMap, gatewayAddr)
+	})
+
+	// Stake the application and delegate it to the gateway in the middle of
+	// the first session.
+	delegationHeight := int64(sharedhelpers.NumBlocksPerSession + 1)
+	ctx = ctx.WithBlockHeight(delegationHeight)
+	_, err := srv.StakeApplication(sdk.WrapSDKContext(ctx), &types.MsgStakeApplication{
+		Address: appAddr,
+		Stake:   &sdk.Coin{Denom: "upokt", Amount: sdk.NewInt(100)},
+		Services: []*sharedtypes.ApplicationServiceConfig{
+			{
+				Service: &sharedtypes.Service{Id: "svc1"},
+			},
+		},
+	})
+	require.NoError(t, err)
+	_, err = srv.DelegateToGateway(sdk.WrapSDKContext(ctx), &types.MsgDelegateToGateway{
+		AppAddress:     appAddr,
+		GatewayAddress: gatewayAddr,
+	})
+	require.NoError(t, err)
+
+	// The gateway is not part of the ring for the rest of the session.
+	res, err := k.ApplicationRing(sdk.WrapSDKContext(ctx), types.NewQueryGetApplicationRingRequest(appAddr, 0))
+	require.NoError(t, err)
+	require.Equal(t, []string{appAddr}, res.RingAddresses)
+
+	// The gateway is part of the ring from the start of the next session.
+	nextSessionStartHeight := sharedhelpers.GetSessionStartBlockHeight(delegationHeight) + sharedhelpers.NumBlocksPerSession
+	ctx = ctx.WithBlockHeight(nextSessionStartHeight)
+	_, err = srv.UndelegateFromGateway(sdk.WrapSDKContext(ctx), &types.MsgUndelegateFromGateway{
+		AppAddress:     appAddr,
+		GatewayAddress: gatewayAddr,
+	})
+	require.NoError(t, err)
+
+	// The undelegation is pending until the start of the following session.
+	res, err = k.ApplicationRing(sdk.WrapSDKContext(ctx), types.NewQueryGetApplicationRingRequest(appAddr, nextSessionStartHeight))
+	require.NoError(t, err)
+	require.Equal(t, []string{appAddr, gatewayAddr}, res.RingAddresses)
+
+	// The ring of the previous session is unaffected by the delegation.
+	res, err = k.ApplicationRing(sdk.WrapSDKContext(ctx), types.NewQueryGetApplicationRingRequest(appAddr, delegationHeight))
+	require.NoError(t, err)
+	require.Equal(t, []string{appAddr}, res.RingAddresses)
+
+	ctx = ctx.WithBlockHeight(nextSessionStartHeight + sharedhelpers.NumBlocksPerSession)
+	res, err = k.ApplicationRing(sdk.WrapSDKContext(ctx), types.NewQueryGetApplicationRingRequest(appAddr, 0))
+	require.NoError(t, err)
+	require.Equal(t, []string{appAddr}, res.RingAddresses)
+}
+
+func TestApplicationRing_InvalidBlockHeight(t *testing.T) {
+	k, ctx := keepertest.ApplicationKeeper(t)
+	apps := createNApplication(k, ctx, 1)
+
+	currentHeight := int64(sharedhelpers.NumBlocksPerSession * (types.NumSessionsDelegationChangesRetained + 2))
+	wctx := sdk.WrapSDKContext(ctx.WithBlockHeight(currentHeight))
+
+	// Heights ahead of the current one are rejected.
+	_, err := k.ApplicationRing(wctx, types.NewQueryGetApplicationRingRequest(apps[0].Address, currentHeight+1))
+	require.ErrorIs(t, err, types.ErrAppInvalidBlockHeight)
+
+	// Heights whose delegation changes are no longer retained are rejected.
+	_, err = k.ApplicationRing(wctx, types.NewQueryGetApplicationRingRequest(apps[0].Address, 1))
+	require.ErrorIs(t, err, types.ErrAppInvalidBlockHeight)
+}
@@ -40,6 +40,10 @@ const (
 	// TODO: Determine the simulation weight value
 	defaultWeightMsgUndelegateFromGateway int = 100
 
+	opWeightMsgDecreaseApplicationStake = "op_weight_msg_decrease_application_stake"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgDecreaseApplicationStake int = 100
+
 	// this line is used by starport scaffolding # simapp/module/const
 )
 
@@ -98,7 +102,7 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 	)
 	operations = append(operations, simulation.NewWeightedOperation(
 		weightMsgDelegateToGateway,
-		applicationsimulation.SimulateMsgDelegateToGateway(am.accountKeeper, am.bankKeeper, am.keeper),
+		applicationsimulation.SimulateMsgDelegateToGateway(am.accountKeeper, am.bankKeeper, am.keeper, am.gatewayKeeper),
 	))
 
 	var weightMsgUndelegateFromGateway int
@@ -112,6 +116,17 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		applicationsimulation.SimulateMsgUndelegateFromGateway(am.accountKeeper, am.bankKeeper, am.keeper),
 	))
 
+	var weightMsgDecreaseApplicationStake int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgDecreaseApplicationStake, &weightMsgDecreaseApplicationStake, nil,
+		func(_ *rand.Rand) {
+			weightMsgDecreaseApplicationStake = defaultWeightMsgDecreaseApplicationStake
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgDecreaseApplicationStake,
+		applicationsimulation.SimulateMsgDecreaseApplicationStake(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
 	// this line is used by starport scaffolding # simapp/module/operation
 
 	return operations
@@ -140,7 +155,7 @@ func (am AppModule) ProposalMsgs(simState module.SimulationState) []simtypes.Wei
 			opWeightMsgDelegateToGateway,
 			defaultWeightMsgDelegateToGateway,
 			func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
-				applicationsimulation.SimulateMsgDelegateToGateway(am.accountKeeper, am.bankKeeper, am.keeper)
+				applicationsimulation.SimulateMsgDelegateToGateway(am.accountKeeper, am.bankKeeper, am.keeper, am.gatewayKeeper)
 				return nil
 			},
 		),
@@ -152,6 +167,14 @@ func (am AppModule) ProposalMsgs(simState module.SimulationState) []simtypes.Wei
 				return nil
 			},
 		),
+		simulation.NewWeightedProposalMsg(
+			opWeightMsgDecreaseApplicationStake,
+			defaultWeightMsgDecreaseApplicationStake,
+			func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
+				applicationsimulation.SimulateMsgDecreaseApplicationStake(am.accountKeeper, am.bankKeeper, am.keeper)
+				return nil
+			},
+		),
 		// this line is used by starport scaffolding # simapp/module/OpMsg
 	}
 }
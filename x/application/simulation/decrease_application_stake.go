@@ -0,0 +1,30 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/pokt-network/poktroll/x/application/keeper"
+	"github.com/pokt-network/poktroll/x/application/types"
+)
+
+func SimulateMsgDecreaseApplicationStake(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		msg := &types.MsgDecreaseApplicationStake{
+			Address: simAccount.Address.String(),
+		}
+
+		// TODO: Handling the DecreaseApplicationStake simulation
+
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "DecreaseApplicationStake simulation not implemented"), nil, nil
+	}
+}
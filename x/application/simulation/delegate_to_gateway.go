@@ -3,30 +3,72 @@ package simulation
 import (
 	"math/rand"
 
-	"github.com/pokt-network/poktroll/x/application/keeper"
-	"github.com/pokt-network/poktroll/x/application/types"
-
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/pokt-network/poktroll/x/application/keeper"
+	"github.com/pokt-network/poktroll/x/application/types"
 )
 
+// SimulateMsgDelegateToGateway delegates a random staked application to a
+// random staked gateway which it isn't delegated to yet.
 func SimulateMsgDelegateToGateway(
 	ak types.AccountKeeper,
 	bk types.BankKeeper,
 	k keeper.Keeper,
+	gk types.GatewayKeeper,
 ) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		simAppAccount, _ := simtypes.RandomAcc(r, accs)
-		simGatewayAccount, _ := simtypes.RandomAcc(r, accs)
-		msg := &types.MsgDelegateToGateway{
-			AppAddress:     simAppAccount.Address.String(),
-			GatewayAddress: simGatewayAccount.Address.String(),
+		applications := k.GetAllApplication(ctx)
+		if len(applications) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDelegateToGateway, "no staked applications"), nil, nil
+		}
+		application := applications[r.Intn(len(applications))]
+
+		if int64(len(application.DelegateeGatewayAddresses)) >= k.GetParams(ctx).MaxDelegatedGateways {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDelegateToGateway, "application delegated to the maximum number of gateways"), nil, nil
 		}
 
-		// TODO: Handling the DelegateToGateway simulation
+		simAppAccount, found := FindAccount(accs, application.Address)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDelegateToGateway, "application account not found"), nil, nil
+		}
+
+		delegateeGateways := make(map[string]struct{}, len(application.DelegateeGatewayAddresses))
+		for _, gatewayAddress := range application.DelegateeGatewayAddresses {
+			delegateeGateways[gatewayAddress] = struct{}{}
+		}
+		var gatewayAddresses []string
+		for _, gateway := range gk.GetAllGateway(ctx) {
+			if _, isDelegatee := delegateeGateways[gateway.Address]; !isDelegatee {
+				gatewayAddresses = append(gatewayAddresses, gateway.Address)
+			}
+		}
+		if len(gatewayAddresses) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDelegateToGateway, "no staked gateways to delegate to"), nil, nil
+		}
+
+		msg := types.NewMsgDelegateToGateway(application.Address, gatewayAddresses[r.Intn(len(gatewayAddresses))])
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           moduletestutil.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         types.TypeMsgDelegateToGateway,
+			Context:         ctx,
+			SimAccount:      simAppAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
 
-		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "DelegateToGateway simulation not implemented"), nil, nil
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
 	}
 }
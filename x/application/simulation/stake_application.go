@@ -5,13 +5,19 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
 
 	"github.com/pokt-network/poktroll/x/application/keeper"
 	"github.com/pokt-network/poktroll/x/application/types"
+	sharedsimulation "github.com/pokt-network/poktroll/x/shared/simulation"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
 )
 
-// TODO(@Olshansk): Implement simulation for application staking
+// SimulateMsgStakeApplication stakes a random account as an application for a
+// random set of the simulation services, or increases its stake and replaces
+// its services if it is already staked.
 func SimulateMsgStakeApplication(
 	ak types.AccountKeeper,
 	bk types.BankKeeper,
@@ -20,13 +26,47 @@ func SimulateMsgStakeApplication(
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
 		simAccount, _ := simtypes.RandomAcc(r, accs)
-		stakeMsg := &types.MsgStakeApplication{
-			Address: simAccount.Address.String(),
+		appAddress := simAccount.Address.String()
+
+		var currStake *sdk.Coin
+		if application, isAppFound := k.GetApplication(ctx, appAddress); isAppFound {
+			currStake = application.Stake
+		}
+
+		spendable := bk.SpendableCoins(ctx, simAccount.Address)
+		stake, ok := sharedsimulation.RandomStake(r, spendable, currStake, k.GetParams(ctx).MinStake)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgStakeApplication, "insufficient funds to stake application"), nil, nil
+		}
+
+		var serviceConfigs []*sharedtypes.ApplicationServiceConfig
+		for _, i := range r.Perm(len(sharedsimulation.Services))[:1+r.Intn(len(sharedsimulation.Services))] {
+			serviceConfigs = append(serviceConfigs, &sharedtypes.ApplicationServiceConfig{
+				Service: sharedsimulation.Services[i],
+			})
+		}
+
+		stakeMsg := types.NewMsgStakeApplication(appAddress, stake, serviceConfigs)
+		coinsToDelegate := sdk.NewCoins(stake)
+		if currStake != nil {
+			coinsToDelegate = coinsToDelegate.Sub(*currStake)
 		}
 
-		// TODO: Handling the StakeApplication simulation
-		// See the documentation here to simulate application staking: https://docs.cosmos.network/main/learn/advanced/simulation
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           moduletestutil.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             stakeMsg,
+			MsgType:         types.TypeMsgStakeApplication,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: coinsToDelegate,
+		}
 
-		return simtypes.NoOpMsg(types.ModuleName, stakeMsg.Type(), "StakeApplication simulation not implemented"), nil, nil
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
 	}
 }
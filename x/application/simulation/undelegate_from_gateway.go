@@ -3,14 +3,18 @@ package simulation
 import (
 	"math/rand"
 
-	"github.com/pokt-network/poktroll/x/application/keeper"
-	"github.com/pokt-network/poktroll/x/application/types"
-
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/pokt-network/poktroll/x/application/keeper"
+	"github.com/pokt-network/poktroll/x/application/types"
 )
 
+// SimulateMsgUndelegateFromGateway undelegates a random staked application
+// from one of the gateways which it is delegated to.
 func SimulateMsgUndelegateFromGateway(
 	ak types.AccountKeeper,
 	bk types.BankKeeper,
@@ -18,15 +22,40 @@ func SimulateMsgUndelegateFromGateway(
 ) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		simAppAccount, _ := simtypes.RandomAcc(r, accs)
-		simGatewayAccount, _ := simtypes.RandomAcc(r, accs)
-		msg := &types.MsgUndelegateFromGateway{
-			AppAddress:     simAppAccount.Address.String(),
-			GatewayAddress: simGatewayAccount.Address.String(),
+		var delegatingApplications []types.Application
+		for _, application := range k.GetAllApplication(ctx) {
+			if len(application.DelegateeGatewayAddresses) > 0 {
+				delegatingApplications = append(delegatingApplications, application)
+			}
+		}
+		if len(delegatingApplications) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUndelegateFromGateway, "no applications delegated to gateways"), nil, nil
+		}
+		application := delegatingApplications[r.Intn(len(delegatingApplications))]
+
+		simAppAccount, found := FindAccount(accs, application.Address)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUndelegateFromGateway, "application account not found"), nil, nil
 		}
 
-		// TODO: Handling the UndelegateFromGateway simulation
+		gatewayAddress := application.DelegateeGatewayAddresses[r.Intn(len(application.DelegateeGatewayAddresses))]
+		msg := types.NewMsgUndelegateFromGateway(application.Address, gatewayAddress)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           moduletestutil.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         types.TypeMsgUndelegateFromGateway,
+			Context:         ctx,
+			SimAccount:      simAppAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
 
-		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "UndelegateFromGateway simulation not implemented"), nil, nil
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
 	}
 }
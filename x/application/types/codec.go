@@ -12,6 +12,7 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUnstakeApplication{}, "application/UnstakeApplication", nil)
 	cdc.RegisterConcrete(&MsgDelegateToGateway{}, "application/DelegateToGateway", nil)
 	cdc.RegisterConcrete(&MsgUndelegateFromGateway{}, "application/UndelegateFromGateway", nil)
+	cdc.RegisterConcrete(&MsgDecreaseApplicationStake{}, "application/DecreaseApplicationStake", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -26,6 +27,9 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUndelegateFromGateway{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgDecreaseApplicationStake{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
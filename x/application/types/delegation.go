@@ -0,0 +1,81 @@
+package types
+
+import (
+	"sort"
+)
+
+// TODO_BLOCKER: Derive this from the claim and proof windows once they are
+// governance params, such that the rings which proofs are validated against are
+// always available.
+// NumSessionsDelegationChangesRetained is the number of sessions, prior to the
+// current one, for which the delegation changes of an application are retained
+// and, therefore, for which its ring can be determined.
+const NumSessionsDelegationChangesRetained = 4
+
+// RecordDelegationChange records the delegation of the application to, or its
+// undelegation from, the given gateway, made in the given session, such that it
+// takes effect at the start of the next session. It also prunes the changes
+// which are no longer needed to determine the application's ring.
+// NB: The caller is responsible for updating DelegateeGatewayAddresses.
+func (app *Application) RecordDelegationChange(
+	gatewayAddress string,
+	isUndelegation bool,
+	currentSessionNumber int64,
+) {
+	app.PruneDelegationChanges(currentSessionNumber)
+	app.DelegationChanges = append(app.DelegationChanges, DelegationChange{
+		GatewayAddress:         gatewayAddress,
+		IsUndelegation:         isUndelegation,
+		EffectiveSessionNumber: currentSessionNumber + 1,
+	})
+}
+
+// PruneDelegationChanges removes the delegation changes which took effect at or
+// before the oldest session for which the application's ring can be determined,
+// as of the given current session. They are reflected in the delegations of all
+// the sessions since.
+func (app *Application) PruneDelegationChanges(currentSessionNumber int64) {
+	oldestSessionNumber := currentSessionNumber - NumSessionsDelegationChangesRetained
+
+	retainedChanges := make([]DelegationChange, 0, len(app.DelegationChanges))
+	for _, change := range app.DelegationChanges {
+		if change.EffectiveSessionNumber > oldestSessionNumber {
+			retainedChanges = append(retainedChanges, change)
+		}
+	}
+	app.DelegationChanges = retainedChanges
+}
+
+// GetDelegateeGatewayAddressesAtSession returns the addresses of the gateways
+// which the application was delegated to during the given session, sorted such
+// that they are the same regardless of the order the changes were made in.
+// It does so by reverting, from the latest delegations, the changes which took
+// effect after the given session.
+func (app *Application) GetDelegateeGatewayAddressesAtSession(sessionNumber int64) []string {
+	delegatees := make(map[string]struct{}, len(app.DelegateeGatewayAddresses))
+	for _, gatewayAddress := range app.DelegateeGatewayAddresses {
+		delegatees[gatewayAddress] = struct{}{}
+	}
+
+	// Revert the changes in the reverse order they were made in.
+	for i := len(app.DelegationChanges) - 1; i >= 0; i-- {
+		change := app.DelegationChanges[i]
+		if change.EffectiveSessionNumber <= sessionNumber {
+			continue
+		}
+
+		if change.IsUndelegation {
+			delegatees[change.GatewayAddress] = struct{}{}
+		} else {
+			delete(delegatees, change.GatewayAddress)
+		}
+	}
+
+	gatewayAddresses := make([]string, 0, len(delegatees))
+	for gatewayAddress := range delegatees {
+		gatewayAddresses = append(gatewayAddresses, gatewayAddress)
+	}
+	sort.Strings(gatewayAddresses)
+
+	return gatewayAddresses
+}
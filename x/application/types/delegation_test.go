@@ -0,0 +1,61 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/testutil/sample"
+	"github.com/pokt-network/poktroll/x/application/types"
+)
+
+func TestApplication_GetDelegateeGatewayAddressesAtSession(t *testing.T) {
+	gatewayAddr1 := sample.AccAddress()
+	gatewayAddr2 := sample.AccAddress()
+
+	app := &types.Application{
+		Address:                   sample.AccAddress(),
+		DelegateeGatewayAddresses: []string{},
+	}
+
+	// Delegate to both gateways in session 1.
+	app.DelegateeGatewayAddresses = append(app.DelegateeGatewayAddresses, gatewayAddr1)
+	app.RecordDelegationChange(gatewayAddr1, false, 1)
+	app.DelegateeGatewayAddresses = append(app.DelegateeGatewayAddresses, gatewayAddr2)
+	app.RecordDelegationChange(gatewayAddr2, false, 1)
+
+	// Undelegate from the first gateway in session 2.
+	app.DelegateeGatewayAddresses = []string{gatewayAddr2}
+	app.RecordDelegationChange(gatewayAddr1, true, 2)
+
+	// The delegations only take effect from session 2 onwards.
+	require.Empty(t, app.GetDelegateeGatewayAddressesAtSession(1))
+	require.ElementsMatch(t,
+		[]string{gatewayAddr1, gatewayAddr2},
+		app.GetDelegateeGatewayAddressesAtSession(2),
+	)
+	// The undelegation is pending until session 3.
+	require.Equal(t, []string{gatewayAddr2}, app.GetDelegateeGatewayAddressesAtSession(3))
+}
+
+func TestApplication_PruneDelegationChanges(t *testing.T) {
+	gatewayAddr := sample.AccAddress()
+
+	app := &types.Application{
+		Address:                   sample.AccAddress(),
+		DelegateeGatewayAddresses: []string{gatewayAddr},
+	}
+	app.RecordDelegationChange(gatewayAddr, false, 1)
+	require.Len(t, app.DelegationChanges, 1)
+
+	// The change is retained as long as the ring prior to it can be requested.
+	app.PruneDelegationChanges(1 + types.NumSessionsDelegationChangesRetained)
+	require.Len(t, app.DelegationChanges, 1)
+
+	app.PruneDelegationChanges(2 + types.NumSessionsDelegationChangesRetained)
+	require.Empty(t, app.DelegationChanges)
+	require.Equal(t,
+		[]string{gatewayAddr},
+		app.GetDelegateeGatewayAddressesAtSession(2+types.NumSessionsDelegationChangesRetained),
+	)
+}
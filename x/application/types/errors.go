@@ -19,4 +19,6 @@ var (
 	ErrAppMaxDelegatedGateways        = sdkerrors.Register(ModuleName, 10, "maximum number of delegated gateways reached")
 	ErrAppInvalidMaxDelegatedGateways = sdkerrors.Register(ModuleName, 11, "invalid MaxDelegatedGateways parameter")
 	ErrAppNotDelegated                = sdkerrors.Register(ModuleName, 12, "application not delegated to gateway")
+	ErrAppInvalidBlockHeight          = sdkerrors.Register(ModuleName, 13, "invalid block height")
+	ErrAppInvalidMinStake             = sdkerrors.Register(ModuleName, 14, "invalid MinStake parameter")
 )
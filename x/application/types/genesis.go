@@ -62,6 +62,13 @@ func (gs GenesisState) Validate() error {
 			}
 		}
 
+		// Check that the gateway addresses of the application's delegation changes are valid
+		for _, change := range app.DelegationChanges {
+			if _, err := sdk.AccAddressFromBech32(change.GatewayAddress); err != nil {
+				return sdkerrors.Wrapf(ErrAppInvalidGatewayAddress, "invalid delegation change gateway address %s; (%v)", change.GatewayAddress, err)
+			}
+		}
+
 		// Validate the application service configs
 		if err := servicehelpers.ValidateAppServiceConfigs(app.ServiceConfigs); err != nil {
 			return sdkerrors.Wrapf(ErrAppInvalidServiceConfigs, err.Error())
@@ -7,6 +7,12 @@ var _ binary.ByteOrder
 const (
 	// ApplicationKeyPrefix is the prefix to retrieve all Application
 	ApplicationKeyPrefix = "Application/value/"
+
+	// ApplicationServicePrefix is the prefix to retrieve an Application's key from the Service index
+	ApplicationServicePrefix = "Application/service/"
+
+	// ApplicationGatewayPrefix is the prefix to retrieve an Application's key from the delegatee Gateway index
+	ApplicationGatewayPrefix = "Application/gateway/"
 )
 
 // ApplicationKey returns the store key to retrieve a Application from the index fields
@@ -21,3 +27,23 @@ func ApplicationKey(
 
 	return key
 }
+
+// ApplicationIndexKeyPrefix returns the key prefix to iterate through the
+// applications indexed under the given service ID or gateway address.
+func ApplicationIndexKeyPrefix(indexValue string) []byte {
+	var key []byte
+
+	key = append(key, []byte(indexValue)...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// ApplicationIndexKey returns the key indexing the application with the given
+// primary key under the given service ID or gateway address.
+func ApplicationIndexKey(indexValue string, primaryKey []byte) []byte {
+	key := ApplicationIndexKeyPrefix(indexValue)
+	key = append(key, primaryKey...)
+
+	return key
+}
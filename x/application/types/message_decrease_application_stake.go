@@ -0,0 +1,65 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgDecreaseApplicationStake = "decrease_application_stake"
+
+var _ sdk.Msg = (*MsgDecreaseApplicationStake)(nil)
+
+func NewMsgDecreaseApplicationStake(address string, stake sdk.Coin) *MsgDecreaseApplicationStake {
+	return &MsgDecreaseApplicationStake{
+		Address: address,
+		Stake:   &stake,
+	}
+}
+
+func (msg *MsgDecreaseApplicationStake) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgDecreaseApplicationStake) Type() string {
+	return TypeMsgDecreaseApplicationStake
+}
+
+func (msg *MsgDecreaseApplicationStake) GetSigners() []sdk.AccAddress {
+	address, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{address}
+}
+
+func (msg *MsgDecreaseApplicationStake) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgDecreaseApplicationStake) ValidateBasic() error {
+	// Validate the address
+	_, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrAppInvalidAddress, "invalid application address %s; (%v)", msg.Address, err)
+	}
+
+	// Validate the stake amount which remains staked
+	if msg.Stake == nil {
+		return sdkerrors.Wrapf(ErrAppInvalidStake, "nil application stake")
+	}
+	stake, err := sdk.ParseCoinNormalized(msg.Stake.String())
+	if err != nil {
+		return sdkerrors.Wrapf(ErrAppInvalidStake, "cannot parse application stake %v; (%v)", msg.Stake, err)
+	}
+	if !stake.IsValid() {
+		return sdkerrors.Wrapf(ErrAppInvalidStake, "invalid application stake %v; (%v)", msg.Stake, stake.Validate())
+	}
+	if stake.IsZero() || stake.IsNegative() {
+		return sdkerrors.Wrapf(ErrAppInvalidStake, "invalid stake amount for application: %v <= 0; use unstake to withdraw the whole stake", msg.Stake)
+	}
+	if stake.Denom != "upokt" {
+		return sdkerrors.Wrapf(ErrAppInvalidStake, "invalid stake amount denom for application %v", msg.Stake)
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/testutil/sample"
+)
+
+func TestMsgDecreaseApplicationStake_ValidateBasic(t *testing.T) {
+	coins := sdk.NewCoin("upokt", sdk.NewInt(100))
+	tests := []struct {
+		name string
+		msg  MsgDecreaseApplicationStake
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgDecreaseApplicationStake{
+				Address: "invalid_address",
+				Stake:   &coins,
+			},
+			err: ErrAppInvalidAddress,
+		}, {
+			name: "valid address - nil stake",
+			msg: MsgDecreaseApplicationStake{
+				Address: sample.AccAddress(),
+				// Stake explicitly nil
+			},
+			err: ErrAppInvalidStake,
+		}, {
+			name: "valid address - zero stake",
+			msg: MsgDecreaseApplicationStake{
+				Address: sample.AccAddress(),
+				Stake:   &sdk.Coin{Denom: "upokt", Amount: sdk.NewInt(0)},
+			},
+			err: ErrAppInvalidStake,
+		}, {
+			name: "valid address - invalid stake denom",
+			msg: MsgDecreaseApplicationStake{
+				Address: sample.AccAddress(),
+				Stake:   &sdk.Coin{Denom: "invalid", Amount: sdk.NewInt(100)},
+			},
+			err: ErrAppInvalidStake,
+		}, {
+			name: "valid address - valid stake",
+			msg: MsgDecreaseApplicationStake{
+				Address: sample.AccAddress(),
+				Stake:   &coins,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
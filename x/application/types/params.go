@@ -1,16 +1,29 @@
 package types
 
 import (
+	"fmt"
+
 	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"gopkg.in/yaml.v2"
+
+	sharedhelpers "github.com/pokt-network/poktroll/x/shared/helpers"
 )
 
 // TODO: Revisit default param values
 const DefaultMaxDelegatedGateways int64 = 7
 
+// TODO: Revisit default param values
+var DefaultMinStake = sdk.NewCoin("upokt", sdk.NewInt(1))
+
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+var (
+	KeyMaxDelegatedGateways = []byte("MaxDelegatedGateways")
+	KeyMinStake             = []byte("MinStake")
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
@@ -18,7 +31,11 @@ func ParamKeyTable() paramtypes.KeyTable {
 
 // NewParams creates a new Params instance
 func NewParams() Params {
-	return Params{MaxDelegatedGateways: DefaultMaxDelegatedGateways}
+	minStake := DefaultMinStake
+	return Params{
+		MaxDelegatedGateways: DefaultMaxDelegatedGateways,
+		MinStake:             &minStake,
+	}
 }
 
 // DefaultParams returns a default set of parameters
@@ -28,13 +45,25 @@ func DefaultParams() Params {
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMaxDelegatedGateways, &p.MaxDelegatedGateways, validateMaxDelegatedGateways),
+		paramtypes.NewParamSetPair(KeyMinStake, &p.MinStake, validateMinStake),
+	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
-	if p.MaxDelegatedGateways < 1 {
-		return sdkerrors.Wrapf(ErrAppInvalidMaxDelegatedGateways, "MaxDelegatedGateways param < 1: got %d", p.MaxDelegatedGateways)
+	if err := validateMaxDelegatedGateways(p.MaxDelegatedGateways); err != nil {
+		return err
+	}
+	return validateMinStake(p.MinStake)
+}
+
+// ValidateStake returns an error if the given stake is lower than the MinStake
+// param. There is no minimum, other than a positive stake, if it isn't set.
+func (p Params) ValidateStake(stake sdk.Coin) error {
+	if p.MinStake != nil && stake.IsLT(*p.MinStake) {
+		return sdkerrors.Wrapf(ErrAppInvalidStake, "stake amount %v must be at least the minimum stake %v", stake, p.MinStake)
 	}
 	return nil
 }
@@ -44,3 +73,21 @@ func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
 	return string(out)
 }
+
+func validateMaxDelegatedGateways(i interface{}) error {
+	maxDelegatedGateways, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if maxDelegatedGateways < 1 {
+		return sdkerrors.Wrapf(ErrAppInvalidMaxDelegatedGateways, "MaxDelegatedGateways param < 1: got %d", maxDelegatedGateways)
+	}
+	return nil
+}
+
+func validateMinStake(i interface{}) error {
+	if err := sharedhelpers.ValidateMinStake(i); err != nil {
+		return ErrAppInvalidMinStake.Wrap(err.Error())
+	}
+	return nil
+}
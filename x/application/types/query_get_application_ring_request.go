@@ -0,0 +1,28 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NOTE: `QueryGetApplicationRingRequest` is not a `sdk.Msg`, but following a similar
+// `ValidateBasic` pattern allows us to localize & reuse validation logic.
+func NewQueryGetApplicationRingRequest(appAddress string, blockHeight int64) *QueryGetApplicationRingRequest {
+	return &QueryGetApplicationRingRequest{
+		Address:     appAddress,
+		BlockHeight: blockHeight,
+	}
+}
+
+func (query *QueryGetApplicationRingRequest) ValidateBasic() error {
+	// Validate the application address
+	if _, err := sdk.AccAddressFromBech32(query.Address); err != nil {
+		return sdkerrors.Wrapf(ErrAppInvalidAddress, "invalid app address for ring being retrieved %s; (%v)", query.Address, err)
+	}
+
+	// Validate the height as of which the ring is being retrieved
+	if query.BlockHeight < 0 { // Note that `0` defaults to the latest height rather than genesis
+		return sdkerrors.Wrapf(ErrAppInvalidBlockHeight, "invalid block height for ring being retrieved %d;", query.BlockHeight)
+	}
+	return nil
+}
@@ -30,6 +30,7 @@ func GetTxCmd() *cobra.Command {
 
 	cmd.AddCommand(CmdStakeGateway())
 	cmd.AddCommand(CmdUnstakeGateway())
+	cmd.AddCommand(CmdDecreaseGatewayStake())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -0,0 +1,48 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/pokt-network/poktroll/x/gateway/types"
+)
+
+func CmdDecreaseGatewayStake() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "decrease-gateway-stake <upokt_amount>",
+		Short: "Decrease the stake of a gateway",
+		Long: `Decrease the stake of a gateway to the provided amount without unstaking it. This is a broadcast operation
+that will return the tokens in excess of the provided amount to the gateway specified by the 'from' address.
+The provided amount must be lower than the current stake and at least the minimum stake.
+
+Example:
+$ poktrolld --home=$(POKTROLLD_HOME) tx gateway decrease-gateway-stake 500upokt --keyring-backend test --from $(GATEWAY) --node $(POCKET_NODE)`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			stakeString := args[0]
+			stake, err := sdk.ParseCoinNormalized(stakeString)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgDecreaseGatewayStake(
+				clientCtx.GetFromAddress().String(),
+				stake,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/pokt-network/poktroll/x/gateway/types"
+	sharedhelpers "github.com/pokt-network/poktroll/x/shared/helpers"
+)
+
+// RegisterInvariants registers the gateway module invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "module-account-balance", ModuleAccountBalanceInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "positive-stake", PositiveStakeInvariant(k))
+}
+
+// ModuleAccountBalanceInvariant checks that the balance of the gateway module
+// account is the sum of the stakes of all gateways.
+func ModuleAccountBalanceInvariant(k Keeper) sdk.Invariant {
+	return sharedhelpers.ModuleAccountBalanceInvariant(types.ModuleName, "gateway", k.bankKeeper, k.getGatewayStakes)
+}
+
+// PositiveStakeInvariant checks that every gateway has a positive stake.
+func PositiveStakeInvariant(k Keeper) sdk.Invariant {
+	return sharedhelpers.PositiveStakeInvariant(types.ModuleName, "gateway", k.getGatewayStakes)
+}
+
+// getGatewayStakes returns the stakes of all gateways.
+func (k Keeper) getGatewayStakes(ctx sdk.Context) (stakes []sharedhelpers.ActorStake) {
+	for _, gateway := range k.GetAllGateway(ctx) {
+		stakes = append(stakes, sharedhelpers.ActorStake{Address: gateway.Address, Stake: gateway.Stake})
+	}
+	return stakes
+}
@@ -0,0 +1,67 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/pokt-network/poktroll/testutil/keeper"
+	"github.com/pokt-network/poktroll/testutil/sample"
+	"github.com/pokt-network/poktroll/x/gateway/keeper"
+	"github.com/pokt-network/poktroll/x/gateway/types"
+)
+
+func TestModuleAccountBalanceInvariant(t *testing.T) {
+	k, ctx := keepertest.GatewayKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	stake := sdk.NewCoin("upokt", sdk.NewInt(100))
+
+	// The invariant holds for gateways which are staked and unstaked by messages
+	for i := 0; i < 3; i++ {
+		_, err := srv.StakeGateway(wctx, types.NewMsgStakeGateway(sample.AccAddress(), stake))
+		require.NoError(t, err)
+	}
+	unstakedAddr := sample.AccAddress()
+	_, err := srv.StakeGateway(wctx, types.NewMsgStakeGateway(unstakedAddr, stake))
+	require.NoError(t, err)
+	_, err = srv.UnstakeGateway(wctx, types.NewMsgUnstakeGateway(unstakedAddr))
+	require.NoError(t, err)
+
+	_, isBroken := keeper.ModuleAccountBalanceInvariant(*k)(ctx)
+	require.False(t, isBroken)
+
+	// It is broken by a stake which wasn't sent to the module account
+	k.SetGateway(ctx, types.Gateway{
+		Address: unstakedAddr,
+		Stake:   &stake,
+	})
+
+	_, isBroken = keeper.ModuleAccountBalanceInvariant(*k)(ctx)
+	require.True(t, isBroken)
+}
+
+func TestPositiveStakeInvariant(t *testing.T) {
+	k, ctx := keepertest.GatewayKeeper(t)
+
+	stake := sdk.NewCoin("upokt", sdk.NewInt(100))
+	k.SetGateway(ctx, types.Gateway{
+		Address: sample.AccAddress(),
+		Stake:   &stake,
+	})
+
+	_, isBroken := keeper.PositiveStakeInvariant(*k)(ctx)
+	require.False(t, isBroken)
+
+	// It is broken by a gateway with a zero stake
+	zeroStake := sdk.NewCoin("upokt", sdk.ZeroInt())
+	k.SetGateway(ctx, types.Gateway{
+		Address: sample.AccAddress(),
+		Stake:   &zeroStake,
+	})
+
+	_, isBroken = keeper.PositiveStakeInvariant(*k)(ctx)
+	require.True(t, isBroken)
+}
@@ -20,6 +20,8 @@ type (
 		paramstore paramtypes.Subspace
 
 		bankKeeper types.BankKeeper
+
+		hooks types.GatewayHooks
 	}
 )
 
@@ -49,3 +51,14 @@ func NewKeeper(
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
+
+// SetHooks sets the hooks which are called on the gateway lifecycle events. It
+// must be called once, before the keeper is copied into the gateway module.
+func (k *Keeper) SetHooks(gh types.GatewayHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set gateway hooks twice")
+	}
+
+	k.hooks = gh
+	return k
+}
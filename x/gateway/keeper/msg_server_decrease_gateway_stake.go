@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/pokt-network/poktroll/x/gateway/types"
+	sharedhelpers "github.com/pokt-network/poktroll/x/shared/helpers"
+)
+
+// DecreaseGatewayStake handles partial unstakes of gateways: the gateway remains
+// staked with the lower stake of the message.
+func (k msgServer) DecreaseGatewayStake(
+	goCtx context.Context,
+	msg *types.MsgDecreaseGatewayStake,
+) (*types.MsgDecreaseGatewayStakeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	logger := k.Logger(ctx).With("method", "DecreaseGatewayStake")
+	logger.Info("About to decrease gateway stake with msg: %v", msg)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	gateway, isGatewayFound := k.GetGateway(ctx, msg.Address)
+	if !isGatewayFound {
+		logger.Info("Gateway not found. Cannot decrease the stake of address %s", msg.Address)
+		return nil, types.ErrGatewayNotFound
+	}
+
+	if err := sharedhelpers.DecreaseStake(
+		ctx, k.bankKeeper, types.ModuleName, msg.Address,
+		*gateway.Stake, *msg.Stake, k.GetParams(ctx).ValidateStake, types.ErrGatewayInvalidStake,
+	); err != nil {
+		logger.Error("could not decrease the stake of gateway %s: %v", msg.Address, err)
+		return nil, err
+	}
+
+	// Update the Gateway in the store
+	gateway.Stake = msg.Stake
+	k.SetGateway(ctx, gateway)
+	logger.Info("Successfully decreased stake for gateway: %+v", gateway)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventGatewayStakeDecreased{
+		Gateway: gateway,
+	}); err != nil {
+		logger.Error("failed to emit EventGatewayStakeDecreased: %v", err)
+		return nil, err
+	}
+
+	return &types.MsgDecreaseGatewayStakeResponse{}, nil
+}
@@ -0,0 +1,56 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/testutil/events"
+	keepertest "github.com/pokt-network/poktroll/testutil/keeper"
+	"github.com/pokt-network/poktroll/testutil/sample"
+	"github.com/pokt-network/poktroll/x/gateway/keeper"
+	"github.com/pokt-network/poktroll/x/gateway/types"
+)
+
+func TestMsgServer_DecreaseGatewayStake_Success(t *testing.T) {
+	k, ctx := keepertest.GatewayKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	// Stake the gateway
+	addr := sample.AccAddress()
+	initialStake := sdk.NewCoin("upokt", sdk.NewInt(100))
+	_, err := srv.StakeGateway(wctx, types.NewMsgStakeGateway(addr, initialStake))
+	require.NoError(t, err)
+
+	// Decrease the stake of the gateway
+	decreasedStake := sdk.NewCoin("upokt", sdk.NewInt(60))
+	_, err = srv.DecreaseGatewayStake(wctx, types.NewMsgDecreaseGatewayStake(addr, decreasedStake))
+	require.NoError(t, err)
+
+	// Verify that the gateway is still staked with the decreased stake
+	foundGateway, isGatewayFound := k.GetGateway(ctx, addr)
+	require.True(t, isGatewayFound)
+	require.Equal(t, decreasedStake.Amount, foundGateway.Stake.Amount)
+
+	// Verify that the stake decrease was emitted
+	stakeDecreasedEvents := events.FilterTypedEvents[*types.EventGatewayStakeDecreased](t, ctx.EventManager().Events())
+	require.Len(t, stakeDecreasedEvents, 1)
+	require.Equal(t, addr, stakeDecreasedEvents[0].Gateway.Address)
+	require.Equal(t, decreasedStake.Amount, stakeDecreasedEvents[0].Gateway.Stake.Amount)
+}
+
+func TestMsgServer_DecreaseGatewayStake_FailIfNotStaked(t *testing.T) {
+	k, ctx := keepertest.GatewayKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	addr := sample.AccAddress()
+	decreasedStake := sdk.NewCoin("upokt", sdk.NewInt(60))
+	_, err := srv.DecreaseGatewayStake(wctx, types.NewMsgDecreaseGatewayStake(addr, decreasedStake))
+	require.ErrorIs(t, err, types.ErrGatewayNotFound)
+
+	_, isGatewayFound := k.GetGateway(ctx, addr)
+	require.False(t, isGatewayFound)
+}
@@ -39,6 +39,10 @@ func (k msgServer) StakeGateway(
 		coinsToDelegate = (*msg.Stake).Sub(currGatewayStake)
 	}
 
+	if err = k.GetParams(ctx).ValidateStake(*gateway.Stake); err != nil {
+		return nil, err
+	}
+
 	// Retrieve the address of the gateway
 	gatewayAddress, err := sdk.AccAddressFromBech32(msg.Address)
 	if err != nil {
@@ -57,6 +61,13 @@ func (k msgServer) StakeGateway(
 	k.SetGateway(ctx, gateway)
 	logger.Info("Successfully updated stake for gateway: %+v", gateway)
 
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventGatewayStaked{
+		Gateway: gateway,
+	}); err != nil {
+		logger.Error("failed to emit EventGatewayStaked: %v", err)
+		return nil, err
+	}
+
 	return &types.MsgStakeGatewayResponse{}, nil
 }
 
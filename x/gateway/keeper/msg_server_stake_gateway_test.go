@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
+	"github.com/pokt-network/poktroll/testutil/events"
 	keepertest "github.com/pokt-network/poktroll/testutil/keeper"
 	"github.com/pokt-network/poktroll/testutil/sample"
 	"github.com/pokt-network/poktroll/x/gateway/keeper"
@@ -54,6 +55,13 @@ func TestMsgServer_StakeGateway_SuccessfulCreateAndUpdate(t *testing.T) {
 	foundGateway, isGatewayFound = k.GetGateway(ctx, addr)
 	require.True(t, isGatewayFound)
 	require.Equal(t, updatedStake.Amount, foundGateway.Stake.Amount)
+
+	// Verify that both the stake and its update were emitted
+	stakedEvents := events.FilterTypedEvents[*types.EventGatewayStaked](t, ctx.EventManager().Events())
+	require.Len(t, stakedEvents, 2)
+	require.Equal(t, addr, stakedEvents[0].Gateway.Address)
+	require.Equal(t, initialStake.Amount, stakedEvents[0].Gateway.Stake.Amount)
+	require.Equal(t, updatedStake.Amount, stakedEvents[1].Gateway.Stake.Amount)
 }
 
 func TestMsgServer_StakeGateway_FailLoweringStake(t *testing.T) {
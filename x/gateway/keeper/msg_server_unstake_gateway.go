@@ -8,7 +8,6 @@ import (
 	"github.com/pokt-network/poktroll/x/gateway/types"
 )
 
-// TODO_TECHDEBT(#49): Add un-delegation from delegated apps
 // TODO(#73): Determine if a gateway needs an unbonding period after unstaking.
 func (k msgServer) UnstakeGateway(
 	goCtx context.Context,
@@ -42,12 +41,28 @@ func (k msgServer) UnstakeGateway(
 	// Send the coins from the gateway pool back to the gateway
 	err = k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.ModuleName, gatewayAddress, []sdk.Coin{*gateway.Stake})
 	if err != nil {
-		logger.Error("could not send %v coins from %s module to %s account due to %v", gateway.Stake, gatewayAddress, types.ModuleName, err)
+		logger.Error("could not send %v coins from %s module to %s account due to %v", gateway.Stake, types.ModuleName, gatewayAddress, err)
 		return nil, err
 	}
 
 	// Update the Gateway in the store
 	k.RemoveGateway(ctx, gatewayAddress.String())
 	logger.Info("Successfully removed the gateway: %+v", gateway)
+
+	// Let the modules which depend on the gateway being staked, e.g. the
+	// application module to undelegate the applications delegated to it, react
+	if k.hooks != nil {
+		if err := k.hooks.AfterGatewayUnstaked(ctx, gateway.Address); err != nil {
+			logger.Error("failed to run the hooks after unstaking gateway %s: %v", gateway.Address, err)
+			return nil, err
+		}
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventGatewayUnstaked{
+		Gateway: gateway,
+	}); err != nil {
+		logger.Error("failed to emit EventGatewayUnstaked: %v", err)
+		return nil, err
+	}
+
 	return &types.MsgUnstakeGatewayResponse{}, nil
 }
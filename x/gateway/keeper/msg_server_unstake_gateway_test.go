@@ -1,11 +1,13 @@
 package keeper_test
 
 import (
+	"errors"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
+	"github.com/pokt-network/poktroll/testutil/events"
 	keepertest "github.com/pokt-network/poktroll/testutil/keeper"
 	"github.com/pokt-network/poktroll/testutil/sample"
 	"github.com/pokt-network/poktroll/x/gateway/keeper"
@@ -49,6 +51,38 @@ func TestMsgServer_UnstakeGateway_Success(t *testing.T) {
 	// Make sure the gateway can no longer be found after unstaking
 	_, isGatewayFound = k.GetGateway(ctx, addr)
 	require.False(t, isGatewayFound)
+
+	// Verify that the unstake was emitted
+	unstakedEvents := events.FilterTypedEvents[*types.EventGatewayUnstaked](t, ctx.EventManager().Events())
+	require.Len(t, unstakedEvents, 1)
+	require.Equal(t, addr, unstakedEvents[0].Gateway.Address)
+	require.Equal(t, initialStake.Amount, unstakedEvents[0].Gateway.Stake.Amount)
+}
+
+func TestMsgServer_UnstakeGateway_CallsHooks(t *testing.T) {
+	k, ctx := keepertest.GatewayKeeper(t)
+	hooks := &fakeGatewayHooks{}
+	k.SetHooks(types.NewMultiGatewayHooks(hooks))
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	addr := sample.AccAddress()
+	stake := sdk.NewCoin("upokt", sdk.NewInt(100))
+	_, err := srv.StakeGateway(wctx, types.NewMsgStakeGateway(addr, stake))
+	require.NoError(t, err)
+	require.Empty(t, hooks.unstakedGatewayAddrs)
+
+	_, err = srv.UnstakeGateway(wctx, types.NewMsgUnstakeGateway(addr))
+	require.NoError(t, err)
+	require.Equal(t, []string{addr}, hooks.unstakedGatewayAddrs)
+
+	// A failing hook fails the unstaking of the gateway
+	hooks.err = errors.New("hook failed")
+	otherAddr := sample.AccAddress()
+	_, err = srv.StakeGateway(wctx, types.NewMsgStakeGateway(otherAddr, stake))
+	require.NoError(t, err)
+	_, err = srv.UnstakeGateway(wctx, types.NewMsgUnstakeGateway(otherAddr))
+	require.ErrorIs(t, err, hooks.err)
 }
 
 func TestMsgServer_UnstakeGateway_FailIfNotStaked(t *testing.T) {
@@ -72,3 +106,20 @@ func TestMsgServer_UnstakeGateway_FailIfNotStaked(t *testing.T) {
 	_, isGatewayFound = k.GetGateway(ctx, addr)
 	require.False(t, isGatewayFound)
 }
+
+// fakeGatewayHooks is a types.GatewayHooks which records the addresses of the
+// gateways it is called for, failing with err if it is set.
+type fakeGatewayHooks struct {
+	err error
+
+	unstakedGatewayAddrs []string
+}
+
+func (h *fakeGatewayHooks) AfterGatewayUnstaked(_ sdk.Context, gatewayAddress string) error {
+	if h.err != nil {
+		return h.err
+	}
+
+	h.unstakedGatewayAddrs = append(h.unstakedGatewayAddrs, gatewayAddress)
+	return nil
+}
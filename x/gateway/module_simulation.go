@@ -32,6 +32,10 @@ const (
 	// TODO: Determine the simulation weight value
 	defaultWeightMsgUnstakeGateway int = 100
 
+	opWeightMsgDecreaseGatewayStake = "op_weight_msg_decrease_gateway_stake"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgDecreaseGatewayStake int = 100
+
 	// this line is used by starport scaffolding # simapp/module/const
 )
 
@@ -82,6 +86,17 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		gatewaysimulation.SimulateMsgUnstakeGateway(am.accountKeeper, am.bankKeeper, am.keeper),
 	))
 
+	var weightMsgDecreaseGatewayStake int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgDecreaseGatewayStake, &weightMsgDecreaseGatewayStake, nil,
+		func(_ *rand.Rand) {
+			weightMsgDecreaseGatewayStake = defaultWeightMsgDecreaseGatewayStake
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgDecreaseGatewayStake,
+		gatewaysimulation.SimulateMsgDecreaseGatewayStake(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
 	// this line is used by starport scaffolding # simapp/module/operation
 
 	return operations
@@ -106,6 +121,14 @@ func (am AppModule) ProposalMsgs(simState module.SimulationState) []simtypes.Wei
 				return nil
 			},
 		),
+		simulation.NewWeightedProposalMsg(
+			opWeightMsgDecreaseGatewayStake,
+			defaultWeightMsgDecreaseGatewayStake,
+			func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
+				gatewaysimulation.SimulateMsgDecreaseGatewayStake(am.accountKeeper, am.bankKeeper, am.keeper)
+				return nil
+			},
+		),
 		// this line is used by starport scaffolding # simapp/module/OpMsg
 	}
 }
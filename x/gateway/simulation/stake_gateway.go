@@ -5,12 +5,17 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
 
 	"github.com/pokt-network/poktroll/x/gateway/keeper"
 	"github.com/pokt-network/poktroll/x/gateway/types"
+	sharedsimulation "github.com/pokt-network/poktroll/x/shared/simulation"
 )
 
+// SimulateMsgStakeGateway stakes a random account as a gateway, or increases
+// its stake if it is already staked.
 func SimulateMsgStakeGateway(
 	ak types.AccountKeeper,
 	bk types.BankKeeper,
@@ -19,12 +24,40 @@ func SimulateMsgStakeGateway(
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
 		simAccount, _ := simtypes.RandomAcc(r, accs)
-		stakeMsg := &types.MsgStakeGateway{
-			Address: simAccount.Address.String(),
+		gatewayAddress := simAccount.Address.String()
+
+		var currStake *sdk.Coin
+		if gateway, isGatewayFound := k.GetGateway(ctx, gatewayAddress); isGatewayFound {
+			currStake = gateway.Stake
+		}
+
+		spendable := bk.SpendableCoins(ctx, simAccount.Address)
+		stake, ok := sharedsimulation.RandomStake(r, spendable, currStake, k.GetParams(ctx).MinStake)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgStakeGateway, "insufficient funds to stake gateway"), nil, nil
 		}
 
-		// TODO: Handling the StakeGateway simulation
+		stakeMsg := types.NewMsgStakeGateway(gatewayAddress, stake)
+		coinsToDelegate := sdk.NewCoins(stake)
+		if currStake != nil {
+			coinsToDelegate = coinsToDelegate.Sub(*currStake)
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           moduletestutil.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             stakeMsg,
+			MsgType:         types.TypeMsgStakeGateway,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: coinsToDelegate,
+		}
 
-		return simtypes.NoOpMsg(types.ModuleName, stakeMsg.Type(), "StakeGateway simulation not implemented"), nil, nil
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
 	}
 }
@@ -10,6 +10,7 @@ import (
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgStakeGateway{}, "gateway/StakeGateway", nil)
 	cdc.RegisterConcrete(&MsgUnstakeGateway{}, "gateway/UnstakeGateway", nil)
+	cdc.RegisterConcrete(&MsgDecreaseGatewayStake{}, "gateway/DecreaseGatewayStake", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -20,6 +21,9 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUnstakeGateway{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgDecreaseGatewayStake{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
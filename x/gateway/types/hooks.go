@@ -0,0 +1,35 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GatewayHooks defines the hooks which the gateway module calls on the modules
+// which depend on the gateways being staked.
+type GatewayHooks interface {
+	// AfterGatewayUnstaked is called once the gateway with the given address
+	// has been unstaked and removed from the store.
+	AfterGatewayUnstaked(ctx sdk.Context, gatewayAddress string) error
+}
+
+var _ GatewayHooks = MultiGatewayHooks{}
+
+// MultiGatewayHooks combines multiple gateway hooks, which are all called in
+// the order they are given in.
+type MultiGatewayHooks []GatewayHooks
+
+// NewMultiGatewayHooks returns the combination of the given gateway hooks.
+func NewMultiGatewayHooks(hooks ...GatewayHooks) MultiGatewayHooks {
+	return hooks
+}
+
+// AfterGatewayUnstaked calls AfterGatewayUnstaked on all the combined hooks,
+// returning the first error encountered.
+func (h MultiGatewayHooks) AfterGatewayUnstaked(ctx sdk.Context, gatewayAddress string) error {
+	for _, hooks := range h {
+		if err := hooks.AfterGatewayUnstaked(ctx, gatewayAddress); err != nil {
+			return err
+		}
+	}
+	return nil
+}
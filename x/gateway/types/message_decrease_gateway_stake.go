@@ -0,0 +1,65 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgDecreaseGatewayStake = "decrease_gateway_stake"
+
+var _ sdk.Msg = (*MsgDecreaseGatewayStake)(nil)
+
+func NewMsgDecreaseGatewayStake(address string, stake sdk.Coin) *MsgDecreaseGatewayStake {
+	return &MsgDecreaseGatewayStake{
+		Address: address,
+		Stake:   &stake,
+	}
+}
+
+func (msg *MsgDecreaseGatewayStake) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgDecreaseGatewayStake) Type() string {
+	return TypeMsgDecreaseGatewayStake
+}
+
+func (msg *MsgDecreaseGatewayStake) GetSigners() []sdk.AccAddress {
+	address, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{address}
+}
+
+func (msg *MsgDecreaseGatewayStake) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgDecreaseGatewayStake) ValidateBasic() error {
+	// Validate the address
+	_, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrGatewayInvalidAddress, "invalid gateway address %s; (%v)", msg.Address, err)
+	}
+
+	// Validate the stake amount which remains staked
+	if msg.Stake == nil {
+		return sdkerrors.Wrapf(ErrGatewayInvalidStake, "nil gateway stake")
+	}
+	stake, err := sdk.ParseCoinNormalized(msg.Stake.String())
+	if err != nil {
+		return sdkerrors.Wrapf(ErrGatewayInvalidStake, "cannot parse gateway stake %v; (%v)", msg.Stake, err)
+	}
+	if !stake.IsValid() {
+		return sdkerrors.Wrapf(ErrGatewayInvalidStake, "invalid gateway stake %v; (%v)", msg.Stake, stake.Validate())
+	}
+	if stake.IsZero() || stake.IsNegative() {
+		return sdkerrors.Wrapf(ErrGatewayInvalidStake, "invalid stake amount for gateway: %v <= 0; use unstake to withdraw the whole stake", msg.Stake)
+	}
+	if stake.Denom != "upokt" {
+		return sdkerrors.Wrapf(ErrGatewayInvalidStake, "invalid stake amount denom for gateway %v", msg.Stake)
+	}
+	return nil
+}
@@ -0,0 +1,24 @@
+package service
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/pokt-network/poktroll/x/service/keeper"
+)
+
+// BeginBlocker records the relay mining difficulties which are in effect for the
+// current session, on its first block, such that they stay constant throughout it.
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	k.RecordSessionRelayDifficulties(ctx)
+}
+
+// EndBlocker retargets the relay mining difficulties of services at the end of
+// every RelayDifficultyRetargetInterval blocks, if it isn't 0.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	retargetInterval := k.GetParams(ctx).RelayDifficultyRetargetInterval
+	if retargetInterval == 0 || uint64(ctx.BlockHeight())%retargetInterval != 0 {
+		return
+	}
+
+	k.RetargetRelayDifficulties(ctx)
+}
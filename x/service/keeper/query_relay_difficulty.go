@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pokt-network/poktroll/x/service/types"
+)
+
+func (k Keeper) RelayDifficulty(goCtx context.Context, req *types.QueryRelayDifficultyRequest) (*types.QueryRelayDifficultyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	difficultyBits, err := k.SessionRelayDifficultyBits(ctx, req.ServiceId, req.SessionStartHeight)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryRelayDifficultyResponse{DifficultyBits: difficultyBits}, nil
+}
@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/pokt-network/poktroll/x/service/types"
+	"github.com/pokt-network/poktroll/x/shared/helpers"
+)
+
+// NumRetainedSessionRelayDifficulties is the number of sessions, up to and
+// including the current one, for which the relay mining difficulties in effect
+// are retained. Claims cannot be created for sessions which started earlier.
+// TODO_TECHDEBT: Derive it from the claim window governance parameter once it
+// is available.
+const NumRetainedSessionRelayDifficulties = 32
+
+// RecordSessionRelayDifficulties records the relay mining difficulties which are
+// in effect for the current session, unless they have already been recorded,
+// such that changes to them, whether retargets or governance param changes, only
+// take effect for the sessions which start after them. The difficulties of the
+// session which is no longer retained are pruned.
+func (k Keeper) RecordSessionRelayDifficulties(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SessionRelayDifficultiesKeyPrefix))
+
+	sessionStartHeight := helpers.GetSessionStartBlockHeight(ctx.BlockHeight())
+	key := types.SessionRelayDifficultiesKey(sessionStartHeight)
+	if store.Has(key) {
+		return
+	}
+
+	params := k.GetParams(ctx)
+	store.Set(key, k.cdc.MustMarshal(&params))
+
+	prunedSessionStartHeight := sessionStartHeight - NumRetainedSessionRelayDifficulties*helpers.NumBlocksPerSession
+	if prunedSessionStartHeight >= 0 {
+		store.Delete(types.SessionRelayDifficultiesKey(prunedSessionStartHeight))
+	}
+}
+
+// SessionRelayDifficultyBits returns the relay mining difficulty of the service
+// with the given ID which was in effect for the session starting at the given
+// height. It returns an error if the session's difficulties aren't retained.
+func (k Keeper) SessionRelayDifficultyBits(ctx sdk.Context, serviceId string, sessionStartHeight int64) (uint64, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SessionRelayDifficultiesKeyPrefix))
+
+	paramsBz := store.Get(types.SessionRelayDifficultiesKey(sessionStartHeight))
+	if paramsBz == nil {
+		return 0, types.ErrServiceRelayDifficultyNotFound.Wrapf(
+			"no relay difficulties recorded for the session starting at height %d", sessionStartHeight,
+		)
+	}
+
+	var params types.Params
+	k.cdc.MustUnmarshal(paramsBz, &params)
+
+	return params.RelayDifficultyBits(serviceId), nil
+}
+
+// AddProvenMinedRelays adds the given number of mined relays, which have been
+// proven for the service with the given ID, to the number which relay
+// difficulties are retargeted by. It does nothing if retargeting is disabled.
+func (k Keeper) AddProvenMinedRelays(ctx sdk.Context, serviceId string, numMinedRelays uint64) {
+	if k.GetParams(ctx).RelayDifficultyRetargetInterval == 0 {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProvenMinedRelaysKeyPrefix))
+	key := types.ProvenMinedRelaysKey(serviceId)
+
+	var provenMinedRelays uint64
+	if provenMinedRelaysBz := store.Get(key); provenMinedRelaysBz != nil {
+		provenMinedRelays = sdk.BigEndianToUint64(provenMinedRelaysBz)
+	}
+
+	if provenMinedRelays > math.MaxUint64-numMinedRelays {
+		provenMinedRelays = math.MaxUint64
+	} else {
+		provenMinedRelays += numMinedRelays
+	}
+
+	store.Set(key, sdk.Uint64ToBigEndian(provenMinedRelays))
+}
+
+// RetargetRelayDifficulties sets the relay mining difficulty of each service
+// which has a difficulty of its own, or for which mined relays have been proven
+// since the last retarget, such that the number of mined relays proven for it
+// over the next retarget interval approaches the target; provided that its
+// relay volume doesn't change. The proven mined relays are then reset. The new
+// difficulties take effect for the sessions which start after the retarget
+// (see: RecordSessionRelayDifficulties).
+func (k Keeper) RetargetRelayDifficulties(ctx sdk.Context) {
+	logger := k.Logger(ctx).With("method", "RetargetRelayDifficulties")
+
+	params := k.GetParams(ctx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProvenMinedRelaysKeyPrefix))
+
+	// NB: Services are retargeted in a deterministic order; the order of their
+	// difficulties in the params followed by the order of their store keys.
+	var serviceIds []string
+	provenMinedRelays := make(map[string]uint64)
+	for _, relayDifficulty := range params.RelayDifficulties {
+		serviceIds = append(serviceIds, relayDifficulty.ServiceId)
+		provenMinedRelays[relayDifficulty.ServiceId] = 0
+	}
+
+	var provenKeys [][]byte
+	iterator := store.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		provenKeys = append(provenKeys, key)
+
+		// Trim the trailing "/" of the key (see: types.ProvenMinedRelaysKey).
+		serviceId := string(key[:len(key)-1])
+		if _, ok := provenMinedRelays[serviceId]; !ok {
+			serviceIds = append(serviceIds, serviceId)
+		}
+		provenMinedRelays[serviceId] = sdk.BigEndianToUint64(iterator.Value())
+	}
+	iterator.Close()
+
+	for _, serviceId := range serviceIds {
+		currentDifficultyBits := params.RelayDifficultyBits(serviceId)
+		difficultyBits := helpers.RetargetRelayDifficultyBits(
+			provenMinedRelays[serviceId],
+			currentDifficultyBits,
+			params.TargetNumMinedRelays,
+		)
+		params.SetRelayDifficultyBits(serviceId, difficultyBits)
+
+		logger.Info(fmt.Sprintf(
+			"retargeted relay difficulty of service %s from %d to %d bits given %d proven mined relays",
+			serviceId, currentDifficultyBits, difficultyBits, provenMinedRelays[serviceId],
+		))
+	}
+
+	for _, key := range provenKeys {
+		store.Delete(key)
+	}
+
+	k.SetParams(ctx, params)
+}
@@ -0,0 +1,45 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/pokt-network/poktroll/testutil/keeper"
+	"github.com/pokt-network/poktroll/x/service/types"
+)
+
+func TestSessionRelayDifficultyBits(t *testing.T) {
+	const serviceId = "svc1"
+
+	k, ctx := testkeeper.ServiceKeeper(t)
+
+	// Record the relay difficulties in effect for the session starting at height 4.
+	ctx = ctx.WithBlockHeight(5)
+	k.RecordSessionRelayDifficulties(ctx)
+	initialDifficultyBits := k.GetParams(ctx).RelayDifficultyBits(serviceId)
+
+	// Changes to the relay difficulties don't take effect for the current session.
+	params := k.GetParams(ctx)
+	params.SetRelayDifficultyBits(serviceId, initialDifficultyBits+1)
+	k.SetParams(ctx, params)
+
+	ctx = ctx.WithBlockHeight(6)
+	k.RecordSessionRelayDifficulties(ctx)
+
+	difficultyBits, err := k.SessionRelayDifficultyBits(ctx, serviceId, 4)
+	require.NoError(t, err)
+	require.Equal(t, initialDifficultyBits, difficultyBits)
+
+	// They take effect for the next session.
+	ctx = ctx.WithBlockHeight(8)
+	k.RecordSessionRelayDifficulties(ctx)
+
+	difficultyBits, err = k.SessionRelayDifficultyBits(ctx, serviceId, 8)
+	require.NoError(t, err)
+	require.Equal(t, initialDifficultyBits+1, difficultyBits)
+
+	// The relay difficulties of sessions which weren't recorded aren't found.
+	_, err = k.SessionRelayDifficultyBits(ctx, serviceId, 0)
+	require.ErrorIs(t, err, types.ErrServiceRelayDifficultyNotFound)
+}
@@ -8,5 +8,10 @@ import (
 
 // x/service module sentinel errors
 var (
-	ErrSample = sdkerrors.Register(ModuleName, 1100, "sample error")
+	ErrSample                         = sdkerrors.Register(ModuleName, 1100, "sample error")
+	ErrServiceInvalidRelayDifficulty  = sdkerrors.Register(ModuleName, 1101, "invalid relay difficulty")
+	ErrServiceInvalidRetargetParams   = sdkerrors.Register(ModuleName, 1102, "invalid relay difficulty retarget params")
+	ErrServiceInvalidRelay            = sdkerrors.Register(ModuleName, 1103, "invalid relay")
+	ErrServiceInvalidRelayProof       = sdkerrors.Register(ModuleName, 1104, "invalid relay proof")
+	ErrServiceRelayDifficultyNotFound = sdkerrors.Register(ModuleName, 1105, "relay difficulty not found")
 )
@@ -1,5 +1,7 @@
 package types
 
+import "encoding/binary"
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "service"
@@ -12,8 +14,38 @@ const (
 
 	// MemStoreKey defines the in-memory store key
 	MemStoreKey = "mem_service"
+
+	// ProvenMinedRelaysKeyPrefix is the prefix of the keys which the number of
+	// mined relays proven for each service, since relay difficulties were last
+	// retargeted, is stored under.
+	ProvenMinedRelaysKeyPrefix = "ProvenMinedRelays/"
+
+	// SessionRelayDifficultiesKeyPrefix is the prefix of the keys which the relay
+	// mining difficulties in effect for each session are stored under.
+	SessionRelayDifficultiesKeyPrefix = "SessionRelayDifficulties/"
 )
 
 func KeyPrefix(p string) []byte {
 	return []byte(p)
 }
+
+// ProvenMinedRelaysKey returns the store key which the number of mined relays
+// proven for the service with the given ID is stored under.
+func ProvenMinedRelaysKey(serviceId string) []byte {
+	var key []byte
+
+	key = append(key, []byte(serviceId)...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// SessionRelayDifficultiesKey returns the store key which the relay mining
+// difficulties in effect for the session starting at the given height are
+// stored under.
+func SessionRelayDifficultiesKey(sessionStartHeight int64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, uint64(sessionStartHeight))
+
+	return key
+}
@@ -1,39 +1,174 @@
 package types
 
 import (
+	"fmt"
+
+	sdkerrors "cosmossdk.io/errors"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"gopkg.in/yaml.v2"
+
+	"github.com/pokt-network/poktroll/x/shared/helpers"
+)
+
+// TODO: Revisit default param values
+const (
+	// DefaultDefaultRelayDifficultyBits is 0 such that every relay is mined
+	// until governance decides otherwise.
+	DefaultDefaultRelayDifficultyBits uint64 = 0
+	// DefaultTargetNumMinedRelays is the number of mined relays per service
+	// which relay difficulties are retargeted towards.
+	DefaultTargetNumMinedRelays uint64 = 10000
+	// DefaultRelayDifficultyRetargetInterval is 0 such that relay difficulties
+	// aren't retargeted until governance decides otherwise.
+	DefaultRelayDifficultyRetargetInterval uint64 = 0
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+var (
+	KeyDefaultRelayDifficultyBits      = []byte("DefaultRelayDifficultyBits")
+	KeyRelayDifficulties               = []byte("RelayDifficulties")
+	KeyTargetNumMinedRelays            = []byte("TargetNumMinedRelays")
+	KeyRelayDifficultyRetargetInterval = []byte("RelayDifficultyRetargetInterval")
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(
+	defaultRelayDifficultyBits uint64,
+	relayDifficulties []ServiceRelayDifficulty,
+	targetNumMinedRelays uint64,
+	relayDifficultyRetargetInterval uint64,
+) Params {
+	return Params{
+		DefaultRelayDifficultyBits:      defaultRelayDifficultyBits,
+		RelayDifficulties:               relayDifficulties,
+		TargetNumMinedRelays:            targetNumMinedRelays,
+		RelayDifficultyRetargetInterval: relayDifficultyRetargetInterval,
+	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(
+		DefaultDefaultRelayDifficultyBits,
+		[]ServiceRelayDifficulty{},
+		DefaultTargetNumMinedRelays,
+		DefaultRelayDifficultyRetargetInterval,
+	)
 }
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyDefaultRelayDifficultyBits, &p.DefaultRelayDifficultyBits, validateRelayDifficultyBits),
+		paramtypes.NewParamSetPair(KeyRelayDifficulties, &p.RelayDifficulties, validateRelayDifficulties),
+		paramtypes.NewParamSetPair(KeyTargetNumMinedRelays, &p.TargetNumMinedRelays, validateUint64),
+		paramtypes.NewParamSetPair(KeyRelayDifficultyRetargetInterval, &p.RelayDifficultyRetargetInterval, validateUint64),
+	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
+	if err := validateRelayDifficultyBits(p.DefaultRelayDifficultyBits); err != nil {
+		return err
+	}
+	if err := validateRelayDifficulties(p.RelayDifficulties); err != nil {
+		return err
+	}
+	if p.RelayDifficultyRetargetInterval > 0 && p.TargetNumMinedRelays == 0 {
+		return sdkerrors.Wrapf(
+			ErrServiceInvalidRetargetParams,
+			"TargetNumMinedRelays param must be positive when RelayDifficultyRetargetInterval is %d",
+			p.RelayDifficultyRetargetInterval,
+		)
+	}
 	return nil
 }
 
+// RelayDifficultyBits returns the relay mining difficulty of the service with
+// the given ID, or the default relay difficulty if it has none of its own.
+func (p Params) RelayDifficultyBits(serviceId string) uint64 {
+	for _, relayDifficulty := range p.RelayDifficulties {
+		if relayDifficulty.ServiceId == serviceId {
+			return relayDifficulty.DifficultyBits
+		}
+	}
+	return p.DefaultRelayDifficultyBits
+}
+
+// SetRelayDifficultyBits sets the relay mining difficulty of the service with
+// the given ID, adding it to the relay difficulties if it has none of its own.
+func (p *Params) SetRelayDifficultyBits(serviceId string, difficultyBits uint64) {
+	for i, relayDifficulty := range p.RelayDifficulties {
+		if relayDifficulty.ServiceId == serviceId {
+			p.RelayDifficulties[i].DifficultyBits = difficultyBits
+			return
+		}
+	}
+	p.RelayDifficulties = append(p.RelayDifficulties, ServiceRelayDifficulty{
+		ServiceId:      serviceId,
+		DifficultyBits: difficultyBits,
+	})
+}
+
 // String implements the Stringer interface.
 func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
 	return string(out)
 }
+
+func validateRelayDifficultyBits(i interface{}) error {
+	difficultyBits, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if difficultyBits > helpers.MaxRelayDifficultyBits {
+		return sdkerrors.Wrapf(
+			ErrServiceInvalidRelayDifficulty,
+			"relay difficulty bits > %d: got %d",
+			helpers.MaxRelayDifficultyBits, difficultyBits,
+		)
+	}
+	return nil
+}
+
+func validateRelayDifficulties(i interface{}) error {
+	relayDifficulties, ok := i.([]ServiceRelayDifficulty)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	serviceIds := make(map[string]struct{}, len(relayDifficulties))
+	for _, relayDifficulty := range relayDifficulties {
+		if !helpers.IsValidServiceId(relayDifficulty.ServiceId) {
+			return sdkerrors.Wrapf(
+				ErrServiceInvalidRelayDifficulty,
+				"invalid service ID: %q", relayDifficulty.ServiceId,
+			)
+		}
+		if _, ok := serviceIds[relayDifficulty.ServiceId]; ok {
+			return sdkerrors.Wrapf(
+				ErrServiceInvalidRelayDifficulty,
+				"duplicate service ID: %q", relayDifficulty.ServiceId,
+			)
+		}
+		serviceIds[relayDifficulty.ServiceId] = struct{}{}
+
+		if err := validateRelayDifficultyBits(relayDifficulty.DifficultyBits); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateUint64(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
@@ -1,22 +1,62 @@
 package types
 
+import (
+	"crypto/rand"
+	"crypto/sha256"
+)
+
 // GetSignableBytes returns the signable bytes for the relay request
 // this involves setting the signature to nil and marshaling the message.
-// A value receiver is used to avoid overwriting any pre-existing signature
+// A value receiver, and a copy of the metadata, are used to avoid overwriting
+// any pre-existing signature
 func (req RelayRequest) GetSignableBytes() ([]byte, error) {
 	// set signature to nil
-	req.Meta.Signature = nil
+	if req.Meta != nil {
+		meta := *req.Meta
+		meta.Signature = nil
+		req.Meta = &meta
+	}
 
 	// return the marshaled message
 	return req.Marshal()
 }
 
+// RelayRequestNonceSize is the size, in bytes, of relay request nonces.
+const RelayRequestNonceSize = 16
+
+// NewRelayRequestNonce returns a new random relay request nonce.
+func NewRelayRequestNonce() ([]byte, error) {
+	nonce := make([]byte, RelayRequestNonceSize)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return nonce, nil
+}
+
+// GetSignableBytesHash returns the hash of the relay request's signable bytes.
+// It is what the relay request's ring signature is made over and what the
+// relay response which answers it references (see: RelayResponseMetadata).
+func (req RelayRequest) GetSignableBytesHash() ([]byte, error) {
+	signableBz, err := req.GetSignableBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha256.Sum256(signableBz)
+	return hash[:], nil
+}
+
 // GetSignableBytes returns the signable bytes for the relay response
 // this involves setting the signature to nil and marshaling the message.
-// A value receiver is used to avoid overwriting any pre-existing signature
+// A value receiver, and a copy of the metadata, are used to avoid overwriting
+// any pre-existing signature
 func (res RelayResponse) GetSignableBytes() ([]byte, error) {
 	// set signature to nil
-	res.Meta.SupplierSignature = nil
+	if res.Meta != nil {
+		meta := *res.Meta
+		meta.SupplierSignature = nil
+		res.Meta = &meta
+	}
 
 	// return the marshaled message
 	return res.Marshal()
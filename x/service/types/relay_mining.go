@@ -0,0 +1,169 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"hash"
+	"math/bits"
+
+	"github.com/pokt-network/smt"
+)
+
+// This file defines the canonical relay encoding, relay hash, relay mining
+// difficulty and the layout of the session SMSTs which mined relays are inserted
+// into. Both the off-chain RelayMiner (i.e. the miner and the session trees)
+// and on-chain proof validation MUST use it such that they never drift apart.
+
+const (
+	// MinedRelayWeight is the weight of each mined relay in its session's SMST,
+	// such that the sum of the tree is the number of mined relays.
+	MinedRelayWeight = 1
+
+	// relaySMSTSumSize is the size, in bytes, of the sum which is appended to
+	// the digests and leaf values of SMSTs (see: smt.SMST#Root()).
+	relaySMSTSumSize = 8
+)
+
+// NewRelayHasher returns a new instance of the hash function which is used to
+// hash relays, as well as the paths and nodes of session SMSTs.
+func NewRelayHasher() hash.Hash {
+	return sha256.New()
+}
+
+// GetCanonicalBytes returns the canonical encoding of the relay, which is what
+// its hash is computed from and its session SMST leaf value. It is the relay's
+// protobuf encoding, which is deterministic as relays contain no maps.
+func (relay *Relay) GetCanonicalBytes() ([]byte, error) {
+	return relay.Marshal()
+}
+
+// RelayFromCanonicalBytes decodes a relay from its canonical encoding.
+func RelayFromCanonicalBytes(relayBz []byte) (*Relay, error) {
+	relay := new(Relay)
+	if err := relay.Unmarshal(relayBz); err != nil {
+		return nil, ErrServiceInvalidRelay.Wrapf("unmarshaling relay: %s", err)
+	}
+	return relay, nil
+}
+
+// RelayHash returns the hash of the relay with the given canonical encoding.
+func RelayHash(relayBz []byte) []byte {
+	hasher := NewRelayHasher()
+	hasher.Write(relayBz)
+	return hasher.Sum(nil)
+}
+
+// CountRelayDifficultyBits returns the number of leading zero bits of the given
+// relay hash, which is the relay mining difficulty that the relay satisfies.
+func CountRelayDifficultyBits(relayHash []byte) int {
+	for byteIdx, byteValue := range relayHash {
+		if byteValue != 0 {
+			// byteIdx bytes are all 0s and this one has some leading 0 bits.
+			return byteIdx*8 + bits.LeadingZeros8(byteValue)
+		}
+	}
+	return len(relayHash) * 8
+}
+
+// IsRelayMined returns true if the relay with the given hash satisfies the given
+// relay mining difficulty, in which case it is volume / reward applicable.
+func IsRelayMined(relayHash []byte, difficultyBits uint64) bool {
+	return uint64(CountRelayDifficultyBits(relayHash)) >= difficultyBits
+}
+
+// RelaySMSTLeaf returns the key, value and weight of the leaf which the relay
+// with the given canonical encoding is inserted into its session's SMST as.
+// They are the relay's hash, its canonical encoding and MinedRelayWeight.
+func RelaySMSTLeaf(relayBz []byte) (key, value []byte, weight uint64) {
+	return RelayHash(relayBz), relayBz, MinedRelayWeight
+}
+
+// NewRelaySMST returns a new, empty, session SMST which is backed by the given
+// store. Leaf values aren't hashed such that proofs contain the relay which
+// they prove.
+func NewRelaySMST(nodes smt.KVStore) *smt.SMST {
+	return smt.NewSparseMerkleSumTree(nodes, NewRelayHasher(), smt.WithValueHasher(nil))
+}
+
+// ImportRelaySMST returns the session SMST with the given root which is backed
+// by the given store (see: NewRelaySMST).
+func ImportRelaySMST(nodes smt.KVStore, root []byte) *smt.SMST {
+	return smt.ImportSparseMerkleSumTree(nodes, NewRelayHasher(), root, smt.WithValueHasher(nil))
+}
+
+// RelaySMSTSpec returns the spec which session SMST closest proofs are verified
+// with. Paths aren't hashed as closest proofs contain the path of the closest
+// leaf rather than its key.
+func RelaySMSTSpec() *smt.TreeSpec {
+	return smt.NoPrehashSpec(NewRelayHasher(), true)
+}
+
+// RelaySMSTPath returns the path of the session SMST leaf with the given key.
+func RelaySMSTPath(key []byte) []byte {
+	hasher := NewRelayHasher()
+	hasher.Write(key)
+	return hasher.Sum(nil)
+}
+
+// RelaySMSTRootSum returns the sum of the session SMST with the given root,
+// which is the number of relays which were mined in the session.
+func RelaySMSTRootSum(root []byte) (uint64, error) {
+	if len(root) < relaySMSTSumSize {
+		return 0, ErrServiceInvalidRelayProof.Wrapf(
+			"root length < %d: got %d", relaySMSTSumSize, len(root),
+		)
+	}
+
+	return binary.BigEndian.Uint64(root[len(root)-relaySMSTSumSize:]), nil
+}
+
+// VerifyRelayClosestProof verifies that the given session SMST closest proof is
+// valid for the tree with the given root, and that the relay which it proves was
+// mined at the given relay mining difficulty. It returns the proven relay.
+func VerifyRelayClosestProof(
+	proof *smt.SparseMerkleClosestProof,
+	root []byte,
+	difficultyBits uint64,
+) (*Relay, error) {
+	valid, err := smt.VerifyClosestProof(proof, root, RelaySMSTSpec())
+	if err != nil {
+		return nil, ErrServiceInvalidRelayProof.Wrapf("%s", err)
+	}
+	if !valid {
+		return nil, ErrServiceInvalidRelayProof.Wrap("proof does not match root")
+	}
+
+	// The closest leaf's value is the relay's canonical encoding followed by
+	// its weight (see: RelaySMSTLeaf).
+	leafValue := proof.ClosestValueHash
+	if len(leafValue) <= relaySMSTSumSize {
+		return nil, ErrServiceInvalidRelayProof.Wrap("proof is of an empty tree")
+	}
+	relayBz := leafValue[:len(leafValue)-relaySMSTSumSize]
+	weight := binary.BigEndian.Uint64(leafValue[len(leafValue)-relaySMSTSumSize:])
+	if weight != MinedRelayWeight {
+		return nil, ErrServiceInvalidRelayProof.Wrapf(
+			"relay weight is not %d: got %d", MinedRelayWeight, weight,
+		)
+	}
+
+	relay, err := RelayFromCanonicalBytes(relayBz)
+	if err != nil {
+		return nil, err
+	}
+
+	key, _, _ := RelaySMSTLeaf(relayBz)
+	if !bytes.Equal(proof.ClosestPath, RelaySMSTPath(key)) {
+		return nil, ErrServiceInvalidRelayProof.Wrap("relay does not match its path")
+	}
+
+	if !IsRelayMined(key, difficultyBits) {
+		return nil, ErrServiceInvalidRelayProof.Wrapf(
+			"relay difficulty < %d bits: got %d",
+			difficultyBits, CountRelayDifficultyBits(key),
+		)
+	}
+
+	return relay, nil
+}
@@ -0,0 +1,117 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/pokt-network/smt"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/x/service/types"
+	sessiontypes "github.com/pokt-network/poktroll/x/session/types"
+)
+
+func TestCountRelayDifficultyBits(t *testing.T) {
+	tests := []struct {
+		bz         []byte
+		difficulty int
+	}{
+		{
+			bz:         []byte{0b11111111, 255, 255, 255},
+			difficulty: 0,
+		},
+		{
+			bz:         []byte{0b01111111, 255, 255, 255},
+			difficulty: 1,
+		},
+		{
+			bz:         []byte{0, 255, 255, 255},
+			difficulty: 8,
+		},
+		{
+			bz:         []byte{0, 0b01111111, 255, 255},
+			difficulty: 9,
+		},
+		{
+			bz:         []byte{0, 0b00111111, 255, 255},
+			difficulty: 10,
+		},
+		{
+			bz:         []byte{0, 0, 255, 255},
+			difficulty: 16,
+		},
+		{
+			bz:         []byte{0, 0, 0, 0},
+			difficulty: 32,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("difficulty_%d_zero_bits", tt.difficulty), func(t *testing.T) {
+			require.Equal(t, tt.difficulty, types.CountRelayDifficultyBits(tt.bz))
+			require.True(t, types.IsRelayMined(tt.bz, uint64(tt.difficulty)))
+			require.False(t, types.IsRelayMined(tt.bz, uint64(tt.difficulty+1)))
+		})
+	}
+}
+
+func TestVerifyRelayClosestProof(t *testing.T) {
+	const (
+		numRelays = 10
+		sessionId = "session_id"
+	)
+
+	treeStore, err := smt.NewKVStore("")
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, treeStore.Stop()) })
+
+	tree := types.NewRelaySMST(treeStore)
+	relayHashes := make(map[string]struct{}, numRelays)
+	for i := 0; i < numRelays; i++ {
+		relay := &types.Relay{
+			Req: &types.RelayRequest{
+				Meta: &types.RelayRequestMetadata{
+					SessionHeader: &sessiontypes.SessionHeader{SessionId: sessionId},
+				},
+				Payload: []byte(fmt.Sprintf("request_%d", i)),
+			},
+			Res: &types.RelayResponse{},
+		}
+		relayBz, err := relay.GetCanonicalBytes()
+		require.NoError(t, err)
+
+		key, value, weight := types.RelaySMSTLeaf(relayBz)
+		require.NoError(t, tree.Update(key, value, weight))
+		relayHashes[string(types.RelayHash(relayBz))] = struct{}{}
+	}
+	require.NoError(t, tree.Commit())
+
+	root := tree.Root()
+	numMinedRelays, err := types.RelaySMSTRootSum(root)
+	require.NoError(t, err)
+	require.Equal(t, uint64(numRelays), numMinedRelays)
+
+	proof, err := tree.ProveClosest(types.RelaySMSTPath([]byte("block_hash")))
+	require.NoError(t, err)
+
+	// The proven relay is one of those which were inserted into the tree.
+	relay, err := types.VerifyRelayClosestProof(proof, root, 0)
+	require.NoError(t, err)
+	require.Equal(t, sessionId, relay.GetReq().GetMeta().GetSessionHeader().GetSessionId())
+
+	relayBz, err := relay.GetCanonicalBytes()
+	require.NoError(t, err)
+	require.Contains(t, relayHashes, string(types.RelayHash(relayBz)))
+
+	// The proof is invalid for any other tree.
+	otherRoot := make([]byte, len(root))
+	copy(otherRoot, root)
+	otherRoot[0] ^= 0xff
+	_, err = types.VerifyRelayClosestProof(proof, otherRoot, 0)
+	require.ErrorIs(t, err, types.ErrServiceInvalidRelayProof)
+
+	// The proven relay doesn't satisfy a difficulty greater than its own.
+	difficultyBits := uint64(types.CountRelayDifficultyBits(types.RelayHash(relayBz)))
+	_, err = types.VerifyRelayClosestProof(proof, root, difficultyBits+1)
+	require.ErrorIs(t, err, types.ErrServiceInvalidRelayProof)
+}
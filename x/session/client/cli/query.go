@@ -26,6 +26,7 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 
 	cmd.AddCommand(CmdQueryParams())
 	cmd.AddCommand(CmdGetSession())
+	cmd.AddCommand(CmdGetSupplierSessions())
 
 	// this line is used by starport scaffolding # 1
 
@@ -0,0 +1,69 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/pokt-network/poktroll/x/session/types"
+)
+
+func CmdGetSupplierSessions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-supplier-sessions <supplier_address> <service_id> [block_height]",
+		Short: "Query get-supplier-sessions",
+		Long: `Query the headers of the sessions a supplier is part of for a specific (service, height) tuple.
+
+[block_height] is optional. If unspecified, or set to 0, it defaults to the latest height of the node being queried.
+
+This is a query operation that will not result in a state transition but simply gives a view into the chain state.
+
+Example:
+$ poktrolld --home=$(POKTROLLD_HOME) q session get-supplier-sessions pokt19a3t4yunp0dlpfjrp7qwnzwlrzd5fzs2gjaaaj svc1 42 --node $(POCKET_NODE)`,
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			supplierAddressString := args[0]
+			serviceIdString := args[1]
+			blockHeightString := "0" // 0 will default to latest height
+			if len(args) == 3 {
+				blockHeightString = args[2]
+			}
+
+			blockHeight, err := strconv.ParseInt(blockHeightString, 10, 64)
+			if err != nil {
+				return fmt.Errorf("couldn't convert block height to int: %s; (%v)", blockHeightString, err)
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			getSupplierSessionsReq := types.NewQueryGetSupplierSessionsRequest(supplierAddressString, serviceIdString, blockHeight, pageReq)
+			if err := getSupplierSessionsReq.ValidateBasic(); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			getSupplierSessionsRes, err := queryClient.GetSupplierSessions(cmd.Context(), getSupplierSessionsReq)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(getSupplierSessionsRes)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
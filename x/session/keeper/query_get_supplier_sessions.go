@@ -0,0 +1,131 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pokt-network/poktroll/x/session/types"
+)
+
+// GetSupplierSessions returns the headers of the sessions, for the requested
+// service and block height, which the requested supplier is part of. Sessions
+// are hydrated for each of the applications staked for the service, in the order
+// of their addresses, such that the result is deterministic.
+// TODO_OPTIMIZE: Every session of the service is hydrated until the requested
+// page is filled. Consider caching the sessions of the current height.
+func (k Keeper) GetSupplierSessions(
+	goCtx context.Context,
+	req *types.QueryGetSupplierSessionsRequest,
+) (*types.QueryGetSupplierSessionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	pageReq := req.Pagination
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if len(pageReq.Key) > 0 && pageReq.Offset > 0 {
+		return nil, status.Error(codes.InvalidArgument, "either offset or key is expected, got both")
+	}
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+	// As with `query.Paginate`, the total is only counted for offset based queries.
+	countTotal := pageReq.CountTotal && len(pageReq.Key) == 0
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// If block height is not specified, use the current (context's latest) block height
+	blockHeight := req.BlockHeight
+	if blockHeight == 0 {
+		blockHeight = ctx.BlockHeight()
+	}
+
+	// A supplier which isn't staked for the service can't be in any of its sessions,
+	// which may not even be hydratable if no supplier is staked for it.
+	if !k.isSupplierStakedForService(ctx, req.SupplierAddress, req.Service.Id) {
+		return &types.QueryGetSupplierSessionsResponse{Pagination: &query.PageResponse{}}, nil
+	}
+
+	var (
+		sessionHeaders []*types.SessionHeader
+		nextKey        []byte
+		numMatches     uint64
+	)
+	for _, app := range k.appKeeper.GetApplicationsByService(ctx, req.Service.Id) {
+		// Skip the applications before the page's key
+		if len(pageReq.Key) > 0 && app.Address < string(pageReq.Key) {
+			continue
+		}
+
+		sessionHydrator := NewSessionHydrator(app.Address, req.Service.Id, blockHeight)
+		session, err := k.HydrateSession(ctx, sessionHydrator)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		if !isSupplierInSession(session, req.SupplierAddress) {
+			continue
+		}
+
+		numMatches++
+		if numMatches <= pageReq.Offset {
+			continue
+		}
+
+		if uint64(len(sessionHeaders)) < limit {
+			sessionHeaders = append(sessionHeaders, session.Header)
+			continue
+		}
+
+		if nextKey == nil {
+			nextKey = []byte(app.Address)
+		}
+		if !countTotal {
+			break
+		}
+	}
+
+	pageRes := &query.PageResponse{NextKey: nextKey}
+	if countTotal {
+		pageRes.Total = numMatches
+	}
+
+	res := &types.QueryGetSupplierSessionsResponse{
+		SessionHeaders: sessionHeaders,
+		Pagination:     pageRes,
+	}
+	return res, nil
+}
+
+// isSupplierStakedForService returns true if the supplier with the given operator
+// address is currently staked for the given service.
+func (k Keeper) isSupplierStakedForService(ctx sdk.Context, supplierAddress, serviceId string) bool {
+	for _, supplier := range k.supplierKeeper.GetSuppliersByService(ctx, serviceId) {
+		if supplier.OperatorAddress == supplierAddress {
+			return true
+		}
+	}
+	return false
+}
+
+// isSupplierInSession returns true if the supplier with the given operator
+// address is one of the suppliers of the given session.
+func isSupplierInSession(session *types.Session, supplierAddress string) bool {
+	for _, supplier := range session.Suppliers {
+		if supplier.OperatorAddress == supplierAddress {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,175 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/pokt-network/poktroll/testutil/keeper"
+	"github.com/pokt-network/poktroll/testutil/sample"
+	"github.com/pokt-network/poktroll/x/session/types"
+)
+
+func TestSession_GetSupplierSessions_Success(t *testing.T) {
+	keeper, ctx := keepertest.SessionKeeper(t)
+	ctx = ctx.WithBlockHeight(100) // provide a sufficiently large block height to avoid errors
+	wctx := sdk.WrapSDKContext(ctx)
+
+	tests := []struct {
+		name string
+
+		supplierAddr string
+		serviceId    string
+
+		expectedAppAddrs []string
+	}{
+		{
+			name: "supplier in the session of app1 for svc1",
+
+			supplierAddr: keepertest.TestSupplierAddress,
+			serviceId:    keepertest.TestServiceId1,
+
+			expectedAppAddrs: []string{keepertest.TestApp1Address},
+		},
+		{
+			name: "supplier in the sessions of app1 and app2 for svc12, ordered by app address",
+
+			supplierAddr: keepertest.TestSupplierAddress,
+			serviceId:    keepertest.TestServiceId12,
+
+			expectedAppAddrs: []string{keepertest.TestApp2Address, keepertest.TestApp1Address},
+		},
+		{
+			name: "supplier not staked for svc11 isn't in any of its sessions",
+
+			supplierAddr: keepertest.TestSupplierAddress,
+			serviceId:    keepertest.TestServiceId11,
+
+			expectedAppAddrs: []string{},
+		},
+		{
+			name: "unknown supplier isn't in any session",
+
+			supplierAddr: sample.AccAddress(),
+			serviceId:    keepertest.TestServiceId12,
+
+			expectedAppAddrs: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := types.NewQueryGetSupplierSessionsRequest(tt.supplierAddr, tt.serviceId, 1, nil)
+			res, err := keeper.GetSupplierSessions(wctx, req)
+			require.NoError(t, err)
+			require.Len(t, res.SessionHeaders, len(tt.expectedAppAddrs))
+
+			for i, sessionHeader := range res.SessionHeaders {
+				require.Equal(t, tt.expectedAppAddrs[i], sessionHeader.ApplicationAddress)
+
+				// The session headers match the ones of the sessions queried by application
+				sessionReq := types.NewQueryGetSessionRequest(tt.expectedAppAddrs[i], tt.serviceId, 1)
+				sessionRes, err := keeper.GetSession(wctx, sessionReq)
+				require.NoError(t, err)
+				require.Equal(t, sessionRes.Session.Header, sessionHeader)
+			}
+		})
+	}
+}
+
+func TestSession_GetSupplierSessions_Paginated(t *testing.T) {
+	keeper, ctx := keepertest.SessionKeeper(t)
+	ctx = ctx.WithBlockHeight(100) // provide a sufficiently large block height to avoid errors
+	wctx := sdk.WrapSDKContext(ctx)
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QueryGetSupplierSessionsRequest {
+		pageReq := &query.PageRequest{
+			Key:        next,
+			Offset:     offset,
+			Limit:      limit,
+			CountTotal: total,
+		}
+		return types.NewQueryGetSupplierSessionsRequest(keepertest.TestSupplierAddress, keepertest.TestServiceId12, 1, pageReq)
+	}
+
+	t.Run("ByKey", func(t *testing.T) {
+		res, err := keeper.GetSupplierSessions(wctx, request(nil, 0, 1, false))
+		require.NoError(t, err)
+		require.Len(t, res.SessionHeaders, 1)
+		require.Equal(t, keepertest.TestApp2Address, res.SessionHeaders[0].ApplicationAddress)
+		require.Equal(t, []byte(keepertest.TestApp1Address), res.Pagination.NextKey)
+
+		res, err = keeper.GetSupplierSessions(wctx, request(res.Pagination.NextKey, 0, 1, false))
+		require.NoError(t, err)
+		require.Len(t, res.SessionHeaders, 1)
+		require.Equal(t, keepertest.TestApp1Address, res.SessionHeaders[0].ApplicationAddress)
+		require.Nil(t, res.Pagination.NextKey)
+	})
+	t.Run("ByOffset", func(t *testing.T) {
+		res, err := keeper.GetSupplierSessions(wctx, request(nil, 1, 1, true))
+		require.NoError(t, err)
+		require.Len(t, res.SessionHeaders, 1)
+		require.Equal(t, keepertest.TestApp1Address, res.SessionHeaders[0].ApplicationAddress)
+		require.Equal(t, uint64(2), res.Pagination.Total)
+	})
+	t.Run("KeyAndOffset", func(t *testing.T) {
+		_, err := keeper.GetSupplierSessions(wctx, request([]byte(keepertest.TestApp1Address), 1, 1, false))
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "either offset or key is expected, got both"))
+	})
+}
+
+func TestSession_GetSupplierSessions_Failure(t *testing.T) {
+	keeper, ctx := keepertest.SessionKeeper(t)
+	ctx = ctx.WithBlockHeight(100) // provide a sufficiently large block height to avoid errors
+	wctx := sdk.WrapSDKContext(ctx)
+
+	tests := []struct {
+		name string
+
+		supplierAddr string
+		serviceId    string
+		blockHeight  int64
+
+		expectedErr error
+	}{
+		{
+			name: "invalid supplier address",
+
+			supplierAddr: "invalid_address",
+			serviceId:    keepertest.TestServiceId1,
+			blockHeight:  1,
+
+			expectedErr: types.ErrSessionInvalidSupplierAddress,
+		},
+		{
+			name: "invalid service id",
+
+			supplierAddr: keepertest.TestSupplierAddress,
+			serviceId:    "service_id_is_too_long_to_be_valid",
+			blockHeight:  1,
+
+			expectedErr: types.ErrSessionInvalidService,
+		},
+		{
+			name: "negative block height",
+
+			supplierAddr: keepertest.TestSupplierAddress,
+			serviceId:    keepertest.TestServiceId1,
+			blockHeight:  -1,
+
+			expectedErr: types.ErrSessionInvalidBlockHeight,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := types.NewQueryGetSupplierSessionsRequest(tt.supplierAddr, tt.serviceId, tt.blockHeight, nil)
+			_, err := keeper.GetSupplierSessions(wctx, req)
+			require.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
@@ -20,7 +20,7 @@ var SHA3HashLen = crypto.SHA3_256.Size()
 
 // TODO(#21): Make these configurable governance param
 const (
-	NumBlocksPerSession         = 4
+	NumBlocksPerSession         = sharedhelpers.NumBlocksPerSession
 	NumSupplierPerSession       = 15
 	SessionIDComponentDelimiter = "."
 )
@@ -91,13 +91,15 @@ func (k Keeper) HydrateSession(ctx sdk.Context, sh *sessionHydrator) (*types.Ses
 func (k Keeper) hydrateSessionMetadata(ctx sdk.Context, sh *sessionHydrator) error {
 	// TODO_TECHDEBT: Add a test if `blockHeight` is ahead of the current chain or what this node is aware of
 
-	if sh.blockHeight > ctx.BlockHeight() {
+	// The first block of the next session may be requested from the last block
+	// of the current one, so that clients can fetch the next session before it starts.
+	if sh.blockHeight > ctx.BlockHeight() && !isNextSessionStartBlockHeight(ctx, sh.blockHeight) {
 		return sdkerrors.Wrapf(types.ErrSessionHydration, "block height %d is ahead of the current block height %d", sh.blockHeight, ctx.BlockHeight())
 	}
 
 	sh.session.NumBlocksPerSession = NumBlocksPerSession
-	sh.session.SessionNumber = int64(sh.blockHeight / NumBlocksPerSession)
-	sh.sessionHeader.SessionStartBlockHeight = sh.blockHeight - (sh.blockHeight % NumBlocksPerSession)
+	sh.session.SessionNumber = sharedhelpers.GetSessionNumber(sh.blockHeight)
+	sh.sessionHeader.SessionStartBlockHeight = sharedhelpers.GetSessionStartBlockHeight(sh.blockHeight)
 	sh.sessionHeader.SessionEndBlockHeight = sh.sessionHeader.SessionStartBlockHeight + NumBlocksPerSession
 	return nil
 }
@@ -154,17 +156,16 @@ func (k Keeper) hydrateSessionSuppliers(ctx sdk.Context, sh *sessionHydrator) er
 	// only retrieving the suppliers at the current block height which could create a discrepancy
 	// if new suppliers were staked mid session.
 	// TODO(@bryanchriswhite): Investigate if `BlockClient` + `ReplayObservable` where `N = SessionLength` could be used here.`
-	suppliers := k.supplierKeeper.GetAllSupplier(ctx)
-
-	candidateSuppliers := make([]*sharedtypes.Supplier, 0)
-	for _, supplier := range suppliers {
-		// TODO_OPTIMIZE: If `supplier.Services` was a map[string]struct{}, we could eliminate `slices.Contains()`'s loop
-		for _, supplierServiceConfig := range supplier.Services {
-			if supplierServiceConfig.Service.Id == sh.sessionHeader.Service.Id {
-				candidateSuppliers = append(candidateSuppliers, &supplier)
-				break
-			}
-		}
+	var suppliers []sharedtypes.Supplier
+	if sh.sessionHeader.SessionStartBlockHeight > ctx.BlockHeight() {
+		suppliers = k.getNextSessionSuppliersByService(ctx, sh.sessionHeader.Service.Id, sh.session.SessionNumber)
+	} else {
+		suppliers = k.supplierKeeper.GetSuppliersByService(ctx, sh.sessionHeader.Service.Id)
+	}
+
+	candidateSuppliers := make([]*sharedtypes.Supplier, 0, len(suppliers))
+	for i := range suppliers {
+		candidateSuppliers = append(candidateSuppliers, &suppliers[i])
 	}
 
 	if len(candidateSuppliers) == 0 {
@@ -182,6 +183,43 @@ func (k Keeper) hydrateSessionSuppliers(ctx sdk.Context, sh *sessionHydrator) er
 	return nil
 }
 
+// getNextSessionSuppliersByService returns the suppliers which will be staked for
+// the given service in the session of the given number, which starts at the next
+// block. The suppliers' pending service config and operator updates are only
+// applied at the start of that session, so their services and operators are
+// those in effect by then.
+// TODO_OPTIMIZE: Index the suppliers with pending updates by their effective
+// session number instead of iterating over all of them.
+func (k Keeper) getNextSessionSuppliersByService(
+	ctx sdk.Context,
+	serviceId string,
+	sessionNumber int64,
+) (suppliers []sharedtypes.Supplier) {
+	for _, supplier := range k.supplierKeeper.GetAllSupplier(ctx) {
+		services := sharedhelpers.GetSupplierServiceConfigsAtSession(&supplier, sessionNumber)
+		for _, serviceConfig := range services {
+			if serviceConfig.GetService().GetId() != serviceId {
+				continue
+			}
+
+			supplier.Services = services
+			supplier.PendingServiceConfigUpdate = nil
+			supplier.OperatorAddress = sharedhelpers.GetSupplierOperatorAddressAtSession(&supplier, sessionNumber)
+			supplier.PendingOperatorUpdate = nil
+			suppliers = append(suppliers, supplier)
+			break
+		}
+	}
+	return suppliers
+}
+
+// isNextSessionStartBlockHeight returns true if the given block height is the
+// next block and the first one of a session.
+func isNextSessionStartBlockHeight(ctx sdk.Context, blockHeight int64) bool {
+	return blockHeight == ctx.BlockHeight()+1 &&
+		sharedhelpers.GetSessionStartBlockHeight(blockHeight) == blockHeight
+}
+
 // TODO_INVESTIGATE: We are using a `Go` native implementation for a pseudo-random number generator. In order
 // for it to be language agnostic, a general purpose algorithm MUST be used.
 // pseudoRandomSelection returns a random subset of the candidates.
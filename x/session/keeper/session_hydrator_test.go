@@ -43,7 +43,7 @@ func TestSession_HydrateSession_Success_BaseCase(t *testing.T) {
 	suppliers := session.Suppliers
 	require.Len(t, suppliers, 1)
 	supplier := suppliers[0]
-	require.Equal(t, keepertest.TestSupplierAddress, supplier.Address)
+	require.Equal(t, keepertest.TestSupplierAddress, supplier.OperatorAddress)
 	require.Len(t, supplier.Services, 3)
 }
 
@@ -106,6 +106,12 @@ func TestSession_HydrateSession_Metadata(t *testing.T) {
 			desc:        "blockHeight > contextHeight",
 			blockHeight: 9001, // block height over 9000 is too high given that the context height is 100
 
+			errExpected: types.ErrSessionHydration,
+		},
+		{
+			desc:        "blockHeight = contextHeight + 1 != sessionHeight",
+			blockHeight: 101, // only the start of the next session can be requested ahead of the context height
+
 			errExpected: types.ErrSessionHydration,
 		},
 	}
@@ -354,3 +360,72 @@ func TestSession_HydrateSession_Suppliers(t *testing.T) {
 		require.Len(t, session.Suppliers, tt.numExpectedSuppliers)
 	}
 }
+
+func TestSession_HydrateSession_NextSession(t *testing.T) {
+	type test struct {
+		desc        string
+		blockHeight int64
+		serviceId   string
+
+		expectedSupplierAddr string
+		expectedErr          error
+	}
+
+	// TestSupplier2 switches from svc3 to svc22, and to its pending operator, at
+	// the start of its pending updates' session.
+	nextSessionStartHeight := keepertest.TestSupplier2PendingUpdateSessionNumber * keeper.NumBlocksPerSession
+	tests := []test{
+		{
+			desc:        "current session uses the current services",
+			blockHeight: nextSessionStartHeight - 1,
+			serviceId:   keepertest.TestServiceId3,
+
+			expectedSupplierAddr: keepertest.TestSupplier2Address,
+		},
+		{
+			desc:        "next session uses the services and operator in effect at its start",
+			blockHeight: nextSessionStartHeight,
+			serviceId:   keepertest.TestServiceId22,
+
+			expectedSupplierAddr: keepertest.TestSupplier2PendingOperatorAddress,
+		},
+		{
+			desc:        "next session excludes the services replaced at its start",
+			blockHeight: nextSessionStartHeight,
+			serviceId:   keepertest.TestServiceId3,
+
+			expectedErr: types.ErrSessionSuppliersNotFound,
+		},
+		{
+			desc:        "heights past the start of the next session are rejected",
+			blockHeight: nextSessionStartHeight + 1,
+			serviceId:   keepertest.TestServiceId22,
+
+			expectedErr: types.ErrSessionHydration,
+		},
+	}
+
+	sessionKeeper, ctx := keepertest.SessionKeeper(t)
+	// The context is at the last block of the session preceding the pending update.
+	ctx = ctx.WithBlockHeight(nextSessionStartHeight - 1)
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			sessionHydrator := keeper.NewSessionHydrator(keepertest.TestApp2Address, tt.serviceId, tt.blockHeight)
+			session, err := sessionKeeper.HydrateSession(ctx, sessionHydrator)
+			if tt.expectedErr != nil {
+				require.ErrorContains(t, err, tt.expectedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+
+			require.Len(t, session.Suppliers, 1)
+			supplier := session.Suppliers[0]
+			require.Equal(t, tt.expectedSupplierAddr, supplier.OperatorAddress)
+			require.Len(t, supplier.Services, 1)
+			require.Equal(t, tt.serviceId, supplier.Services[0].Service.Id)
+			require.Nil(t, supplier.PendingServiceConfigUpdate)
+			require.Nil(t, supplier.PendingOperatorUpdate)
+		})
+	}
+}
@@ -21,9 +21,11 @@ type BankKeeper interface{}
 // ApplicationKeeper defines the expected application keeper to retrieve applications
 type ApplicationKeeper interface {
 	GetApplication(ctx sdk.Context, address string) (app apptypes.Application, found bool)
+	GetApplicationsByService(ctx sdk.Context, serviceId string) []apptypes.Application
 }
 
 // SupplierKeeper defines the expected supplier keeper to retrieve suppliers
 type SupplierKeeper interface {
+	GetSuppliersByService(ctx sdk.Context, serviceId string) (suppliers []sharedtypes.Supplier)
 	GetAllSupplier(ctx sdk.Context) (suppliers []sharedtypes.Supplier)
 }
@@ -0,0 +1,45 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	sharedhelpers "github.com/pokt-network/poktroll/x/shared/helpers"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+)
+
+// NOTE: Like `QueryGetSessionRequest`, `QueryGetSupplierSessionsRequest` is not a `sdk.Msg`
+// but follows the `ValidateBasic` pattern to localize & reuse validation logic.
+func NewQueryGetSupplierSessionsRequest(
+	supplierAddress, serviceId string,
+	blockHeight int64,
+	pageReq *query.PageRequest,
+) *QueryGetSupplierSessionsRequest {
+	return &QueryGetSupplierSessionsRequest{
+		SupplierAddress: supplierAddress,
+		Service: &sharedtypes.Service{
+			Id: serviceId,
+		},
+		BlockHeight: blockHeight,
+		Pagination:  pageReq,
+	}
+}
+
+func (query *QueryGetSupplierSessionsRequest) ValidateBasic() error {
+	// Validate the supplier address
+	if _, err := sdk.AccAddressFromBech32(query.SupplierAddress); err != nil {
+		return sdkerrors.Wrapf(ErrSessionInvalidSupplierAddress, "invalid supplier address for sessions being retrieved %s; (%v)", query.SupplierAddress, err)
+	}
+
+	// Validate the Service ID
+	if !sharedhelpers.IsValidService(query.Service) {
+		return sdkerrors.Wrapf(ErrSessionInvalidService, "invalid service for sessions being retrieved %s;", query.Service)
+	}
+
+	// Validate the height for which the sessions are being retrieved
+	if query.BlockHeight < 0 { // Note that `0` defaults to the latest height rather than genesis
+		return sdkerrors.Wrapf(ErrSessionInvalidBlockHeight, "invalid block height for sessions being retrieved %d;", query.BlockHeight)
+	}
+	return nil
+}
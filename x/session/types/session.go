@@ -0,0 +1,19 @@
+package types
+
+// IsEnded returns true if the given height is past the last block of the
+// session. A session whose number of blocks is unknown never ends.
+func (session *Session) IsEnded(height int64) bool {
+	numBlocksPerSession := session.GetNumBlocksPerSession()
+	if numBlocksPerSession <= 0 {
+		return false
+	}
+
+	startHeight := session.GetHeader().GetSessionStartBlockHeight()
+	return height >= startHeight+numBlocksPerSession
+}
+
+// ContainsHeight returns true if the given height is part of the session.
+func (session *Session) ContainsHeight(height int64) bool {
+	startHeight := session.GetHeader().GetSessionStartBlockHeight()
+	return height >= startHeight && !session.IsEnded(height)
+}
@@ -0,0 +1,77 @@
+package helpers
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// ActorStake is the stake of a staked actor, keyed by the actor's address.
+type ActorStake struct {
+	Address string
+	Stake   *sdk.Coin
+}
+
+// GetActorStakesFn returns the stakes of all the actors staked in a module.
+type GetActorStakesFn func(ctx sdk.Context) []ActorStake
+
+// BalanceBankKeeper defines the bank keeper method which staked actors' modules
+// use to check the balance of their module account.
+type BalanceBankKeeper interface {
+	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+}
+
+// ModuleAccountBalanceInvariant returns an invariant of the module with the
+// given name which checks that the balance of its module account is the sum of
+// the stakes of its actors, named actorName.
+func ModuleAccountBalanceInvariant(
+	moduleName string,
+	actorName string,
+	bankKeeper BalanceBankKeeper,
+	getActorStakes GetActorStakesFn,
+) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		stakes := sdk.NewCoins()
+		for _, actorStake := range getActorStakes(ctx) {
+			if actorStake.Stake != nil {
+				stakes = stakes.Add(*actorStake.Stake)
+			}
+		}
+
+		balance := bankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(moduleName))
+		broken := !balance.IsAllGTE(stakes) || !stakes.IsAllGTE(balance)
+
+		return sdk.FormatInvariant(
+			moduleName, "module account balance",
+			fmt.Sprintf("\tsum of %s stakes: %v\n\tmodule account balance: %v\n", actorName, stakes, balance),
+		), broken
+	}
+}
+
+// PositiveStakeInvariant returns an invariant of the module with the given name
+// which checks that every one of its actors, named actorName, has a positive
+// stake.
+func PositiveStakeInvariant(
+	moduleName string,
+	actorName string,
+	getActorStakes GetActorStakesFn,
+) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg             string
+			numBrokenActors int
+		)
+		for _, actorStake := range getActorStakes(ctx) {
+			if actorStake.Stake == nil || !actorStake.Stake.IsPositive() {
+				numBrokenActors++
+				msg += fmt.Sprintf("\t%s %s has a non-positive stake: %v\n", actorName, actorStake.Address, actorStake.Stake)
+			}
+		}
+
+		return sdk.FormatInvariant(
+			moduleName, "positive stake",
+			fmt.Sprintf("found %d %ss with a non-positive stake\n%s", numBrokenActors, actorName, msg),
+		), numBrokenActors != 0
+	}
+}
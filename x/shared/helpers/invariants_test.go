@@ -0,0 +1,118 @@
+package helpers
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/testutil/sample"
+)
+
+func TestModuleAccountBalanceInvariant(t *testing.T) {
+	const moduleName = "testmodule"
+
+	stake := sdk.NewCoin("upokt", sdk.NewInt(100))
+	actorStakes := []ActorStake{
+		{Address: sample.AccAddress(), Stake: &stake},
+		{Address: sample.AccAddress(), Stake: &stake},
+	}
+	getActorStakes := func(sdk.Context) []ActorStake { return actorStakes }
+
+	tests := []struct {
+		desc           string
+		balance        sdk.Coins
+		expectedBroken bool
+	}{
+		{
+			desc:    "balance equal to the sum of stakes",
+			balance: sdk.NewCoins(sdk.NewCoin("upokt", sdk.NewInt(200))),
+		},
+		{
+			desc:           "balance lower than the sum of stakes",
+			balance:        sdk.NewCoins(sdk.NewCoin("upokt", sdk.NewInt(100))),
+			expectedBroken: true,
+		},
+		{
+			desc:           "balance higher than the sum of stakes",
+			balance:        sdk.NewCoins(sdk.NewCoin("upokt", sdk.NewInt(300))),
+			expectedBroken: true,
+		},
+		{
+			desc:           "balance of another denom",
+			balance:        sdk.NewCoins(sdk.NewCoin("upokt", sdk.NewInt(200)), sdk.NewCoin("otherdenom", sdk.NewInt(1))),
+			expectedBroken: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			bankKeeper := &fakeBalanceBankKeeper{
+				moduleAddr: authtypes.NewModuleAddress(moduleName),
+				balance:    test.balance,
+			}
+
+			_, isBroken := ModuleAccountBalanceInvariant(moduleName, "actor", bankKeeper, getActorStakes)(sdk.Context{})
+			require.Equal(t, test.expectedBroken, isBroken)
+		})
+	}
+}
+
+func TestPositiveStakeInvariant(t *testing.T) {
+	stake := sdk.NewCoin("upokt", sdk.NewInt(100))
+	zeroStake := sdk.NewCoin("upokt", sdk.ZeroInt())
+
+	tests := []struct {
+		desc           string
+		stake          *sdk.Coin
+		expectedBroken bool
+	}{
+		{
+			desc:  "positive stake",
+			stake: &stake,
+		},
+		{
+			desc:           "zero stake",
+			stake:          &zeroStake,
+			expectedBroken: true,
+		},
+		{
+			desc:           "unset stake",
+			stake:          nil,
+			expectedBroken: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			brokenAddr := sample.AccAddress()
+			getActorStakes := func(sdk.Context) []ActorStake {
+				return []ActorStake{
+					{Address: sample.AccAddress(), Stake: &stake},
+					{Address: brokenAddr, Stake: test.stake},
+				}
+			}
+
+			msg, isBroken := PositiveStakeInvariant("testmodule", "actor", getActorStakes)(sdk.Context{})
+			require.Equal(t, test.expectedBroken, isBroken)
+			if test.expectedBroken {
+				require.Contains(t, msg, brokenAddr)
+			}
+		})
+	}
+}
+
+// fakeBalanceBankKeeper is a BalanceBankKeeper which holds the given balance
+// in the account with the given module address only.
+type fakeBalanceBankKeeper struct {
+	moduleAddr sdk.AccAddress
+	balance    sdk.Coins
+}
+
+func (bk *fakeBalanceBankKeeper) GetAllBalances(_ sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	if !addr.Equals(bk.moduleAddr) {
+		return sdk.NewCoins()
+	}
+	return bk.balance
+}
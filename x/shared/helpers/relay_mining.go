@@ -0,0 +1,55 @@
+package helpers
+
+import "math/big"
+
+// MaxRelayDifficultyBits is the greatest relay mining difficulty, which is the
+// length, in bits, of the relay hashes that difficulty is measured on (sha256).
+const MaxRelayDifficultyBits = 256
+
+// NumRelaysPerMinedRelay returns the number of relays which each mined relay
+// accounts for, on average, given the relay mining difficulty which it was
+// mined at. A relay hash has at least difficultyBits leading zero bits with a
+// probability of 1/2^difficultyBits.
+func NumRelaysPerMinedRelay(difficultyBits uint64) *big.Int {
+	return EstimatedNumRelays(1, difficultyBits)
+}
+
+// EstimatedNumRelays returns the estimated number of relays which were serviced,
+// given the number of them which were mined at the given relay mining difficulty.
+//
+// On-chain settlement scales rewards and burns by the estimated number of
+// relays rather than by the number of mined relays, such that suppliers are
+// compensated for the relays which they serviced but didn't mine.
+func EstimatedNumRelays(numMinedRelays, difficultyBits uint64) *big.Int {
+	numMinedRelaysBig := new(big.Int).SetUint64(numMinedRelays)
+	return numMinedRelaysBig.Lsh(numMinedRelaysBig, uint(difficultyBits))
+}
+
+// RetargetRelayDifficultyBits returns the greatest relay mining difficulty at
+// which no fewer than targetNumMinedRelays relays would have been mined, given
+// that numMinedRelays were mined at the current difficulty. If the estimated
+// number of relays doesn't exceed the target, every relay should be mined and
+// the difficulty is 0. The current difficulty is returned if the target is 0.
+func RetargetRelayDifficultyBits(
+	numMinedRelays uint64,
+	currentDifficultyBits uint64,
+	targetNumMinedRelays uint64,
+) uint64 {
+	if targetNumMinedRelays == 0 {
+		return currentDifficultyBits
+	}
+
+	// The difficulty is floor(log2(estimatedNumRelays / targetNumMinedRelays)).
+	ratio := EstimatedNumRelays(numMinedRelays, currentDifficultyBits)
+	ratio.Quo(ratio, new(big.Int).SetUint64(targetNumMinedRelays))
+	if ratio.Sign() == 0 {
+		return 0
+	}
+
+	difficultyBits := uint64(ratio.BitLen() - 1)
+	if difficultyBits > MaxRelayDifficultyBits {
+		return MaxRelayDifficultyBits
+	}
+
+	return difficultyBits
+}
@@ -0,0 +1,110 @@
+package helpers
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetargetRelayDifficultyBits(t *testing.T) {
+	tests := []struct {
+		desc string
+
+		numMinedRelays        uint64
+		currentDifficultyBits uint64
+		targetNumMinedRelays  uint64
+
+		expectedDifficultyBits uint64
+	}{
+		{
+			desc: "estimated relays at target mines every relay",
+
+			numMinedRelays:        1000,
+			currentDifficultyBits: 0,
+			targetNumMinedRelays:  1000,
+
+			expectedDifficultyBits: 0,
+		},
+		{
+			desc: "estimated relays below target mines every relay",
+
+			numMinedRelays:        10,
+			currentDifficultyBits: 4,
+			targetNumMinedRelays:  1000,
+
+			expectedDifficultyBits: 0,
+		},
+		{
+			desc: "difficulty increases with relay volume",
+
+			numMinedRelays:        1024,
+			currentDifficultyBits: 0,
+			targetNumMinedRelays:  256,
+
+			expectedDifficultyBits: 2,
+		},
+		{
+			desc: "difficulty is rounded down",
+
+			numMinedRelays:        1000,
+			currentDifficultyBits: 0,
+			targetNumMinedRelays:  256,
+
+			expectedDifficultyBits: 1,
+		},
+		{
+			desc: "difficulty decreases with relay volume",
+
+			numMinedRelays:        64,
+			currentDifficultyBits: 8,
+			targetNumMinedRelays:  1024,
+
+			expectedDifficultyBits: 4,
+		},
+		{
+			desc: "no mined relays mines every relay",
+
+			numMinedRelays:        0,
+			currentDifficultyBits: 8,
+			targetNumMinedRelays:  1024,
+
+			expectedDifficultyBits: 0,
+		},
+		{
+			desc: "zero target keeps current difficulty",
+
+			numMinedRelays:        1024,
+			currentDifficultyBits: 8,
+			targetNumMinedRelays:  0,
+
+			expectedDifficultyBits: 8,
+		},
+		{
+			desc: "difficulty is capped",
+
+			numMinedRelays:        math.MaxUint64,
+			currentDifficultyBits: MaxRelayDifficultyBits,
+			targetNumMinedRelays:  1,
+
+			expectedDifficultyBits: MaxRelayDifficultyBits,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			difficultyBits := RetargetRelayDifficultyBits(
+				tt.numMinedRelays,
+				tt.currentDifficultyBits,
+				tt.targetNumMinedRelays,
+			)
+			require.Equal(t, tt.expectedDifficultyBits, difficultyBits)
+		})
+	}
+}
+
+func TestEstimatedNumRelays(t *testing.T) {
+	require.Equal(t, uint64(10), EstimatedNumRelays(10, 0).Uint64())
+	require.Equal(t, uint64(160), EstimatedNumRelays(10, 4).Uint64())
+	require.Equal(t, uint64(1<<20), NumRelaysPerMinedRelay(20).Uint64())
+}
@@ -0,0 +1,59 @@
+package helpers
+
+import (
+	"fmt"
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ComputeUnitsToTokensMultiplier is the number of uPOKT which an application
+	// is charged for each compute unit of the relays it is serviced.
+	// TODO_BLOCKER: Make this a governance parameter.
+	ComputeUnitsToTokensMultiplier = 1
+
+	// ComputeUnitsPerRelay is the number of compute units which every relay
+	// accounts for. Both the RelayMiner, when reserving the relay quota of an
+	// application, and the supplier module, when settling claims, MUST use it
+	// such that the supplier is never billed for relays it can't be paid for.
+	// TODO_FUTURE: Weigh mined relays by the compute units of their requests
+	// once the session SMST accounts for them.
+	ComputeUnitsPerRelay = 1
+
+	// appStakeDenom is the denomination of application stakes.
+	appStakeDenom = "upokt"
+)
+
+// MaxSessionComputeUnits returns the maximum number of compute units which each
+// supplier in a session can bill the application for, given the application's
+// stake and the number of suppliers in the session. The stake is split evenly
+// between the suppliers such that, together, they can never bill the application
+// for more than it has staked.
+//
+// Both the RelayMiner, when deciding whether to serve a relay, and the supplier
+// module, when settling claims, MUST use this function such that they agree on
+// the quota.
+func MaxSessionComputeUnits(appStake *sdk.Coin, numSuppliers int) (uint64, error) {
+	if appStake == nil {
+		return 0, fmt.Errorf("application stake cannot be nil")
+	}
+	if appStake.Denom != appStakeDenom {
+		return 0, fmt.Errorf("invalid application stake denom: expected %q, got %q", appStakeDenom, appStake.Denom)
+	}
+	if appStake.Amount.IsNegative() {
+		return 0, fmt.Errorf("application stake cannot be negative: %s", appStake)
+	}
+	if numSuppliers <= 0 {
+		return 0, fmt.Errorf("number of suppliers in session must be positive, got %d", numSuppliers)
+	}
+
+	maxComputeUnits := appStake.Amount.
+		QuoRaw(ComputeUnitsToTokensMultiplier).
+		QuoRaw(int64(numSuppliers))
+	if !maxComputeUnits.IsUint64() {
+		return math.MaxUint64, nil
+	}
+
+	return maxComputeUnits.Uint64(), nil
+}
@@ -0,0 +1,92 @@
+package helpers
+
+import (
+	"math"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaxSessionComputeUnits(t *testing.T) {
+	tests := []struct {
+		desc string
+
+		appStake     *sdk.Coin
+		numSuppliers int
+
+		expectedMaxComputeUnits uint64
+		expectedErr             bool
+	}{
+		{
+			desc: "stake is split evenly between suppliers",
+
+			appStake:     &sdk.Coin{Denom: "upokt", Amount: sdkmath.NewInt(1000)},
+			numSuppliers: 4,
+
+			expectedMaxComputeUnits: 250,
+		},
+		{
+			desc: "remainder is rounded down",
+
+			appStake:     &sdk.Coin{Denom: "upokt", Amount: sdkmath.NewInt(1000)},
+			numSuppliers: 3,
+
+			expectedMaxComputeUnits: 333,
+		},
+		{
+			desc: "zero stake has no quota",
+
+			appStake:     &sdk.Coin{Denom: "upokt", Amount: sdkmath.ZeroInt()},
+			numSuppliers: 1,
+
+			expectedMaxComputeUnits: 0,
+		},
+		{
+			desc: "quota is capped at max uint64",
+
+			appStake:     &sdk.Coin{Denom: "upokt", Amount: sdkmath.NewIntFromUint64(math.MaxUint64).MulRaw(2)},
+			numSuppliers: 1,
+
+			expectedMaxComputeUnits: math.MaxUint64,
+		},
+		{
+			desc: "nil stake is invalid",
+
+			appStake:     nil,
+			numSuppliers: 1,
+
+			expectedErr: true,
+		},
+		{
+			desc: "invalid denom",
+
+			appStake:     &sdk.Coin{Denom: "invalid", Amount: sdkmath.NewInt(1000)},
+			numSuppliers: 1,
+
+			expectedErr: true,
+		},
+		{
+			desc: "no suppliers",
+
+			appStake:     &sdk.Coin{Denom: "upokt", Amount: sdkmath.NewInt(1000)},
+			numSuppliers: 0,
+
+			expectedErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			maxComputeUnits, err := MaxSessionComputeUnits(test.appStake, test.numSuppliers)
+			if test.expectedErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, test.expectedMaxComputeUnits, maxComputeUnits)
+		})
+	}
+}
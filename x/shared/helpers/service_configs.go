@@ -79,3 +79,17 @@ func ValidateSupplierServiceConfigs(services []*sharedtypes.SupplierServiceConfi
 	}
 	return nil
 }
+
+// GetSupplierServiceConfigsAtSession returns the service configs of the given
+// supplier which are in effect during the session of the given number; i.e. its
+// pending service configs if they take effect by then, its current ones otherwise.
+func GetSupplierServiceConfigsAtSession(
+	supplier *sharedtypes.Supplier,
+	sessionNumber int64,
+) []*sharedtypes.SupplierServiceConfig {
+	pendingUpdate := supplier.GetPendingServiceConfigUpdate()
+	if pendingUpdate == nil || pendingUpdate.GetEffectiveSessionNumber() > sessionNumber {
+		return supplier.GetServices()
+	}
+	return pendingUpdate.GetServices()
+}
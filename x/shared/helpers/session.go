@@ -0,0 +1,17 @@
+package helpers
+
+// NumBlocksPerSession is the number of blocks which make up a session.
+// TODO(#21): Make this a configurable governance param
+const NumBlocksPerSession = 4
+
+// GetSessionNumber returns the number of the session which the given block
+// height is part of.
+func GetSessionNumber(blockHeight int64) int64 {
+	return blockHeight / NumBlocksPerSession
+}
+
+// GetSessionStartBlockHeight returns the height of the first block of the
+// session which the given block height is part of.
+func GetSessionStartBlockHeight(blockHeight int64) int64 {
+	return blockHeight - (blockHeight % NumBlocksPerSession)
+}
@@ -0,0 +1,68 @@
+package helpers
+
+import (
+	"fmt"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// StakeBankKeeper defines the bank keeper method which staked actors' modules
+// use to return stake from their module account.
+type StakeBankKeeper interface {
+	UndelegateCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// ValidateMinStake returns an error if the given MinStake param is set but
+// isn't a valid uPOKT amount. The type of the param is checked as well, so it
+// can be used as the validator of its param set pair.
+func ValidateMinStake(i interface{}) error {
+	minStake, ok := i.(*sdk.Coin)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if minStake == nil {
+		return nil
+	}
+	if !minStake.IsValid() {
+		return fmt.Errorf("MinStake param is invalid: got %v", minStake)
+	}
+	if minStake.Denom != "upokt" {
+		return fmt.Errorf("MinStake param denom must be upokt: got %v", minStake)
+	}
+	return nil
+}
+
+// DecreaseStake returns the amount by which the given current stake exceeds
+// the given new stake to the account with the given address, from the account
+// of the module with the given name. The new stake must be lower than the
+// current one, and valid according to validateStake; errInvalidStake is
+// returned otherwise.
+func DecreaseStake(
+	ctx sdk.Context,
+	bankKeeper StakeBankKeeper,
+	moduleName string,
+	address string,
+	currentStake sdk.Coin,
+	newStake sdk.Coin,
+	validateStake func(sdk.Coin) error,
+	errInvalidStake *sdkerrors.Error,
+) error {
+	if newStake.IsGTE(currentStake) {
+		return errInvalidStake.Wrapf("stake amount %v must be lower than current stake amount %v", newStake, currentStake)
+	}
+	if err := validateStake(newStake); err != nil {
+		return err
+	}
+
+	accAddress, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return err
+	}
+
+	coinsToUnbond := currentStake.Sub(newStake)
+	if err := bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, moduleName, accAddress, []sdk.Coin{coinsToUnbond}); err != nil {
+		return fmt.Errorf("could not send %v coins from %s module to %s account: %w", coinsToUnbond, moduleName, address, err)
+	}
+	return nil
+}
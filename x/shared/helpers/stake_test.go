@@ -0,0 +1,153 @@
+package helpers
+
+import (
+	"errors"
+	"testing"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/testutil/sample"
+)
+
+var (
+	errTestInvalidStake = sdkerrors.Register("helpers_test", 1, "invalid stake")
+	errTestBank         = errors.New("insufficient module account balance")
+)
+
+func TestValidateMinStake(t *testing.T) {
+	validMinStake := sdk.NewCoin("upokt", sdk.NewInt(100))
+	otherDenomMinStake := sdk.NewCoin("otherdenom", sdk.NewInt(100))
+
+	tests := []struct {
+		desc        string
+		minStake    interface{}
+		expectedErr bool
+	}{
+		{
+			desc:     "valid min stake",
+			minStake: &validMinStake,
+		},
+		{
+			desc:     "unset min stake",
+			minStake: (*sdk.Coin)(nil),
+		},
+		{
+			desc:        "invalid denom",
+			minStake:    &otherDenomMinStake,
+			expectedErr: true,
+		},
+		{
+			desc:        "invalid type",
+			minStake:    validMinStake,
+			expectedErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			err := ValidateMinStake(test.minStake)
+			if test.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestDecreaseStake(t *testing.T) {
+	const moduleName = "testmodule"
+
+	currentStake := sdk.NewCoin("upokt", sdk.NewInt(100))
+	minStake := sdk.NewCoin("upokt", sdk.NewInt(50))
+	validateStake := func(stake sdk.Coin) error {
+		if stake.IsLT(minStake) {
+			return errTestInvalidStake.Wrapf("stake %v is lower than %v", stake, minStake)
+		}
+		return nil
+	}
+
+	tests := []struct {
+		desc        string
+		newStake    sdk.Coin
+		bankErr     error
+		expectedErr error
+	}{
+		{
+			desc:     "lower stake",
+			newStake: sdk.NewCoin("upokt", sdk.NewInt(60)),
+		},
+		{
+			desc:        "same stake",
+			newStake:    currentStake,
+			expectedErr: errTestInvalidStake,
+		},
+		{
+			desc:        "higher stake",
+			newStake:    sdk.NewCoin("upokt", sdk.NewInt(200)),
+			expectedErr: errTestInvalidStake,
+		},
+		{
+			desc:        "stake below the minimum stake",
+			newStake:    sdk.NewCoin("upokt", sdk.NewInt(40)),
+			expectedErr: errTestInvalidStake,
+		},
+		{
+			desc:        "bank keeper failure",
+			newStake:    sdk.NewCoin("upokt", sdk.NewInt(60)),
+			bankErr:     errTestBank,
+			expectedErr: errTestBank,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			address := sample.AccAddress()
+			bankKeeper := &fakeStakeBankKeeper{err: test.bankErr}
+
+			err := DecreaseStake(
+				sdk.Context{}, bankKeeper, moduleName, address,
+				currentStake, test.newStake, validateStake, errTestInvalidStake,
+			)
+			if test.expectedErr != nil {
+				require.ErrorIs(t, err, test.expectedErr)
+				require.Empty(t, bankKeeper.sentCoins)
+				return
+			}
+			require.NoError(t, err)
+
+			// Only the difference with the current stake is returned.
+			require.Equal(t, moduleName, bankKeeper.senderModule)
+			require.Equal(t, address, bankKeeper.recipientAddr.String())
+			require.Equal(t, sdk.NewCoins(currentStake.Sub(test.newStake)), bankKeeper.sentCoins)
+		})
+	}
+}
+
+// fakeStakeBankKeeper is a StakeBankKeeper which records the coins it is asked
+// to send, failing with err if it is set.
+type fakeStakeBankKeeper struct {
+	err error
+
+	senderModule  string
+	recipientAddr sdk.AccAddress
+	sentCoins     sdk.Coins
+}
+
+func (bk *fakeStakeBankKeeper) UndelegateCoinsFromModuleToAccount(
+	_ sdk.Context,
+	senderModule string,
+	recipientAddr sdk.AccAddress,
+	amt sdk.Coins,
+) error {
+	if bk.err != nil {
+		return bk.err
+	}
+
+	bk.senderModule = senderModule
+	bk.recipientAddr = recipientAddr
+	bk.sentCoins = amt
+	return nil
+}
@@ -0,0 +1,19 @@
+package helpers
+
+import (
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+)
+
+// GetSupplierOperatorAddressAtSession returns the operator address of the given
+// supplier which is in effect during the session of the given number; i.e. its
+// pending operator address if it takes effect by then, its current one otherwise.
+func GetSupplierOperatorAddressAtSession(
+	supplier *sharedtypes.Supplier,
+	sessionNumber int64,
+) string {
+	pendingUpdate := supplier.GetPendingOperatorUpdate()
+	if pendingUpdate == nil || pendingUpdate.GetEffectiveSessionNumber() > sessionNumber {
+		return supplier.GetOperatorAddress()
+	}
+	return pendingUpdate.GetNewOperatorAddress()
+}
@@ -0,0 +1,106 @@
+package simulation
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+
+	sdkmath "cosmossdk.io/math"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+)
+
+// StakeDenom is the denomination which actors are staked with.
+const StakeDenom = "upokt"
+
+// Services are the services which applications and suppliers are staked for in
+// simulations. They are few such that applications and suppliers end up sharing
+// sessions.
+var Services = []*sharedtypes.Service{
+	{Id: "svc1", Name: "simulation service 1"},
+	{Id: "svc2", Name: "simulation service 2"},
+	{Id: "svc3", Name: "simulation service 3"},
+}
+
+// RandomService returns one of the simulation services at random.
+func RandomService(r *rand.Rand) *sharedtypes.Service {
+	return Services[r.Intn(len(Services))]
+}
+
+// RandomStake returns a random stake, which is greater than the given current
+// stake (nil for actors which aren't staked yet) and at least the given minimum
+// stake, such that the coins it adds to the current stake are spendable. It
+// returns false if the spendable coins are insufficient.
+func RandomStake(
+	r *rand.Rand,
+	spendable sdk.Coins,
+	currStake *sdk.Coin,
+	minStake *sdk.Coin,
+) (sdk.Coin, bool) {
+	currAmount := sdkmath.ZeroInt()
+	if currStake != nil {
+		currAmount = currStake.Amount
+	}
+
+	// The stake must increase by at least one and reach the minimum stake.
+	minIncrease := sdkmath.OneInt()
+	if minStake != nil && minStake.Amount.Sub(currAmount).GT(minIncrease) {
+		minIncrease = minStake.Amount.Sub(currAmount)
+	}
+
+	spendableAmount := spendable.AmountOf(StakeDenom)
+	if spendableAmount.LT(minIncrease) {
+		return sdk.Coin{}, false
+	}
+
+	increase := minIncrease.Add(simtypes.RandomAmount(r, spendableAmount.Sub(minIncrease)))
+	return sdk.NewCoin(StakeDenom, currAmount.Add(increase)), true
+}
+
+// GenAndDeliverTxWithExpectedError generates a transaction with a random fee
+// and delivers it, expecting its message to be rejected with the given error.
+// It returns an error if the transaction is delivered successfully, or rejected
+// for any other reason.
+func GenAndDeliverTxWithExpectedError(
+	txCtx simulation.OperationInput,
+	expectedErr error,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	account := txCtx.AccountKeeper.GetAccount(txCtx.Context, txCtx.SimAccount.Address)
+	spendable := txCtx.Bankkeeper.SpendableCoins(txCtx.Context, account.GetAddress())
+
+	fees, err := simtypes.RandomFees(txCtx.R, txCtx.Context, spendable)
+	if err != nil {
+		return simtypes.NoOpMsg(txCtx.ModuleName, txCtx.MsgType, "unable to generate fees"), nil, err
+	}
+
+	tx, err := simtestutil.GenSignedMockTx(
+		txCtx.R,
+		txCtx.TxGen,
+		[]sdk.Msg{txCtx.Msg},
+		fees,
+		simtestutil.DefaultGenTxGas,
+		txCtx.Context.ChainID(),
+		[]uint64{account.GetAccountNumber()},
+		[]uint64{account.GetSequence()},
+		txCtx.SimAccount.PrivKey,
+	)
+	if err != nil {
+		return simtypes.NoOpMsg(txCtx.ModuleName, txCtx.MsgType, "unable to generate mock tx"), nil, err
+	}
+
+	_, _, err = txCtx.App.SimDeliver(txCtx.TxGen.TxEncoder(), tx)
+	if err == nil {
+		return simtypes.NoOpMsg(txCtx.ModuleName, txCtx.MsgType, "tx was expected to fail"), nil,
+			fmt.Errorf("expected %s tx to fail with %q", txCtx.MsgType, expectedErr)
+	}
+	if !errors.Is(err, expectedErr) {
+		return simtypes.NoOpMsg(txCtx.ModuleName, txCtx.MsgType, "tx failed unexpectedly"), nil,
+			fmt.Errorf("expected %s tx to fail with %q, got: %w", txCtx.MsgType, expectedErr, err)
+	}
+
+	return simtypes.NewOperationMsg(txCtx.Msg, false, err.Error(), txCtx.Cdc), nil, nil
+}
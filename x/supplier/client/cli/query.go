@@ -27,6 +27,7 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdQueryParams())
 	cmd.AddCommand(CmdListSupplier())
 	cmd.AddCommand(CmdShowSupplier())
+	cmd.AddCommand(CmdListSuppliersByService())
 	cmd.AddCommand(CmdListClaims())
 	cmd.AddCommand(CmdShowClaim())
 	// this line is used by starport scaffolding # 1
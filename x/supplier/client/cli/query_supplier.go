@@ -44,6 +44,44 @@ func CmdListSupplier() *cobra.Command {
 	return cmd
 }
 
+func CmdListSuppliersByService() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-suppliers-by-service <service_id>",
+		Short: "list all suppliers staked for a service",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QuerySuppliersByServiceRequest{
+				ServiceId:  args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.SuppliersByService(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func CmdShowSupplier() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "show-supplier <supplier_address>",
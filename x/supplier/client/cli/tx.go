@@ -16,6 +16,7 @@ var (
 
 const (
 	flagPacketTimeoutTimestamp = "packet-timeout-timestamp"
+	flagOperatorAddress        = "operator-address"
 	listSeparator              = ","
 )
 
@@ -33,6 +34,9 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdUnstakeSupplier())
 	cmd.AddCommand(CmdCreateClaim())
 	cmd.AddCommand(CmdSubmitProof())
+	cmd.AddCommand(CmdUpdateSupplierServices())
+	cmd.AddCommand(CmdDecreaseSupplierStake())
+	cmd.AddCommand(CmdUpdateSupplierOperator())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -0,0 +1,55 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/pokt-network/poktroll/x/supplier/types"
+)
+
+func CmdDecreaseSupplierStake() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "decrease-supplier-stake <upokt_amount>",
+		Short: "Decrease the stake of a supplier",
+		Long: `Decrease the stake of a supplier to the provided amount without unstaking it. This is a broadcast operation
+that will return the tokens in excess of the provided amount to the 'from' address, which must own the supplier
+operated by the --operator-address address, or by the 'from' address if none is provided.
+The provided amount must be lower than the current stake and at least the minimum stake.
+
+Example:
+$ poktrolld --home=$(POKTROLLD_HOME) tx supplier decrease-supplier-stake 500upokt --keyring-backend test --from $(SUPPLIER) --node $(POCKET_NODE)`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			stakeString := args[0]
+			stake, err := sdk.ParseCoinNormalized(stakeString)
+			if err != nil {
+				return err
+			}
+			operatorAddress, err := cmd.Flags().GetString(flagOperatorAddress)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgDecreaseSupplierStake(
+				clientCtx.GetFromAddress().String(),
+				operatorAddress,
+				stake,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagOperatorAddress, "", "Bech32 address of the supplier's operator; defaults to the 'from' address")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
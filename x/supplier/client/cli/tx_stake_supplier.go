@@ -25,10 +25,13 @@ func CmdStakeSupplier() *cobra.Command {
 		Use:   "stake-supplier <upokt_amount> --config <config_file.yaml>",
 		Short: "Stake a supplier",
 		Long: `Stake an supplier with the provided parameters. This is a broadcast operation that
-will stake the tokens and associate them with the supplier specified by the 'from' address.
+will stake the tokens of the 'from' address, which owns the supplier, and associate them with
+the supplier operated by the --operator-address address. The owner also operates the supplier
+if no operator address is provided. Otherwise, the transaction must also be signed by the operator,
+e.g. by generating it with --generate-only and signing it with both accounts.
 
 Example:
-$ poktrolld --home=$(POKTROLLD_HOME) tx supplier stake-supplier 1000upokt --config stake_config.yaml --keyring-backend test --from $(APP) --node $(POCKET_NODE)`,
+$ poktrolld --home=$(POKTROLLD_HOME) tx supplier stake-supplier 1000upokt --config stake_config.yaml --operator-address $(SUPPLIER_OPERATOR) --keyring-backend test --from $(APP) --node $(POCKET_NODE)`,
 
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
@@ -53,8 +56,14 @@ $ poktrolld --home=$(POKTROLLD_HOME) tx supplier stake-supplier 1000upokt --conf
 				return err
 			}
 
+			operatorAddress, err := cmd.Flags().GetString(flagOperatorAddress)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgStakeSupplier(
 				clientCtx.GetFromAddress().String(),
+				operatorAddress,
 				stake,
 				supplierStakeConfigs,
 			)
@@ -68,6 +77,7 @@ $ poktrolld --home=$(POKTROLLD_HOME) tx supplier stake-supplier 1000upokt --conf
 	}
 
 	cmd.Flags().StringVar(&flagStakeConfig, "config", "", "Path to the stake config file")
+	cmd.Flags().String(flagOperatorAddress, "", "Bech32 address of the supplier's operator; defaults to the 'from' address")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
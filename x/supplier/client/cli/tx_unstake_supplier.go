@@ -14,7 +14,9 @@ func CmdUnstakeSupplier() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "unstake-supplier",
 		Short: "Unstake a supplier",
-		Long: `Unstake an supplier with the provided parameters. This is a broadcast operation that will unstake the supplier specified by the 'from' address.
+		Long: `Unstake an supplier with the provided parameters. This is a broadcast operation that will unstake the supplier
+operated by the --operator-address address, or by the 'from' address if none is provided, and return its stake to the
+'from' address, which must own the supplier.
 
 Example:
 $ poktrolld --home=$(POKTROLLD_HOME) tx supplier unstake-supplier --keyring-backend test --from $(SUPPLIER) --node $(POCKET_NODE)`,
@@ -26,8 +28,14 @@ $ poktrolld --home=$(POKTROLLD_HOME) tx supplier unstake-supplier --keyring-back
 				return err
 			}
 
+			operatorAddress, err := cmd.Flags().GetString(flagOperatorAddress)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgUnstakeSupplier(
 				clientCtx.GetFromAddress().String(),
+				operatorAddress,
 			)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -36,6 +44,7 @@ $ poktrolld --home=$(POKTROLLD_HOME) tx supplier unstake-supplier --keyring-back
 		},
 	}
 
+	cmd.Flags().String(flagOperatorAddress, "", "Bech32 address of the supplier's operator; defaults to the 'from' address")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -0,0 +1,47 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/pokt-network/poktroll/x/supplier/types"
+)
+
+func CmdUpdateSupplierOperator() *cobra.Command {
+	// fromAddress & signature is retrieved via `flags.FlagFrom` in the `clientCtx`
+	cmd := &cobra.Command{
+		Use:   "update-supplier-operator <operator_address> <new_operator_address>",
+		Short: "Rotate the operator of a staked supplier",
+		Long: `Replace the operator of a staked supplier, which signs its relays, claims and proofs, without unstaking it.
+The new operator takes effect at the start of the next session.
+This is a broadcast operation which must be signed by the 'from' address owning the supplier.
+Unless the owner becomes the operator, the transaction must also be signed by the new operator,
+e.g. by generating it with --generate-only and signing it with both accounts.
+
+Example:
+$ poktrolld --home=$(POKTROLLD_HOME) tx supplier update-supplier-operator $(SUPPLIER_OPERATOR) $(NEW_SUPPLIER_OPERATOR) --keyring-backend test --from $(SUPPLIER) --node $(POCKET_NODE)`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateSupplierOperator(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
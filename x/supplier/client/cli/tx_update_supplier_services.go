@@ -0,0 +1,87 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/pokt-network/poktroll/x/supplier/client/config"
+	"github.com/pokt-network/poktroll/x/supplier/types"
+)
+
+var flagServicesConfig string
+
+func CmdUpdateSupplierServices() *cobra.Command {
+	// fromAddress & signature is retrieved via `flags.FlagFrom` in the `clientCtx`
+	cmd := &cobra.Command{
+		Use:   "update-supplier-services <add|remove|replace> --config <config_file.yaml>",
+		Short: "Update the services of a staked supplier",
+		Long: `Update the services of the supplier operated by the --operator-address address, or by the 'from' address
+if none is provided, without restaking it. The 'from' address must own the supplier.
+The services and endpoints are read from a config file in the same format as the stake config file.
+
+- add: adds the endpoints to their services, adding the services the supplier doesn't provide yet
+- remove: removes the endpoints, matched by URL, from their services, removing the services left without endpoints
+- replace: replaces all the endpoints of the services, adding the services the supplier doesn't provide yet
+
+The updated services take effect at the start of the next session.
+
+Example:
+$ poktrolld --home=$(POKTROLLD_HOME) tx supplier update-supplier-services add --config services_config.yaml --keyring-backend test --from $(SUPPLIER) --node $(POCKET_NODE)`,
+
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			actionString := args[0]
+			action, ok := types.SupplierServiceUpdateAction_value[strings.ToUpper(actionString)]
+			if !ok || action == int32(types.SupplierServiceUpdateAction_UNKNOWN_ACTION) {
+				return fmt.Errorf("invalid service update action: %s", actionString)
+			}
+
+			configContent, err := os.ReadFile(flagServicesConfig)
+			if err != nil {
+				return err
+			}
+
+			supplierServiceConfigs, err := config.ParseSupplierConfigs(configContent)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			operatorAddress, err := cmd.Flags().GetString(flagOperatorAddress)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateSupplierServices(
+				clientCtx.GetFromAddress().String(),
+				operatorAddress,
+				types.NewSupplierServiceUpdates(
+					types.SupplierServiceUpdateAction(action),
+					supplierServiceConfigs,
+				),
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().StringVar(&flagServicesConfig, "config", "", "Path to the services config file")
+	cmd.Flags().String(flagOperatorAddress, "", "Bech32 address of the supplier's operator; defaults to the 'from' address")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
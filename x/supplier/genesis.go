@@ -7,13 +7,20 @@ import (
 	"github.com/pokt-network/poktroll/x/supplier/types"
 )
 
-// TODO_TECHDEBT(@Olshansk): Remove existing claims from genesis.
 // InitGenesis initializes the module's state from a provided genesis state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
 	// Set all the supplier
 	for _, supplier := range genState.SupplierList {
 		k.SetSupplier(ctx, supplier)
 	}
+	// Set all the claims, which are pending to be proven and settled
+	for _, claim := range genState.ClaimList {
+		k.InsertClaim(ctx, claim)
+	}
+	// Set all the proofs of the settled claims
+	for _, proof := range genState.ProofList {
+		k.UpsertProof(ctx, proof)
+	}
 	// this line is used by starport scaffolding # genesis/module/init
 	k.SetParams(ctx, genState.Params)
 }
@@ -24,6 +31,8 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.Params = k.GetParams(ctx)
 
 	genesis.SupplierList = k.GetAllSupplier(ctx)
+	genesis.ClaimList = k.GetAllClaims(ctx)
+	genesis.ProofList = k.GetAllProofs(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
@@ -1,27 +1,34 @@
 package supplier_test
 
 import (
+	"encoding/binary"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
+	"github.com/pokt-network/poktroll/testutil/events"
 	keepertest "github.com/pokt-network/poktroll/testutil/keeper"
 	"github.com/pokt-network/poktroll/testutil/nullify"
 	"github.com/pokt-network/poktroll/testutil/sample"
+	servicetypes "github.com/pokt-network/poktroll/x/service/types"
+	sessiontypes "github.com/pokt-network/poktroll/x/session/types"
 	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
 	"github.com/pokt-network/poktroll/x/supplier"
+	"github.com/pokt-network/poktroll/x/supplier/keeper"
 	"github.com/pokt-network/poktroll/x/supplier/types"
 )
 
 // Please see `x/supplier/types/genesis_test.go` for extensive tests related to the validity of the genesis state.
 func TestGenesis(t *testing.T) {
+	supplierAddr := sample.AccAddress()
 	genesisState := types.GenesisState{
 		Params: types.DefaultParams(),
 		SupplierList: []sharedtypes.Supplier{
 			{
-				Address: sample.AccAddress(),
-				Stake:   &sdk.Coin{Denom: "upokt", Amount: sdk.NewInt(100)},
+				OwnerAddress:    sample.AccAddress(),
+				OperatorAddress: supplierAddr,
+				Stake:           &sdk.Coin{Denom: "upokt", Amount: sdk.NewInt(100)},
 				Services: []*sharedtypes.SupplierServiceConfig{
 					{
 						Service: &sharedtypes.Service{
@@ -38,8 +45,9 @@ func TestGenesis(t *testing.T) {
 				},
 			},
 			{
-				Address: sample.AccAddress(),
-				Stake:   &sdk.Coin{Denom: "upokt", Amount: sdk.NewInt(100)},
+				OwnerAddress:    sample.AccAddress(),
+				OperatorAddress: sample.AccAddress(),
+				Stake:           &sdk.Coin{Denom: "upokt", Amount: sdk.NewInt(100)},
 				Services: []*sharedtypes.SupplierServiceConfig{
 					{
 						Service: &sharedtypes.Service{
@@ -56,6 +64,17 @@ func TestGenesis(t *testing.T) {
 				},
 			},
 		},
+		ClaimList: []types.Claim{
+			newGenesisClaim(supplierAddr, "session_id_1", 8, 10),
+			newGenesisClaim(supplierAddr, "session_id_2", 12, 5),
+		},
+		ProofList: []types.Proof{
+			{
+				SupplierAddress:    supplierAddr,
+				SessionHeader:      &sessiontypes.SessionHeader{SessionId: "session_id_0"},
+				ClosestMerkleProof: []byte("closest_merkle_proof"),
+			},
+		},
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
@@ -68,5 +87,107 @@ func TestGenesis(t *testing.T) {
 	nullify.Fill(got)
 
 	require.ElementsMatch(t, genesisState.SupplierList, got.SupplierList)
+	require.ElementsMatch(t, genesisState.ClaimList, got.ClaimList)
+	require.ElementsMatch(t, genesisState.ProofList, got.ProofList)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
+
+func TestGenesis_ExportImportKeepsRewardsPending(t *testing.T) {
+	supplierAddr := sample.AccAddress()
+	supplierToExport := sharedtypes.Supplier{
+		OwnerAddress:    supplierAddr,
+		OperatorAddress: supplierAddr,
+		Stake:           &sdk.Coin{Denom: "upokt", Amount: sdk.NewInt(100)},
+		Services: []*sharedtypes.SupplierServiceConfig{
+			{
+				Service: &sharedtypes.Service{Id: "svc1"},
+				Endpoints: []*sharedtypes.SupplierEndpoint{
+					{
+						Url:     "http://localhost:8081",
+						RpcType: sharedtypes.RPCType_JSON_RPC,
+						Configs: make([]*sharedtypes.ConfigOption, 0),
+					},
+				},
+			},
+		},
+	}
+	sessionHeader := keepertest.AddSupplierSession(t, supplierAddr, 1000)
+	tree := keepertest.NewSupplierSessionTree(t, supplierAddr, sessionHeader, 5)
+	proofToExport := types.Proof{
+		SupplierAddress:    supplierAddr,
+		SessionHeader:      &sessiontypes.SessionHeader{SessionId: "settled_session_id"},
+		ClosestMerkleProof: []byte("closest_merkle_proof"),
+	}
+
+	// Export a chain with a claim which is yet to be proven and settled, and
+	// the proof of one which was settled
+	exportKeeper, exportCtx := keepertest.SupplierKeeper(t)
+	exportKeeper.SetSupplier(exportCtx, supplierToExport)
+	exportKeeper.UpsertProof(exportCtx, proofToExport)
+	exportSrv := keeper.NewMsgServerImpl(*exportKeeper)
+	claimMsg := types.NewMsgCreateClaim(supplierAddr, sessionHeader, tree.Root())
+	_, err := exportSrv.CreateClaim(sdk.WrapSDKContext(exportCtx), claimMsg)
+	require.NoError(t, err)
+	claimToExport, isClaimFound := exportKeeper.GetClaim(exportCtx, sessionHeader.SessionId, supplierAddr)
+	require.True(t, isClaimFound)
+
+	exportedGenesis := supplier.ExportGenesis(exportCtx, *exportKeeper)
+	require.NoError(t, exportedGenesis.Validate())
+	require.Len(t, exportedGenesis.ClaimList, 1)
+	require.Len(t, exportedGenesis.ProofList, 1)
+
+	// Import it in a new chain
+	importKeeper, importCtx := keepertest.SupplierKeeper(t)
+	supplier.InitGenesis(importCtx, *importKeeper, *exportedGenesis)
+
+	// The claim is still pending, and can be found by its supplier and by its
+	// session end height, as it would when being settled.
+	claimFound, isClaimFound := importKeeper.GetClaim(importCtx, sessionHeader.SessionId, supplierAddr)
+	require.True(t, isClaimFound)
+	require.Equal(t, claimToExport, claimFound)
+	require.Equal(t, []types.Claim{claimToExport}, importKeeper.GetClaimsByAddress(importCtx, supplierAddr))
+	require.Equal(t, []types.Claim{claimToExport}, importKeeper.GetClaimsByHeight(importCtx, claimToExport.SessionEndBlockHeight))
+
+	proofFound, isProofFound := importKeeper.GetProof(importCtx, "settled_session_id", supplierAddr)
+	require.True(t, isProofFound)
+	require.Equal(t, proofToExport, proofFound)
+
+	// The supplier is rewarded once it proves the imported claim.
+	closestProof, err := tree.ProveClosest(servicetypes.RelaySMSTPath([]byte("block_hash")))
+	require.NoError(t, err)
+	closestProofBz, err := closestProof.Marshal()
+	require.NoError(t, err)
+
+	importSrv := keeper.NewMsgServerImpl(*importKeeper)
+	_, err = importSrv.SubmitProof(sdk.WrapSDKContext(importCtx), &types.MsgSubmitProof{
+		SupplierAddress: supplierAddr,
+		SessionHeader:   sessionHeader,
+		Proof:           closestProofBz,
+	})
+	require.NoError(t, err)
+
+	claimSettledEvents := events.FilterTypedEvents[*types.EventClaimSettled](t, importCtx.EventManager().Events())
+	require.Len(t, claimSettledEvents, 1)
+	require.Equal(t, sdk.NewCoin("upokt", sdk.NewInt(5)), claimSettledEvents[0].Reward)
+	require.Equal(t, int64(995), keepertest.SupplierApplicationsMap[sessionHeader.ApplicationAddress].Stake.Amount.Int64())
+}
+
+// newGenesisClaim returns a claim for the given supplier and session, whose
+// root hash commits to the given number of mined relays.
+func newGenesisClaim(supplierAddr, sessionId string, sessionEndHeight, numMinedRelays uint64) types.Claim {
+	// The relay SMST root is a hash followed by the sum of the tree's leaves.
+	rootHash := make([]byte, 40)
+	binary.BigEndian.PutUint64(rootHash[32:], numMinedRelays)
+
+	return types.Claim{
+		SupplierAddress:       supplierAddr,
+		SessionId:             sessionId,
+		SessionEndBlockHeight: sessionEndHeight,
+		RootHash:              rootHash,
+		ServiceId:             "svcId1",
+		NumMinedRelays:        numMinedRelays,
+		ApplicationAddress:    sample.AccAddress(),
+		NumSessionSuppliers:   1,
+		SupplierOwnerAddress:  supplierAddr,
+	}
+}
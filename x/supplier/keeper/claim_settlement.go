@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	apptypes "github.com/pokt-network/poktroll/x/application/types"
+	"github.com/pokt-network/poktroll/x/shared/helpers"
+	"github.com/pokt-network/poktroll/x/supplier/types"
+)
+
+// settleClaim bills the application of the given proven claim's session for the
+// relays which the supplier serviced in it, as estimated from the claim (see:
+// Claim#EstimatedNumRelays), and rewards the supplier's owner, as recorded in
+// the claim, with the billed amount. The estimated relays are capped by the
+// compute units which the application can be billed for by each supplier in the
+// session (see: helpers.MaxSessionComputeUnits), and the billed amount by the
+// application's remaining stake. An application whose stake is exhausted is
+// unstaked. It returns the billed amount, which is zero if the application is
+// no longer staked.
+func (k Keeper) settleClaim(ctx sdk.Context, claim types.Claim) (sdk.Coin, error) {
+	logger := k.Logger(ctx).With("method", "settleClaim")
+
+	appAddress := claim.ApplicationAddress
+	app, isAppFound := k.applicationKeeper.GetApplication(ctx, appAddress)
+	if !isAppFound || app.Stake == nil {
+		logger.Info(fmt.Sprintf("application %s is no longer staked; nothing to settle for session %s", appAddress, claim.SessionId))
+		return sdk.NewCoin("upokt", sdk.ZeroInt()), nil
+	}
+
+	maxComputeUnits, err := helpers.MaxSessionComputeUnits(app.Stake, int(claim.NumSessionSuppliers))
+	if err != nil {
+		return sdk.Coin{}, types.ErrSupplierRelayQuotaExceeded.Wrapf(
+			"unable to determine quota of application %s in session %s: %s",
+			appAddress, claim.SessionId, err,
+		)
+	}
+
+	computeUnits := new(big.Int).Mul(
+		claim.EstimatedNumRelays(),
+		big.NewInt(helpers.ComputeUnitsPerRelay),
+	)
+	if maxComputeUnitsBig := new(big.Int).SetUint64(maxComputeUnits); computeUnits.Cmp(maxComputeUnitsBig) > 0 {
+		computeUnits = maxComputeUnitsBig
+	}
+
+	amount := sdk.NewIntFromBigInt(computeUnits).MulRaw(helpers.ComputeUnitsToTokensMultiplier)
+	if amount.GT(app.Stake.Amount) {
+		amount = app.Stake.Amount
+	}
+	settledCoin := sdk.NewCoin(app.Stake.Denom, amount)
+	if settledCoin.IsZero() {
+		return settledCoin, nil
+	}
+
+	// The supplier's owner is rewarded even if the supplier has since unstaked
+	// or rotated its operator, as it serviced the claimed relays.
+	rewardAddr, err := sdk.AccAddressFromBech32(claim.SupplierOwnerAddress)
+	if err != nil {
+		return sdk.Coin{}, types.ErrSupplierInvalidAddress.Wrapf("invalid reward address %s: %s", claim.SupplierOwnerAddress, err)
+	}
+
+	// The stakes of applications are held by the application module account.
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(
+		ctx, apptypes.ModuleName, rewardAddr, sdk.NewCoins(settledCoin),
+	); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	newStake := app.Stake.Sub(settledCoin)
+	if newStake.IsZero() {
+		k.applicationKeeper.RemoveApplication(ctx, app.Address)
+		logger.Info(fmt.Sprintf("unstaked application %s whose stake was exhausted by session %s", app.Address, claim.SessionId))
+	} else {
+		app.Stake = &newStake
+		k.applicationKeeper.SetApplication(ctx, app)
+	}
+
+	logger.Info(fmt.Sprintf(
+		"settled claim of supplier %s for session %s; billed application %s %s",
+		claim.SupplierAddress, claim.SessionId, app.Address, settledCoin,
+	))
+
+	return settledCoin, nil
+}
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	sharedhelpers "github.com/pokt-network/poktroll/x/shared/helpers"
+	"github.com/pokt-network/poktroll/x/supplier/types"
+)
+
+// RegisterInvariants registers the supplier module invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "module-account-balance", ModuleAccountBalanceInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "positive-stake", PositiveStakeInvariant(k))
+}
+
+// ModuleAccountBalanceInvariant checks that the balance of the supplier module
+// account is the sum of the stakes of all suppliers.
+func ModuleAccountBalanceInvariant(k Keeper) sdk.Invariant {
+	return sharedhelpers.ModuleAccountBalanceInvariant(types.ModuleName, "supplier", k.bankKeeper, k.getSupplierStakes)
+}
+
+// PositiveStakeInvariant checks that every supplier has a positive stake.
+func PositiveStakeInvariant(k Keeper) sdk.Invariant {
+	return sharedhelpers.PositiveStakeInvariant(types.ModuleName, "supplier", k.getSupplierStakes)
+}
+
+// getSupplierStakes returns the stakes of all suppliers.
+func (k Keeper) getSupplierStakes(ctx sdk.Context) (stakes []sharedhelpers.ActorStake) {
+	for _, supplier := range k.GetAllSupplier(ctx) {
+		stakes = append(stakes, sharedhelpers.ActorStake{Address: supplier.OperatorAddress, Stake: supplier.Stake})
+	}
+	return stakes
+}
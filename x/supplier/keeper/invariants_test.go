@@ -0,0 +1,70 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/pokt-network/poktroll/testutil/keeper"
+	"github.com/pokt-network/poktroll/testutil/sample"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+	"github.com/pokt-network/poktroll/x/supplier/keeper"
+	"github.com/pokt-network/poktroll/x/supplier/types"
+)
+
+func TestModuleAccountBalanceInvariant(t *testing.T) {
+	k, ctx := keepertest.SupplierKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	// The invariant holds for suppliers which are staked and unstaked by messages
+	for i := 0; i < 3; i++ {
+		addr := sample.AccAddress()
+		_, err := srv.StakeSupplier(wctx, newStakeSupplierMsg(addr, addr))
+		require.NoError(t, err)
+	}
+	unstakedAddr := sample.AccAddress()
+	_, err := srv.StakeSupplier(wctx, newStakeSupplierMsg(unstakedAddr, unstakedAddr))
+	require.NoError(t, err)
+	_, err = srv.UnstakeSupplier(wctx, types.NewMsgUnstakeSupplier(unstakedAddr, unstakedAddr))
+	require.NoError(t, err)
+
+	_, isBroken := keeper.ModuleAccountBalanceInvariant(*k)(ctx)
+	require.False(t, isBroken)
+
+	// It is broken by a stake which wasn't sent to the module account
+	stakeMsg := newStakeSupplierMsg(unstakedAddr, unstakedAddr)
+	k.SetSupplier(ctx, sharedtypes.Supplier{
+		OwnerAddress:    stakeMsg.OwnerAddress,
+		OperatorAddress: stakeMsg.OperatorAddress,
+		Stake:           stakeMsg.Stake,
+		Services:        stakeMsg.Services,
+	})
+
+	_, isBroken = keeper.ModuleAccountBalanceInvariant(*k)(ctx)
+	require.True(t, isBroken)
+}
+
+func TestPositiveStakeInvariant(t *testing.T) {
+	k, ctx := keepertest.SupplierKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	addr := sample.AccAddress()
+	_, err := srv.StakeSupplier(wctx, newStakeSupplierMsg(addr, addr))
+	require.NoError(t, err)
+
+	_, isBroken := keeper.PositiveStakeInvariant(*k)(ctx)
+	require.False(t, isBroken)
+
+	// It is broken by a supplier with a zero stake
+	supplier, isSupplierFound := k.GetSupplier(ctx, addr)
+	require.True(t, isSupplierFound)
+	zeroStake := sdk.NewCoin("upokt", sdk.ZeroInt())
+	supplier.Stake = &zeroStake
+	k.SetSupplier(ctx, supplier)
+
+	_, isBroken = keeper.PositiveStakeInvariant(*k)(ctx)
+	require.True(t, isBroken)
+}
@@ -19,7 +19,10 @@ type (
 		memKey     storetypes.StoreKey
 		paramstore paramtypes.Subspace
 
-		bankKeeper types.BankKeeper
+		bankKeeper        types.BankKeeper
+		serviceKeeper     types.ServiceKeeper
+		sessionKeeper     types.SessionKeeper
+		applicationKeeper types.ApplicationKeeper
 	}
 )
 
@@ -30,6 +33,9 @@ func NewKeeper(
 	ps paramtypes.Subspace,
 
 	bankKeeper types.BankKeeper,
+	serviceKeeper types.ServiceKeeper,
+	sessionKeeper types.SessionKeeper,
+	applicationKeeper types.ApplicationKeeper,
 ) *Keeper {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
@@ -42,7 +48,10 @@ func NewKeeper(
 		memKey:     memKey,
 		paramstore: ps,
 
-		bankKeeper: bankKeeper,
+		bankKeeper:        bankKeeper,
+		serviceKeeper:     serviceKeeper,
+		sessionKeeper:     sessionKeeper,
+		applicationKeeper: applicationKeeper,
 	}
 }
 
@@ -5,6 +5,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	sessiontypes "github.com/pokt-network/poktroll/x/session/types"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
 	"github.com/pokt-network/poktroll/x/supplier/types"
 )
 
@@ -16,11 +18,49 @@ func (k msgServer) CreateClaim(goCtx context.Context, msg *types.MsgCreateClaim)
 		return nil, err
 	}
 
+	numMinedRelays, err := types.NumMinedRelaysFromRootHash(msg.RootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := k.queryAndValidateSessionHeader(goCtx, msg.GetSessionHeader())
+	if err != nil {
+		return nil, err
+	}
+	sessionSupplier, err := getSessionSupplier(session, msg.GetSupplierAddress())
+	if err != nil {
+		return nil, err
+	}
+
+	// NB: Claims aren't rejected for exceeding the application's relay quota, as
+	// its stake may change during the claim window regardless of the relays the
+	// supplier serviced. The billed amount is capped at settlement instead (see:
+	// Keeper#settleClaim).
+
+	// The supplier mined its relays at the relay difficulty which was in effect
+	// for the session, regardless of any retargets since it started.
+	serviceId := msg.SessionHeader.Service.Id
+	relayDifficultyBits, err := k.serviceKeeper.SessionRelayDifficultyBits(
+		ctx, serviceId, msg.SessionHeader.SessionStartBlockHeight,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// The session, and the supplier's owner, are recorded in the claim such that
+	// it can still be proven, and settled, once the supplier unstakes or rotates
+	// its operator, at which point it's no longer part of the session.
 	claim := types.Claim{
 		SupplierAddress:       msg.SupplierAddress,
 		SessionId:             msg.SessionHeader.SessionId,
 		SessionEndBlockHeight: uint64(msg.SessionHeader.SessionEndBlockHeight),
 		RootHash:              msg.RootHash,
+		ServiceId:             serviceId,
+		RelayDifficultyBits:   relayDifficultyBits,
+		NumMinedRelays:        numMinedRelays,
+		ApplicationAddress:    session.GetApplication().GetAddress(),
+		NumSessionSuppliers:   uint64(len(session.GetSuppliers())),
+		SupplierOwnerAddress:  sessionSupplier.GetOwnerAddress(),
 	}
 	k.Keeper.InsertClaim(ctx, claim)
 
@@ -33,8 +73,8 @@ func (k msgServer) CreateClaim(goCtx context.Context, msg *types.MsgCreateClaim)
 		## Validation
 
 		### Session validation
-		1. [ ] claimed session ID matches on-chain session ID
-		2. [ ] this supplier is in the session's suppliers list
+		1. [x] claimed session ID matches on-chain session ID
+		2. [x] this supplier is in the session's suppliers list
 
 		### Msg distribution validation (depends on session validation)
 		1. [ ] governance-based earliest block offset
@@ -55,5 +95,67 @@ func (k msgServer) CreateClaim(goCtx context.Context, msg *types.MsgCreateClaim)
 	*/
 	_ = ctx
 
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventClaimCreated{
+		Claim: claim,
+	}); err != nil {
+		logger.Error("failed to emit EventClaimCreated: %v", err)
+		return nil, err
+	}
+
 	return &types.MsgCreateClaimResponse{}, nil
 }
+
+// queryAndValidateSessionHeader queries the on-chain session which the given
+// session header is for and ensures that the header matches it. It returns the
+// on-chain session.
+func (k msgServer) queryAndValidateSessionHeader(
+	goCtx context.Context,
+	sessionHeader *sessiontypes.SessionHeader,
+) (*sessiontypes.Session, error) {
+	sessionRes, err := k.sessionKeeper.GetSession(goCtx, &sessiontypes.QueryGetSessionRequest{
+		ApplicationAddress: sessionHeader.GetApplicationAddress(),
+		Service:            sessionHeader.GetService(),
+		BlockHeight:        sessionHeader.GetSessionStartBlockHeight(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	session := sessionRes.GetSession()
+	onChainSessionHeader := session.GetHeader()
+
+	if onChainSessionHeader.GetSessionId() != sessionHeader.GetSessionId() {
+		return nil, types.ErrSupplierInvalidSessionId.Wrapf(
+			"session ID %q does not match on-chain session ID %q",
+			sessionHeader.GetSessionId(), onChainSessionHeader.GetSessionId(),
+		)
+	}
+
+	if onChainSessionHeader.GetSessionEndBlockHeight() != sessionHeader.GetSessionEndBlockHeight() {
+		return nil, types.ErrSupplierInvalidSessionEndHeight.Wrapf(
+			"session end height %d does not match on-chain session end height %d",
+			sessionHeader.GetSessionEndBlockHeight(), onChainSessionHeader.GetSessionEndBlockHeight(),
+		)
+	}
+
+	return session, nil
+}
+
+// getSessionSupplier returns the supplier of the given session which is operated
+// by the given address. Suppliers which have since unstaked, or rotated their
+// operator, are no longer part of the sessions they were in.
+func getSessionSupplier(
+	session *sessiontypes.Session,
+	supplierAddress string,
+) (*sharedtypes.Supplier, error) {
+	for _, supplier := range session.GetSuppliers() {
+		if supplier.GetOperatorAddress() == supplierAddress {
+			return supplier, nil
+		}
+	}
+
+	return nil, types.ErrSupplierNotFound.Wrapf(
+		"supplier %s not found in session %s",
+		supplierAddress, session.GetSessionId(),
+	)
+}
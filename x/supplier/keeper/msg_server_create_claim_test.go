@@ -0,0 +1,46 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/testutil/events"
+	keepertest "github.com/pokt-network/poktroll/testutil/keeper"
+	"github.com/pokt-network/poktroll/testutil/sample"
+	"github.com/pokt-network/poktroll/x/supplier/keeper"
+	"github.com/pokt-network/poktroll/x/supplier/types"
+)
+
+func TestMsgServer_CreateClaim_EmitsEvent(t *testing.T) {
+	k, ctx := keepertest.SupplierKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	supplierAddr := sample.AccAddress()
+	sessionHeader := keepertest.AddSupplierSession(t, supplierAddr, 1000)
+
+	// Claims which are rejected don't emit any event
+	otherSupplierAddr := sample.AccAddress()
+	otherTree := keepertest.NewSupplierSessionTree(t, otherSupplierAddr, sessionHeader, 5)
+	_, err := srv.CreateClaim(wctx, types.NewMsgCreateClaim(otherSupplierAddr, sessionHeader, otherTree.Root()))
+	require.ErrorIs(t, err, types.ErrSupplierNotFound)
+	require.Empty(t, events.FilterTypedEvents[*types.EventClaimCreated](t, ctx.EventManager().Events()))
+
+	// Claim the work done in the session
+	tree := keepertest.NewSupplierSessionTree(t, supplierAddr, sessionHeader, 5)
+	_, err = srv.CreateClaim(wctx, types.NewMsgCreateClaim(supplierAddr, sessionHeader, tree.Root()))
+	require.NoError(t, err)
+
+	claim, isClaimFound := k.GetClaim(ctx, sessionHeader.SessionId, supplierAddr)
+	require.True(t, isClaimFound)
+	require.Equal(t, uint64(5), claim.NumMinedRelays)
+
+	// Verify that the created claim was emitted
+	claimCreatedEvents := events.FilterTypedEvents[*types.EventClaimCreated](t, ctx.EventManager().Events())
+	require.Len(t, claimCreatedEvents, 1)
+	require.Equal(t, claim, claimCreatedEvents[0].Claim)
+	require.Equal(t, supplierAddr, claimCreatedEvents[0].Claim.SupplierAddress)
+	require.Equal(t, sessionHeader.SessionId, claimCreatedEvents[0].Claim.SessionId)
+}
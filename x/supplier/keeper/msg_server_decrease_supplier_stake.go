@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	sharedhelpers "github.com/pokt-network/poktroll/x/shared/helpers"
+	"github.com/pokt-network/poktroll/x/supplier/types"
+)
+
+// DecreaseSupplierStake lowers the stake of a supplier, which must be signed for
+// by its owner, who the difference is returned to.
+func (k msgServer) DecreaseSupplierStake(
+	goCtx context.Context,
+	msg *types.MsgDecreaseSupplierStake,
+) (*types.MsgDecreaseSupplierStakeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	logger := k.Logger(ctx).With("method", "DecreaseSupplierStake")
+	logger.Info("About to decrease supplier stake with msg: %v", msg)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	operatorAddress := msg.GetOperatorAddressOrOwner()
+	supplier, err := k.getOwnedSupplier(ctx, msg.OwnerAddress, operatorAddress)
+	if err != nil {
+		logger.Info("Cannot decrease the stake of supplier with operator address %s: %v", operatorAddress, err)
+		return nil, err
+	}
+
+	if err := sharedhelpers.DecreaseStake(
+		ctx, k.bankKeeper, types.ModuleName, msg.OwnerAddress,
+		*supplier.Stake, *msg.Stake, k.GetParams(ctx).ValidateStake, types.ErrSupplierInvalidStake,
+	); err != nil {
+		logger.Error("could not decrease the stake of supplier %s: %v", operatorAddress, err)
+		return nil, err
+	}
+
+	// Update the Supplier in the store
+	supplier.Stake = msg.Stake
+	k.SetSupplier(ctx, supplier)
+	logger.Info("Successfully decreased stake for supplier: %+v", supplier)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventSupplierStakeDecreased{
+		Supplier: supplier,
+	}); err != nil {
+		logger.Error("failed to emit EventSupplierStakeDecreased: %v", err)
+		return nil, err
+	}
+
+	return &types.MsgDecreaseSupplierStakeResponse{}, nil
+}
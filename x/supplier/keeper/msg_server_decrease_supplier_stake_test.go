@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/testutil/events"
+	keepertest "github.com/pokt-network/poktroll/testutil/keeper"
+	"github.com/pokt-network/poktroll/testutil/sample"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+	"github.com/pokt-network/poktroll/x/supplier/keeper"
+	"github.com/pokt-network/poktroll/x/supplier/types"
+)
+
+func TestMsgServer_DecreaseSupplierStake_Success(t *testing.T) {
+	k, ctx := keepertest.SupplierKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	// Stake the supplier
+	addr := sample.AccAddress()
+	initialStake := sdk.NewCoin("upokt", sdk.NewInt(100))
+	_, err := srv.StakeSupplier(wctx, &types.MsgStakeSupplier{
+		OwnerAddress: addr,
+		Stake:        &initialStake,
+		Services: []*sharedtypes.SupplierServiceConfig{
+			{
+				Service: &sharedtypes.Service{Id: "svcId"},
+				Endpoints: []*sharedtypes.SupplierEndpoint{
+					{
+						Url:     "http://localhost:8080",
+						RpcType: sharedtypes.RPCType_JSON_RPC,
+						Configs: make([]*sharedtypes.ConfigOption, 0),
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	// Decrease the stake of the supplier
+	decreasedStake := sdk.NewCoin("upokt", sdk.NewInt(60))
+	_, err = srv.DecreaseSupplierStake(wctx, types.NewMsgDecreaseSupplierStake(addr, "", decreasedStake))
+	require.NoError(t, err)
+
+	// Verify that the supplier is still staked with the decreased stake
+	foundSupplier, isSupplierFound := k.GetSupplier(ctx, addr)
+	require.True(t, isSupplierFound)
+	require.Equal(t, decreasedStake.Amount, foundSupplier.Stake.Amount)
+
+	// Verify that the stake decrease was emitted
+	stakeDecreasedEvents := events.FilterTypedEvents[*types.EventSupplierStakeDecreased](t, ctx.EventManager().Events())
+	require.Len(t, stakeDecreasedEvents, 1)
+	require.Equal(t, addr, stakeDecreasedEvents[0].Supplier.OperatorAddress)
+	require.Equal(t, decreasedStake.Amount, stakeDecreasedEvents[0].Supplier.Stake.Amount)
+}
+
+func TestMsgServer_DecreaseSupplierStake_FailIfNotStaked(t *testing.T) {
+	k, ctx := keepertest.SupplierKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	addr := sample.AccAddress()
+	decreasedStake := sdk.NewCoin("upokt", sdk.NewInt(60))
+	_, err := srv.DecreaseSupplierStake(wctx, types.NewMsgDecreaseSupplierStake(addr, "", decreasedStake))
+	require.ErrorIs(t, err, types.ErrSupplierNotFound)
+
+	_, isSupplierFound := k.GetSupplier(ctx, addr)
+	require.False(t, isSupplierFound)
+}
@@ -27,13 +27,18 @@ func (k msgServer) StakeSupplier(
 	// Check if the supplier already exists or not
 	var err error
 	var coinsToDelegate sdk.Coin
-	supplier, isSupplierFound := k.GetSupplier(ctx, msg.Address)
+	operatorAddress := msg.GetOperatorAddressOrOwner()
+	supplier, isSupplierFound := k.GetSupplier(ctx, operatorAddress)
 	if !isSupplierFound {
-		logger.Info("Supplier not found. Creating new supplier for address %s", msg.Address)
+		logger.Info("Supplier not found. Creating new supplier for operator address %s", operatorAddress)
+		// The operator address may be reserved by the pending update of another supplier.
+		if k.isOperatorAddressInUse(ctx, operatorAddress) {
+			return nil, sdkerrors.Wrapf(types.ErrSupplierOperatorInUse, "operator address %s is about to be used by a supplier", operatorAddress)
+		}
 		supplier = k.createSupplier(ctx, msg)
 		coinsToDelegate = *msg.Stake
 	} else {
-		logger.Info("Supplier found. Updating supplier for address %s", msg.Address)
+		logger.Info("Supplier found. Updating supplier for operator address %s", operatorAddress)
 		currSupplierStake := *supplier.Stake
 		if err = k.updateSupplier(ctx, &supplier, msg); err != nil {
 			return nil, err
@@ -41,18 +46,22 @@ func (k msgServer) StakeSupplier(
 		coinsToDelegate = (*msg.Stake).Sub(currSupplierStake)
 	}
 
-	// Retrieve the address of the supplier
-	supplierAddress, err := sdk.AccAddressFromBech32(msg.Address)
+	if err = k.GetParams(ctx).ValidateStake(*supplier.Stake); err != nil {
+		return nil, err
+	}
+
+	// Retrieve the address of the supplier's owner, which the stake is sent from
+	ownerAddress, err := sdk.AccAddressFromBech32(msg.OwnerAddress)
 	if err != nil {
-		logger.Error("could not parse address %s", msg.Address)
+		logger.Error("could not parse owner address %s", msg.OwnerAddress)
 		return nil, err
 	}
 
 	// TODO_IMPROVE: Should we avoid making this call if `coinsToDelegate` = 0?
-	// Send the coins from the supplier to the staked supplier pool
-	err = k.bankKeeper.DelegateCoinsFromAccountToModule(ctx, supplierAddress, types.ModuleName, []sdk.Coin{coinsToDelegate})
+	// Send the coins from the supplier's owner to the staked supplier pool
+	err = k.bankKeeper.DelegateCoinsFromAccountToModule(ctx, ownerAddress, types.ModuleName, []sdk.Coin{coinsToDelegate})
 	if err != nil {
-		logger.Error("could not send %v coins from %s to %s module account due to %v", coinsToDelegate, supplierAddress, types.ModuleName, err)
+		logger.Error("could not send %v coins from %s to %s module account due to %v", coinsToDelegate, ownerAddress, types.ModuleName, err)
 		return nil, err
 	}
 
@@ -60,6 +69,13 @@ func (k msgServer) StakeSupplier(
 	k.SetSupplier(ctx, supplier)
 	logger.Info("Successfully updated supplier stake for supplier: %+v", supplier)
 
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventSupplierStaked{
+		Supplier: supplier,
+	}); err != nil {
+		logger.Error("failed to emit EventSupplierStaked: %v", err)
+		return nil, err
+	}
+
 	return &types.MsgStakeSupplierResponse{}, nil
 }
 
@@ -68,9 +84,10 @@ func (k msgServer) createSupplier(
 	msg *types.MsgStakeSupplier,
 ) sharedtypes.Supplier {
 	return sharedtypes.Supplier{
-		Address:  msg.Address,
-		Stake:    msg.Stake,
-		Services: msg.Services,
+		OwnerAddress:    msg.OwnerAddress,
+		OperatorAddress: msg.GetOperatorAddressOrOwner(),
+		Stake:           msg.Stake,
+		Services:        msg.Services,
 	}
 }
 
@@ -79,9 +96,9 @@ func (k msgServer) updateSupplier(
 	supplier *sharedtypes.Supplier,
 	msg *types.MsgStakeSupplier,
 ) error {
-	// Checks if the the msg address is the same as the current owner
-	if msg.Address != supplier.Address {
-		return sdkerrors.Wrapf(types.ErrSupplierUnauthorized, "msg Address (%s) != supplier address (%s)", msg.Address, supplier.Address)
+	// Checks if the the msg owner address is the same as the current owner
+	if msg.OwnerAddress != supplier.OwnerAddress {
+		return sdkerrors.Wrapf(types.ErrSupplierUnauthorized, "msg OwnerAddress (%s) != supplier owner address (%s)", msg.OwnerAddress, supplier.OwnerAddress)
 	}
 
 	// Validate that the stake is not being lowered
@@ -100,6 +117,8 @@ func (k msgServer) updateSupplier(
 		return sdkerrors.Wrapf(types.ErrSupplierInvalidServiceConfig, "must have at least one service")
 	}
 	supplier.Services = msg.Services
+	// Restaking replaces the service configs outright, superseding any pending update.
+	supplier.PendingServiceConfigUpdate = nil
 
 	return nil
 }
@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
+	"github.com/pokt-network/poktroll/testutil/events"
 	keepertest "github.com/pokt-network/poktroll/testutil/keeper"
 	"github.com/pokt-network/poktroll/testutil/sample"
 	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
@@ -27,8 +28,8 @@ func TestMsgServer_StakeSupplier_SuccessfulCreateAndUpdate(t *testing.T) {
 
 	// Prepare the stakeMsg
 	stakeMsg := &types.MsgStakeSupplier{
-		Address: addr,
-		Stake:   &sdk.Coin{Denom: "upokt", Amount: sdk.NewInt(100)},
+		OwnerAddress: addr,
+		Stake:        &sdk.Coin{Denom: "upokt", Amount: sdk.NewInt(100)},
 		Services: []*sharedtypes.SupplierServiceConfig{
 			{
 				Service: &sharedtypes.Service{
@@ -52,7 +53,7 @@ func TestMsgServer_StakeSupplier_SuccessfulCreateAndUpdate(t *testing.T) {
 	// Verify that the supplier exists
 	supplierFound, isSupplierFound := k.GetSupplier(ctx, addr)
 	require.True(t, isSupplierFound)
-	require.Equal(t, addr, supplierFound.Address)
+	require.Equal(t, addr, supplierFound.OperatorAddress)
 	require.Equal(t, int64(100), supplierFound.Stake.Amount.Int64())
 	require.Len(t, supplierFound.Services, 1)
 	require.Equal(t, "svcId", supplierFound.Services[0].Service.Id)
@@ -61,8 +62,8 @@ func TestMsgServer_StakeSupplier_SuccessfulCreateAndUpdate(t *testing.T) {
 
 	// Prepare an updated supplier with a higher stake and a different URL for the service
 	updateMsg := &types.MsgStakeSupplier{
-		Address: addr,
-		Stake:   &sdk.Coin{Denom: "upokt", Amount: sdk.NewInt(200)},
+		OwnerAddress: addr,
+		Stake:        &sdk.Coin{Denom: "upokt", Amount: sdk.NewInt(200)},
 		Services: []*sharedtypes.SupplierServiceConfig{
 			{
 				Service: &sharedtypes.Service{
@@ -89,6 +90,15 @@ func TestMsgServer_StakeSupplier_SuccessfulCreateAndUpdate(t *testing.T) {
 	require.Equal(t, "svcId2", supplierFound.Services[0].Service.Id)
 	require.Len(t, supplierFound.Services[0].Endpoints, 1)
 	require.Equal(t, "http://localhost:8082", supplierFound.Services[0].Endpoints[0].Url)
+
+	// Verify that both the stake and its update were emitted
+	stakedEvents := events.FilterTypedEvents[*types.EventSupplierStaked](t, ctx.EventManager().Events())
+	require.Len(t, stakedEvents, 2)
+	require.Equal(t, addr, stakedEvents[0].Supplier.OwnerAddress)
+	require.Equal(t, addr, stakedEvents[0].Supplier.OperatorAddress)
+	require.Equal(t, int64(100), stakedEvents[0].Supplier.Stake.Amount.Int64())
+	require.Equal(t, int64(200), stakedEvents[1].Supplier.Stake.Amount.Int64())
+	require.Equal(t, "svcId2", stakedEvents[1].Supplier.Services[0].Service.Id)
 }
 
 func TestMsgServer_StakeSupplier_FailRestakingDueToInvalidServices(t *testing.T) {
@@ -100,8 +110,8 @@ func TestMsgServer_StakeSupplier_FailRestakingDueToInvalidServices(t *testing.T)
 
 	// Prepare the supplier stake message
 	stakeMsg := &types.MsgStakeSupplier{
-		Address: supplierAddr,
-		Stake:   &sdk.Coin{Denom: "upokt", Amount: sdk.NewInt(100)},
+		OwnerAddress: supplierAddr,
+		Stake:        &sdk.Coin{Denom: "upokt", Amount: sdk.NewInt(100)},
 		Services: []*sharedtypes.SupplierServiceConfig{
 			{
 				Service: &sharedtypes.Service{
@@ -124,8 +134,8 @@ func TestMsgServer_StakeSupplier_FailRestakingDueToInvalidServices(t *testing.T)
 
 	// Prepare the supplier stake message without any service endpoints
 	updateStakeMsg := &types.MsgStakeSupplier{
-		Address: supplierAddr,
-		Stake:   &sdk.Coin{Denom: "upokt", Amount: sdk.NewInt(100)},
+		OwnerAddress: supplierAddr,
+		Stake:        &sdk.Coin{Denom: "upokt", Amount: sdk.NewInt(100)},
 		Services: []*sharedtypes.SupplierServiceConfig{
 			{
 				Service:   &sharedtypes.Service{Id: "svcId"},
@@ -141,7 +151,7 @@ func TestMsgServer_StakeSupplier_FailRestakingDueToInvalidServices(t *testing.T)
 	// Verify the supplierFound still exists and is staked for svc1
 	supplierFound, isSupplierFound := k.GetSupplier(ctx, supplierAddr)
 	require.True(t, isSupplierFound)
-	require.Equal(t, supplierAddr, supplierFound.Address)
+	require.Equal(t, supplierAddr, supplierFound.OperatorAddress)
 	require.Len(t, supplierFound.Services, 1)
 	require.Equal(t, "svcId", supplierFound.Services[0].Service.Id)
 	require.Len(t, supplierFound.Services[0].Endpoints, 1)
@@ -149,8 +159,8 @@ func TestMsgServer_StakeSupplier_FailRestakingDueToInvalidServices(t *testing.T)
 
 	// Prepare the supplier stake message with an invalid service ID
 	updateStakeMsg = &types.MsgStakeSupplier{
-		Address: supplierAddr,
-		Stake:   &sdk.Coin{Denom: "upokt", Amount: sdk.NewInt(100)},
+		OwnerAddress: supplierAddr,
+		Stake:        &sdk.Coin{Denom: "upokt", Amount: sdk.NewInt(100)},
 		Services: []*sharedtypes.SupplierServiceConfig{
 			{
 				Service: &sharedtypes.Service{Id: "svc1 INVALID ! & *"},
@@ -165,7 +175,7 @@ func TestMsgServer_StakeSupplier_FailRestakingDueToInvalidServices(t *testing.T)
 	// Verify the supplier still exists and is staked for svc1
 	supplierFound, isSupplierFound = k.GetSupplier(ctx, supplierAddr)
 	require.True(t, isSupplierFound)
-	require.Equal(t, supplierAddr, supplierFound.Address)
+	require.Equal(t, supplierAddr, supplierFound.OperatorAddress)
 	require.Len(t, supplierFound.Services, 1)
 	require.Equal(t, "svcId", supplierFound.Services[0].Service.Id)
 	require.Len(t, supplierFound.Services[0].Endpoints, 1)
@@ -180,8 +190,8 @@ func TestMsgServer_StakeSupplier_FailLoweringStake(t *testing.T) {
 	// Prepare the supplier
 	addr := sample.AccAddress()
 	stakeMsg := &types.MsgStakeSupplier{
-		Address: addr,
-		Stake:   &sdk.Coin{Denom: "upokt", Amount: sdk.NewInt(100)},
+		OwnerAddress: addr,
+		Stake:        &sdk.Coin{Denom: "upokt", Amount: sdk.NewInt(100)},
 		Services: []*sharedtypes.SupplierServiceConfig{
 			{
 				Service: &sharedtypes.Service{
@@ -206,8 +216,8 @@ func TestMsgServer_StakeSupplier_FailLoweringStake(t *testing.T) {
 
 	// Prepare an updated supplier with a lower stake
 	updateMsg := &types.MsgStakeSupplier{
-		Address: addr,
-		Stake:   &sdk.Coin{Denom: "upokt", Amount: sdk.NewInt(50)},
+		OwnerAddress: addr,
+		Stake:        &sdk.Coin{Denom: "upokt", Amount: sdk.NewInt(50)},
 		Services: []*sharedtypes.SupplierServiceConfig{
 			{
 				Service: &sharedtypes.Service{
@@ -4,25 +4,84 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pokt-network/smt"
 
+	servicetypes "github.com/pokt-network/poktroll/x/service/types"
+	sessiontypes "github.com/pokt-network/poktroll/x/session/types"
 	"github.com/pokt-network/poktroll/x/supplier/types"
 )
 
 func (k msgServer) SubmitProof(goCtx context.Context, msg *types.MsgSubmitProof) (*types.MsgSubmitProofResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	logger := k.Logger(ctx).With("method", "SubmitProof")
 
 	if err := msg.ValidateBasic(); err != nil {
 		return nil, err
 	}
 
+	sessionId := msg.GetSessionHeader().GetSessionId()
+	claim, found := k.Keeper.GetClaim(ctx, sessionId, msg.SupplierAddress)
+	if !found {
+		return nil, types.ErrSupplierClaimNotFound.Wrapf(
+			"session ID %q, supplier %s", sessionId, msg.SupplierAddress,
+		)
+	}
+
+	proof := new(smt.SparseMerkleClosestProof)
+	if err := proof.Unmarshal(msg.Proof); err != nil {
+		return nil, types.ErrSupplierInvalidProof.Wrapf("unmarshaling proof: %s", err)
+	}
+
+	// The proven relay must be a leaf of the claimed session tree, mined at the
+	// relay difficulty which was recorded when the claim was created.
+	relay, err := servicetypes.VerifyRelayClosestProof(proof, claim.RootHash, claim.RelayDifficultyBits)
+	if err != nil {
+		return nil, types.ErrSupplierInvalidProof.Wrapf("%s", err)
+	}
+	if relaySessionId := relay.GetReq().GetMeta().GetSessionHeader().GetSessionId(); relaySessionId != sessionId {
+		return nil, types.ErrSupplierInvalidProof.Wrapf(
+			"proven relay is for session ID %q, expected %q", relaySessionId, sessionId,
+		)
+	}
+	if relaySupplierAddress := relay.GetReq().GetMeta().GetSupplierAddress(); relaySupplierAddress != msg.SupplierAddress {
+		return nil, types.ErrSupplierInvalidProof.Wrapf(
+			"proven relay is for supplier %q, expected %q", relaySupplierAddress, msg.SupplierAddress,
+		)
+	}
+
+	// The session header is validated against the claim, rather than the
+	// on-chain session, as the supplier may have since unstaked or rotated its
+	// operator; its membership of the session was validated when claiming it.
+	if err := validateClaimSessionHeader(claim, msg.GetSessionHeader()); err != nil {
+		return nil, err
+	}
+
+	// Only the mined relays of proven claims count towards retargeting relay
+	// difficulties, since claims are only checked against their root hash once
+	// they're proven.
+	k.serviceKeeper.AddProvenMinedRelays(ctx, claim.ServiceId, claim.NumMinedRelays)
+
+	// The claim is settled, and removed, once proven such that it can't be
+	// proven, and settled, again. The proof is kept as a record of it.
+	settledCoin, err := k.Keeper.settleClaim(ctx, claim)
+	if err != nil {
+		return nil, err
+	}
+	k.Keeper.RemoveClaim(ctx, claim.SessionId, claim.SupplierAddress)
+	k.Keeper.UpsertProof(ctx, types.Proof{
+		SupplierAddress:    msg.SupplierAddress,
+		SessionHeader:      msg.SessionHeader,
+		ClosestMerkleProof: msg.Proof,
+	})
+
 	/*
 		INCOMPLETE: Handling the message
 
 		## Validation
 
 		### Session validation
-		1. [ ] claimed session ID == retrieved session ID
-		2. [ ] this supplier is in the session's suppliers list
+		1. [x] claimed session ID == retrieved session ID
+		2. [x] this supplier is in the session's suppliers list
 		3. [ ] proof signer addr == session application addr
 
 		### Msg distribution validation (depends on session validation)
@@ -30,26 +89,69 @@ func (k msgServer) SubmitProof(goCtx context.Context, msg *types.MsgSubmitProof)
 		2. [ ] governance-based earliest block offset
 
 		### Proof validation
-		1. [ ] session validation
+		1. [x] session validation
 		2. [ ] msg distribution validation
-		3. [ ] claim with matching session ID exists
+		3. [x] claim with matching session ID exists
 		4. [ ] proof path matches last committed block hash at claim height - 1
-		5. [ ] proof validates with claimed root hash
+		5. [x] proof validates with claimed root hash
 
 		## Persistence
-		1. [ ] submit proof message
+		1. [x] submit proof message
 			- supplier address
 			- session header
 			- proof
 
 		## Accounting
-		1. [ ] extract work done from root hash
-		2. [ ] calculate reward/burn token with governance-based multiplier
-		3. [ ] reward supplier
+		1. [x] extract work done from root hash (see: Claim#NumMinedRelays)
+		2. [x] calculate reward/burn token with governance-based multiplier,
+		       scaled by the estimated number of relays (see: Claim#EstimatedNumRelays)
+		3. [x] reward supplier
 		4. [ ] burn application tokens
+		5. [x] emit EventClaimSettled
 	*/
 
-	_ = ctx
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventProofSubmitted{
+		Claim: claim,
+	}); err != nil {
+		logger.Error("failed to emit EventProofSubmitted: %v", err)
+		return nil, err
+	}
+
+	// The amount billed to the application is rewarded to the supplier as is.
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventClaimSettled{
+		Claim:  claim,
+		Reward: settledCoin,
+	}); err != nil {
+		logger.Error("failed to emit EventClaimSettled: %v", err)
+		return nil, err
+	}
 
 	return &types.MsgSubmitProofResponse{}, nil
 }
+
+// validateClaimSessionHeader ensures that the given session header is for the
+// session of the given claim.
+func validateClaimSessionHeader(claim types.Claim, sessionHeader *sessiontypes.SessionHeader) error {
+	if uint64(sessionHeader.GetSessionEndBlockHeight()) != claim.SessionEndBlockHeight {
+		return types.ErrSupplierInvalidSessionEndHeight.Wrapf(
+			"session end height %d does not match claimed session end height %d",
+			sessionHeader.GetSessionEndBlockHeight(), claim.SessionEndBlockHeight,
+		)
+	}
+
+	if sessionHeader.GetService().GetId() != claim.ServiceId {
+		return types.ErrSupplierInvalidService.Wrapf(
+			"service ID %q does not match claimed service ID %q",
+			sessionHeader.GetService().GetId(), claim.ServiceId,
+		)
+	}
+
+	if sessionHeader.GetApplicationAddress() != claim.ApplicationAddress {
+		return types.ErrSupplierInvalidApplication.Wrapf(
+			"application %s does not match claimed application %s",
+			sessionHeader.GetApplicationAddress(), claim.ApplicationAddress,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,330 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pokt-network/smt"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/testutil/events"
+	keepertest "github.com/pokt-network/poktroll/testutil/keeper"
+	"github.com/pokt-network/poktroll/testutil/sample"
+	servicetypes "github.com/pokt-network/poktroll/x/service/types"
+	sessiontypes "github.com/pokt-network/poktroll/x/session/types"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+	"github.com/pokt-network/poktroll/x/supplier/keeper"
+	"github.com/pokt-network/poktroll/x/supplier/types"
+)
+
+func TestMsgServer_SubmitProof_EmitsEvents(t *testing.T) {
+	k, ctx := keepertest.SupplierKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	supplierAddr := sample.AccAddress()
+	sessionHeader := keepertest.AddSupplierSession(t, supplierAddr, 1000)
+
+	// Claim the work done in the session
+	tree := keepertest.NewSupplierSessionTree(t, supplierAddr, sessionHeader, 5)
+	_, err := srv.CreateClaim(wctx, types.NewMsgCreateClaim(supplierAddr, sessionHeader, tree.Root()))
+	require.NoError(t, err)
+
+	claim, isClaimFound := k.GetClaim(ctx, sessionHeader.SessionId, supplierAddr)
+	require.True(t, isClaimFound)
+
+	// Prove the claim
+	proof, err := tree.ProveClosest(servicetypes.RelaySMSTPath([]byte("block_hash")))
+	require.NoError(t, err)
+	proofBz, err := proof.Marshal()
+	require.NoError(t, err)
+
+	proofMsg := &types.MsgSubmitProof{
+		SupplierAddress: supplierAddr,
+		SessionHeader:   sessionHeader,
+		Proof:           proofBz,
+	}
+	_, err = srv.SubmitProof(wctx, proofMsg)
+	require.NoError(t, err)
+
+	proofSubmittedEvents := events.FilterTypedEvents[*types.EventProofSubmitted](t, ctx.EventManager().Events())
+	require.Len(t, proofSubmittedEvents, 1)
+	require.Equal(t, claim, proofSubmittedEvents[0].Claim)
+
+	// The application is billed, and the supplier rewarded, for the 5 relays.
+	expectedSettledCoin := sdk.NewCoin("upokt", sdk.NewInt(5))
+	claimSettledEvents := events.FilterTypedEvents[*types.EventClaimSettled](t, ctx.EventManager().Events())
+	require.Len(t, claimSettledEvents, 1)
+	require.Equal(t, claim, claimSettledEvents[0].Claim)
+	require.Equal(t, expectedSettledCoin, claimSettledEvents[0].Reward)
+}
+
+func TestMsgServer_CreateClaim_ValidatesSession(t *testing.T) {
+	k, ctx := keepertest.SupplierKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	supplierAddr := sample.AccAddress()
+	// The application can be billed for 2 compute units by each of the 2
+	// suppliers in the session.
+	sessionHeader := keepertest.AddSupplierSession(t, supplierAddr, 4, sample.AccAddress())
+
+	tests := []struct {
+		desc            string
+		supplierAddr    string
+		sessionHeader   *sessiontypes.SessionHeader
+		numMinedRelays  int
+		expectedErr     error
+		expectedClaimed bool
+	}{
+		{
+			desc:         "session ID does not match on-chain session",
+			supplierAddr: supplierAddr,
+			sessionHeader: &sessiontypes.SessionHeader{
+				ApplicationAddress:      sessionHeader.ApplicationAddress,
+				Service:                 sessionHeader.Service,
+				SessionId:               "invalid_session_id",
+				SessionStartBlockHeight: sessionHeader.SessionStartBlockHeight,
+				SessionEndBlockHeight:   sessionHeader.SessionEndBlockHeight,
+			},
+			numMinedRelays: 1,
+			expectedErr:    types.ErrSupplierInvalidSessionId,
+		},
+		{
+			desc:           "supplier not in session",
+			supplierAddr:   sample.AccAddress(),
+			sessionHeader:  sessionHeader,
+			numMinedRelays: 1,
+			expectedErr:    types.ErrSupplierNotFound,
+		},
+		{
+			desc:            "valid claim",
+			supplierAddr:    supplierAddr,
+			sessionHeader:   sessionHeader,
+			numMinedRelays:  2,
+			expectedClaimed: true,
+		},
+		{
+			// The billed amount is capped at settlement instead.
+			desc:            "claimed relays exceeding the application's relay quota",
+			supplierAddr:    supplierAddr,
+			sessionHeader:   sessionHeader,
+			numMinedRelays:  3,
+			expectedClaimed: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			tree := keepertest.NewSupplierSessionTree(t, test.supplierAddr, test.sessionHeader, test.numMinedRelays)
+
+			claimMsg := types.NewMsgCreateClaim(test.supplierAddr, test.sessionHeader, tree.Root())
+			_, err := srv.CreateClaim(wctx, claimMsg)
+			if test.expectedErr != nil {
+				require.ErrorIs(t, err, test.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			_, isClaimFound := k.GetClaim(ctx, test.sessionHeader.SessionId, test.supplierAddr)
+			require.Equal(t, test.expectedClaimed, isClaimFound)
+		})
+	}
+}
+
+func TestMsgServer_SubmitProof_SettlesClaim(t *testing.T) {
+	tests := []struct {
+		desc              string
+		appStakeAmount    int64
+		numOtherSuppliers int
+		numMinedRelays    int
+		expectedAppStake  int64
+		expectedAppStaked bool
+	}{
+		{
+			desc:              "application is billed for the estimated relays",
+			appStakeAmount:    1000,
+			numMinedRelays:    5,
+			expectedAppStake:  995,
+			expectedAppStaked: true,
+		},
+		{
+			// The application can be billed for 3 compute units by each of the 2
+			// suppliers in the session.
+			desc:              "application is billed for at most its relay quota",
+			appStakeAmount:    6,
+			numOtherSuppliers: 1,
+			numMinedRelays:    5,
+			expectedAppStake:  3,
+			expectedAppStaked: true,
+		},
+		{
+			desc:              "application is unstaked once its stake is exhausted",
+			appStakeAmount:    5,
+			numMinedRelays:    5,
+			expectedAppStake:  0,
+			expectedAppStaked: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			k, ctx := keepertest.SupplierKeeper(t)
+			srv := keeper.NewMsgServerImpl(*k)
+			wctx := sdk.WrapSDKContext(ctx)
+
+			supplierAddr := sample.AccAddress()
+			otherSupplierAddrs := make([]string, test.numOtherSuppliers)
+			for i := range otherSupplierAddrs {
+				otherSupplierAddrs[i] = sample.AccAddress()
+			}
+			sessionHeader := keepertest.AddSupplierSession(t, supplierAddr, test.appStakeAmount, otherSupplierAddrs...)
+
+			tree := keepertest.NewSupplierSessionTree(t, supplierAddr, sessionHeader, test.numMinedRelays)
+			_, err := srv.CreateClaim(wctx, types.NewMsgCreateClaim(supplierAddr, sessionHeader, tree.Root()))
+			require.NoError(t, err)
+
+			proof, err := tree.ProveClosest(servicetypes.RelaySMSTPath([]byte("block_hash")))
+			require.NoError(t, err)
+			proofBz, err := proof.Marshal()
+			require.NoError(t, err)
+
+			_, err = srv.SubmitProof(wctx, &types.MsgSubmitProof{
+				SupplierAddress: supplierAddr,
+				SessionHeader:   sessionHeader,
+				Proof:           proofBz,
+			})
+			require.NoError(t, err)
+
+			app, isAppFound := keepertest.SupplierApplicationsMap[sessionHeader.ApplicationAddress]
+			require.Equal(t, test.expectedAppStaked, isAppFound)
+			if test.expectedAppStaked {
+				require.Equal(t, test.expectedAppStake, app.Stake.Amount.Int64())
+			}
+
+			// The proof is stored once the claim is settled.
+			proofFound, isProofFound := k.GetProof(ctx, sessionHeader.SessionId, supplierAddr)
+			require.True(t, isProofFound)
+			require.Equal(t, supplierAddr, proofFound.SupplierAddress)
+			require.Equal(t, sessionHeader, proofFound.SessionHeader)
+			require.Equal(t, proofBz, proofFound.ClosestMerkleProof)
+
+			// A settled claim can't be proven again.
+			_, isClaimFound := k.GetClaim(ctx, sessionHeader.SessionId, supplierAddr)
+			require.False(t, isClaimFound)
+
+			_, err = srv.SubmitProof(wctx, &types.MsgSubmitProof{
+				SupplierAddress: supplierAddr,
+				SessionHeader:   sessionHeader,
+				Proof:           proofBz,
+			})
+			require.ErrorIs(t, err, types.ErrSupplierClaimNotFound)
+		})
+	}
+}
+
+func TestMsgServer_SubmitProof_ValidatesSessionHeader(t *testing.T) {
+	k, ctx := keepertest.SupplierKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	supplierAddr := sample.AccAddress()
+	sessionHeader, tree := claimSupplierSession(t, srv, wctx, supplierAddr, supplierAddr, 5)
+
+	proof, err := tree.ProveClosest(servicetypes.RelaySMSTPath([]byte("block_hash")))
+	require.NoError(t, err)
+	proofBz, err := proof.Marshal()
+	require.NoError(t, err)
+
+	// Proofs are validated against the claimed session rather than the on-chain
+	// one, which the supplier may no longer be part of.
+	tests := []struct {
+		desc              string
+		editSessionHeader func(sessionHeader *sessiontypes.SessionHeader)
+		expectedErr       error
+	}{
+		{
+			desc: "session end height does not match claim",
+			editSessionHeader: func(sessionHeader *sessiontypes.SessionHeader) {
+				sessionHeader.SessionEndBlockHeight++
+			},
+			expectedErr: types.ErrSupplierInvalidSessionEndHeight,
+		},
+		{
+			desc: "service does not match claim",
+			editSessionHeader: func(sessionHeader *sessiontypes.SessionHeader) {
+				sessionHeader.Service = &sharedtypes.Service{Id: "svc2"}
+			},
+			expectedErr: types.ErrSupplierInvalidService,
+		},
+		{
+			desc: "application does not match claim",
+			editSessionHeader: func(sessionHeader *sessiontypes.SessionHeader) {
+				sessionHeader.ApplicationAddress = sample.AccAddress()
+			},
+			expectedErr: types.ErrSupplierInvalidApplication,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			invalidSessionHeader := *sessionHeader
+			test.editSessionHeader(&invalidSessionHeader)
+
+			_, err := srv.SubmitProof(wctx, types.NewMsgSubmitProof(supplierAddr, &invalidSessionHeader, proofBz))
+			require.ErrorIs(t, err, test.expectedErr)
+
+			// The claim remains pending.
+			_, isClaimFound := k.GetClaim(ctx, sessionHeader.SessionId, supplierAddr)
+			require.True(t, isClaimFound)
+		})
+	}
+}
+
+// claimSupplierSession claims the given number of relays serviced by the
+// supplier with the given owner and operator addresses in a new session. It
+// returns the header of the session and its session tree.
+func claimSupplierSession(
+	t *testing.T,
+	srv types.MsgServer,
+	wctx context.Context,
+	ownerAddr string,
+	operatorAddr string,
+	numRelays int,
+) (*sessiontypes.SessionHeader, *smt.SMST) {
+	t.Helper()
+
+	// The session is hydrated from the staked supplier, which is owned by the
+	// given owner.
+	sessionHeader := keepertest.AddSupplierSession(t, operatorAddr, 1000)
+	keepertest.SupplierSessionsMap[sessionHeader.SessionId].Suppliers[0].OwnerAddress = ownerAddr
+
+	tree := keepertest.NewSupplierSessionTree(t, operatorAddr, sessionHeader, numRelays)
+	_, err := srv.CreateClaim(wctx, types.NewMsgCreateClaim(operatorAddr, sessionHeader, tree.Root()))
+	require.NoError(t, err)
+
+	return sessionHeader, tree
+}
+
+// proveSupplierSession submits the proof of the claim made by the supplier
+// with the given operator address for the session with the given header and
+// session tree.
+func proveSupplierSession(
+	t *testing.T,
+	srv types.MsgServer,
+	wctx context.Context,
+	operatorAddr string,
+	sessionHeader *sessiontypes.SessionHeader,
+	tree *smt.SMST,
+) {
+	t.Helper()
+
+	proof, err := tree.ProveClosest(servicetypes.RelaySMSTPath([]byte("block_hash")))
+	require.NoError(t, err)
+	proofBz, err := proof.Marshal()
+	require.NoError(t, err)
+
+	_, err = srv.SubmitProof(wctx, types.NewMsgSubmitProof(operatorAddr, sessionHeader, proofBz))
+	require.NoError(t, err)
+}
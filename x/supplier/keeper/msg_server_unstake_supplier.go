@@ -22,30 +22,38 @@ func (k msgServer) UnstakeSupplier(
 		return nil, err
 	}
 
-	// Check if the supplier already exists or not
-	supplier, isSupplierFound := k.GetSupplier(ctx, msg.Address)
-	if !isSupplierFound {
-		logger.Info("Supplier not found. Cannot unstake address %s", msg.Address)
-		return nil, types.ErrSupplierNotFound
+	// Check if the supplier already exists and is owned by the signer
+	operatorAddress := msg.GetOperatorAddressOrOwner()
+	supplier, err := k.getOwnedSupplier(ctx, msg.OwnerAddress, operatorAddress)
+	if err != nil {
+		logger.Info("Cannot unstake supplier with operator address %s: %v", operatorAddress, err)
+		return nil, err
 	}
-	logger.Info("Supplier found. Unstaking supplier for address %s", msg.Address)
+	logger.Info("Supplier found. Unstaking supplier for operator address %s", operatorAddress)
 
-	// Retrieve the address of the supplier
-	supplierAddress, err := sdk.AccAddressFromBech32(msg.Address)
+	// Retrieve the address of the supplier's owner
+	ownerAddress, err := sdk.AccAddressFromBech32(msg.OwnerAddress)
 	if err != nil {
-		logger.Error("could not parse address %s", msg.Address)
+		logger.Error("could not parse owner address %s", msg.OwnerAddress)
 		return nil, err
 	}
 
-	// Send the coins from the supplier pool back to the supplier
-	err = k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.ModuleName, supplierAddress, []sdk.Coin{*supplier.Stake})
+	// Send the coins from the supplier pool back to the supplier's owner
+	err = k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.ModuleName, ownerAddress, []sdk.Coin{*supplier.Stake})
 	if err != nil {
-		logger.Error("could not send %v coins from %s module to %s account due to %v", supplier.Stake, supplierAddress, types.ModuleName, err)
+		logger.Error("could not send %v coins from %s module to %s account due to %v", supplier.Stake, types.ModuleName, ownerAddress, err)
 		return nil, err
 	}
 
 	// Update the Supplier in the store
-	k.RemoveSupplier(ctx, supplierAddress.String())
+	k.RemoveSupplier(ctx, operatorAddress)
 	logger.Info("Successfully removed the supplier: %+v", supplier)
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventSupplierUnstaked{
+		Supplier: supplier,
+	}); err != nil {
+		logger.Error("failed to emit EventSupplierUnstaked: %v", err)
+		return nil, err
+	}
+
 	return &types.MsgUnstakeSupplierResponse{}, nil
 }
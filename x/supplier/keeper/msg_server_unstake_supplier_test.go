@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
+	"github.com/pokt-network/poktroll/testutil/events"
 	keepertest "github.com/pokt-network/poktroll/testutil/keeper"
 	"github.com/pokt-network/poktroll/testutil/sample"
 	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
@@ -28,8 +29,8 @@ func TestMsgServer_UnstakeSupplier_Success(t *testing.T) {
 	// Prepare the supplier
 	initialStake := sdk.NewCoin("upokt", sdk.NewInt(100))
 	stakeMsg := &types.MsgStakeSupplier{
-		Address: addr,
-		Stake:   &initialStake,
+		OwnerAddress: addr,
+		Stake:        &initialStake,
 		Services: []*sharedtypes.SupplierServiceConfig{
 			{
 				Service: &sharedtypes.Service{
@@ -53,18 +54,24 @@ func TestMsgServer_UnstakeSupplier_Success(t *testing.T) {
 	// Verify that the supplier exists
 	foundSupplier, isSupplierFound := k.GetSupplier(ctx, addr)
 	require.True(t, isSupplierFound)
-	require.Equal(t, addr, foundSupplier.Address)
+	require.Equal(t, addr, foundSupplier.OperatorAddress)
 	require.Equal(t, initialStake.Amount, foundSupplier.Stake.Amount)
 	require.Len(t, foundSupplier.Services, 1)
 
 	// Unstake the supplier
-	unstakeMsg := &types.MsgUnstakeSupplier{Address: addr}
+	unstakeMsg := &types.MsgUnstakeSupplier{OwnerAddress: addr}
 	_, err = srv.UnstakeSupplier(wctx, unstakeMsg)
 	require.NoError(t, err)
 
 	// Make sure the supplier can no longer be found after unstaking
 	_, isSupplierFound = k.GetSupplier(ctx, addr)
 	require.False(t, isSupplierFound)
+
+	// Verify that the unstake was emitted
+	unstakedEvents := events.FilterTypedEvents[*types.EventSupplierUnstaked](t, ctx.EventManager().Events())
+	require.Len(t, unstakedEvents, 1)
+	require.Equal(t, addr, unstakedEvents[0].Supplier.OperatorAddress)
+	require.Equal(t, initialStake.Amount, unstakedEvents[0].Supplier.Stake.Amount)
 }
 
 func TestMsgServer_UnstakeSupplier_FailIfNotStaked(t *testing.T) {
@@ -80,7 +87,7 @@ func TestMsgServer_UnstakeSupplier_FailIfNotStaked(t *testing.T) {
 	require.False(t, isSupplierFound)
 
 	// Unstake the supplier
-	unstakeMsg := &types.MsgUnstakeSupplier{Address: addr}
+	unstakeMsg := &types.MsgUnstakeSupplier{OwnerAddress: addr}
 	_, err := srv.UnstakeSupplier(wctx, unstakeMsg)
 	require.Error(t, err)
 	require.ErrorIs(t, err, types.ErrSupplierNotFound)
@@ -88,3 +95,31 @@ func TestMsgServer_UnstakeSupplier_FailIfNotStaked(t *testing.T) {
 	_, isSupplierFound = k.GetSupplier(ctx, addr)
 	require.False(t, isSupplierFound)
 }
+
+func TestMsgServer_UnstakeSupplier_KeepsPendingClaimsSettleable(t *testing.T) {
+	k, ctx := keepertest.SupplierKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	ownerAddr := sample.AccAddress()
+	operatorAddr := sample.AccAddress()
+	_, err := srv.StakeSupplier(wctx, newStakeSupplierMsg(ownerAddr, operatorAddr))
+	require.NoError(t, err)
+
+	// Claim the relays serviced in a session before unstaking
+	sessionHeader, tree := claimSupplierSession(t, srv, wctx, ownerAddr, operatorAddr, 5)
+	_, err = srv.UnstakeSupplier(wctx, types.NewMsgUnstakeSupplier(ownerAddr, operatorAddr))
+	require.NoError(t, err)
+
+	// The claim remains pending and can still be proven, rewarding the owner of
+	// the unstaked supplier
+	claim, isClaimFound := k.GetClaim(ctx, sessionHeader.SessionId, operatorAddr)
+	require.True(t, isClaimFound)
+	require.Equal(t, ownerAddr, claim.SupplierOwnerAddress)
+
+	proveSupplierSession(t, srv, wctx, operatorAddr, sessionHeader, tree)
+	claimSettledEvents := events.FilterTypedEvents[*types.EventClaimSettled](t, ctx.EventManager().Events())
+	require.Len(t, claimSettledEvents, 1)
+	require.Equal(t, claim, claimSettledEvents[0].Claim)
+	require.Equal(t, sdk.NewCoin("upokt", sdk.NewInt(5)), claimSettledEvents[0].Reward)
+}
@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"context"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	sharedhelpers "github.com/pokt-network/poktroll/x/shared/helpers"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+	"github.com/pokt-network/poktroll/x/supplier/types"
+)
+
+// UpdateSupplierOperator schedules the replacement of the operator of a staked
+// supplier, which signs its relays, claims and proofs, without unstaking it.
+// The new operator only takes effect at the start of the next session, such
+// that the session membership doesn't change mid-session.
+func (k msgServer) UpdateSupplierOperator(
+	goCtx context.Context,
+	msg *types.MsgUpdateSupplierOperator,
+) (*types.MsgUpdateSupplierOperatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	logger := k.Logger(ctx).With("method", "UpdateSupplierOperator")
+	logger.Info("About to update supplier operator with msg: %v", msg)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	supplier, err := k.getOwnedSupplier(ctx, msg.OwnerAddress, msg.OperatorAddress)
+	if err != nil {
+		logger.Info("Cannot update the operator of supplier with operator address %s: %v", msg.OperatorAddress, err)
+		return nil, err
+	}
+
+	// Suppliers are identified by their operator address, which must therefore
+	// neither be used by another supplier nor be about to be.
+	if k.isOperatorAddressInUse(ctx, msg.NewOperatorAddress) {
+		return nil, sdkerrors.Wrapf(types.ErrSupplierOperatorInUse, "operator address %s is already used by a supplier", msg.NewOperatorAddress)
+	}
+
+	// Updates made within the same session replace each other.
+	supplier.PendingOperatorUpdate = &sharedtypes.SupplierOperatorUpdate{
+		NewOperatorAddress:     msg.NewOperatorAddress,
+		EffectiveSessionNumber: sharedhelpers.GetSessionNumber(ctx.BlockHeight()) + 1,
+	}
+
+	k.SetSupplier(ctx, supplier)
+	logger.Info("Successfully updated pending operator for supplier: %+v", supplier)
+
+	return &types.MsgUpdateSupplierOperatorResponse{}, nil
+}
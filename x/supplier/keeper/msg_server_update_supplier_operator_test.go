@@ -0,0 +1,212 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/testutil/events"
+	keepertest "github.com/pokt-network/poktroll/testutil/keeper"
+	"github.com/pokt-network/poktroll/testutil/sample"
+	sharedhelpers "github.com/pokt-network/poktroll/x/shared/helpers"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+	"github.com/pokt-network/poktroll/x/supplier/keeper"
+	"github.com/pokt-network/poktroll/x/supplier/types"
+)
+
+func TestMsgServer_UpdateSupplierOperator_TakesEffectAtNextSession(t *testing.T) {
+	k, ctx := keepertest.SupplierKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+
+	// Stake a supplier operated by a different address than its owner, in the
+	// middle of a session
+	ownerAddr := sample.AccAddress()
+	operatorAddr := sample.AccAddress()
+	ctx = ctx.WithBlockHeight(sharedhelpers.NumBlocksPerSession + 1)
+	stakeMsg := newStakeSupplierMsg(ownerAddr, operatorAddr)
+	_, err := srv.StakeSupplier(sdk.WrapSDKContext(ctx), stakeMsg)
+	require.NoError(t, err)
+
+	// The supplier is identified by its operator address
+	supplierFound, isSupplierFound := k.GetSupplier(ctx, operatorAddr)
+	require.True(t, isSupplierFound)
+	require.Equal(t, ownerAddr, supplierFound.OwnerAddress)
+	require.Equal(t, operatorAddr, supplierFound.OperatorAddress)
+
+	// Rotate the operator of the supplier
+	newOperatorAddr := sample.AccAddress()
+	updateMsg := types.NewMsgUpdateSupplierOperator(ownerAddr, operatorAddr, newOperatorAddr)
+	_, err = srv.UpdateSupplierOperator(sdk.WrapSDKContext(ctx), updateMsg)
+	require.NoError(t, err)
+
+	// The new operator is pending for the rest of the session
+	supplierFound, isSupplierFound = k.GetSupplier(ctx, operatorAddr)
+	require.True(t, isSupplierFound)
+	require.NotNil(t, supplierFound.PendingOperatorUpdate)
+	require.Equal(t, newOperatorAddr, supplierFound.PendingOperatorUpdate.NewOperatorAddress)
+	require.Equal(t, int64(2), supplierFound.PendingOperatorUpdate.EffectiveSessionNumber)
+	_, isSupplierFound = k.GetSupplier(ctx, newOperatorAddr)
+	require.False(t, isSupplierFound)
+
+	// Pending updates are not applied in the middle of a session
+	ctx = ctx.WithBlockHeight(sharedhelpers.NumBlocksPerSession + 2)
+	k.ApplyPendingSupplierOperatorUpdates(ctx)
+	_, isSupplierFound = k.GetSupplier(ctx, operatorAddr)
+	require.True(t, isSupplierFound)
+
+	// At the start of the next session, the supplier can only be found by its
+	// new operator address
+	ctx = ctx.WithBlockHeight(2 * sharedhelpers.NumBlocksPerSession)
+	k.ApplyPendingSupplierOperatorUpdates(ctx)
+	_, isSupplierFound = k.GetSupplier(ctx, operatorAddr)
+	require.False(t, isSupplierFound)
+	supplierFound, isSupplierFound = k.GetSupplier(ctx, newOperatorAddr)
+	require.True(t, isSupplierFound)
+	require.Equal(t, ownerAddr, supplierFound.OwnerAddress)
+	require.Equal(t, newOperatorAddr, supplierFound.OperatorAddress)
+	require.Nil(t, supplierFound.PendingOperatorUpdate)
+	require.Equal(t, stakeMsg.Stake.Amount, supplierFound.Stake.Amount)
+	require.Len(t, supplierFound.Services, 1)
+	require.Len(t, k.GetSuppliersByService(ctx, "svcId"), 1)
+
+	// Verify that the operator update was emitted once it took effect
+	operatorUpdatedEvents := events.FilterTypedEvents[*types.EventSupplierOperatorUpdated](t, ctx.EventManager().Events())
+	require.Len(t, operatorUpdatedEvents, 1)
+	require.Equal(t, operatorAddr, operatorUpdatedEvents[0].PreviousOperatorAddress)
+	require.Equal(t, newOperatorAddr, operatorUpdatedEvents[0].Supplier.OperatorAddress)
+	require.Equal(t, ownerAddr, operatorUpdatedEvents[0].Supplier.OwnerAddress)
+}
+
+func TestMsgServer_UpdateSupplierOperator_FailIfNotOwner(t *testing.T) {
+	k, ctx := keepertest.SupplierKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	ownerAddr := sample.AccAddress()
+	operatorAddr := sample.AccAddress()
+	_, err := srv.StakeSupplier(wctx, newStakeSupplierMsg(ownerAddr, operatorAddr))
+	require.NoError(t, err)
+
+	// The operator cannot rotate itself
+	updateMsg := types.NewMsgUpdateSupplierOperator(operatorAddr, operatorAddr, sample.AccAddress())
+	_, err = srv.UpdateSupplierOperator(wctx, updateMsg)
+	require.ErrorIs(t, err, types.ErrSupplierUnauthorized)
+
+	// Neither can it unstake the supplier
+	_, err = srv.UnstakeSupplier(wctx, types.NewMsgUnstakeSupplier(operatorAddr, operatorAddr))
+	require.ErrorIs(t, err, types.ErrSupplierUnauthorized)
+
+	// Nor decrease its stake
+	decreasedStake := sdk.NewCoin("upokt", sdk.NewInt(50))
+	_, err = srv.DecreaseSupplierStake(wctx, types.NewMsgDecreaseSupplierStake(operatorAddr, operatorAddr, decreasedStake))
+	require.ErrorIs(t, err, types.ErrSupplierUnauthorized)
+
+	// Nor restake it
+	_, err = srv.StakeSupplier(wctx, newStakeSupplierMsg(operatorAddr, operatorAddr))
+	require.ErrorIs(t, err, types.ErrSupplierUnauthorized)
+
+	supplierFound, isSupplierFound := k.GetSupplier(ctx, operatorAddr)
+	require.True(t, isSupplierFound)
+	require.Equal(t, ownerAddr, supplierFound.OwnerAddress)
+}
+
+func TestMsgServer_UpdateSupplierOperator_FailIfOperatorInUse(t *testing.T) {
+	k, ctx := keepertest.SupplierKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	ownerAddr := sample.AccAddress()
+	operatorAddr := sample.AccAddress()
+	_, err := srv.StakeSupplier(wctx, newStakeSupplierMsg(ownerAddr, operatorAddr))
+	require.NoError(t, err)
+
+	otherOperatorAddr := sample.AccAddress()
+	_, err = srv.StakeSupplier(wctx, newStakeSupplierMsg(sample.AccAddress(), otherOperatorAddr))
+	require.NoError(t, err)
+
+	// The operator of another supplier cannot be reused
+	updateMsg := types.NewMsgUpdateSupplierOperator(ownerAddr, operatorAddr, otherOperatorAddr)
+	_, err = srv.UpdateSupplierOperator(wctx, updateMsg)
+	require.ErrorIs(t, err, types.ErrSupplierOperatorInUse)
+
+	// Neither can the pending operator of another supplier
+	pendingOperatorAddr := sample.AccAddress()
+	updateMsg = types.NewMsgUpdateSupplierOperator(ownerAddr, operatorAddr, pendingOperatorAddr)
+	_, err = srv.UpdateSupplierOperator(wctx, updateMsg)
+	require.NoError(t, err)
+
+	thirdOwnerAddr := sample.AccAddress()
+	thirdOperatorAddr := sample.AccAddress()
+	_, err = srv.StakeSupplier(wctx, newStakeSupplierMsg(thirdOwnerAddr, thirdOperatorAddr))
+	require.NoError(t, err)
+	updateMsg = types.NewMsgUpdateSupplierOperator(thirdOwnerAddr, thirdOperatorAddr, pendingOperatorAddr)
+	_, err = srv.UpdateSupplierOperator(wctx, updateMsg)
+	require.ErrorIs(t, err, types.ErrSupplierOperatorInUse)
+
+	// Nor can a new supplier be staked with it
+	_, err = srv.StakeSupplier(wctx, newStakeSupplierMsg(sample.AccAddress(), pendingOperatorAddr))
+	require.ErrorIs(t, err, types.ErrSupplierOperatorInUse)
+
+	// Rotating the operator of a supplier which isn't staked fails
+	updateMsg = types.NewMsgUpdateSupplierOperator(ownerAddr, sample.AccAddress(), sample.AccAddress())
+	_, err = srv.UpdateSupplierOperator(wctx, updateMsg)
+	require.ErrorIs(t, err, types.ErrSupplierNotFound)
+}
+
+func TestMsgServer_UpdateSupplierOperator_KeepsPendingClaimsSettleable(t *testing.T) {
+	k, ctx := keepertest.SupplierKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	ownerAddr := sample.AccAddress()
+	operatorAddr := sample.AccAddress()
+	_, err := srv.StakeSupplier(wctx, newStakeSupplierMsg(ownerAddr, operatorAddr))
+	require.NoError(t, err)
+
+	// Claim the relays serviced in a session before rotating the operator
+	sessionHeader, tree := claimSupplierSession(t, srv, wctx, ownerAddr, operatorAddr, 5)
+	newOperatorAddr := sample.AccAddress()
+	updateMsg := types.NewMsgUpdateSupplierOperator(ownerAddr, operatorAddr, newOperatorAddr)
+	_, err = srv.UpdateSupplierOperator(wctx, updateMsg)
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(sharedhelpers.NumBlocksPerSession)
+	k.ApplyPendingSupplierOperatorUpdates(ctx)
+	_, isSupplierFound := k.GetSupplier(ctx, newOperatorAddr)
+	require.True(t, isSupplierFound)
+
+	// The claim remains keyed by the previous operator, which can still prove it
+	// once the supplier is operated by the new one, rewarding its owner
+	claim, isClaimFound := k.GetClaim(ctx, sessionHeader.SessionId, operatorAddr)
+	require.True(t, isClaimFound)
+	require.Equal(t, ownerAddr, claim.SupplierOwnerAddress)
+
+	proveSupplierSession(t, srv, sdk.WrapSDKContext(ctx), operatorAddr, sessionHeader, tree)
+	claimSettledEvents := events.FilterTypedEvents[*types.EventClaimSettled](t, ctx.EventManager().Events())
+	require.Len(t, claimSettledEvents, 1)
+	require.Equal(t, claim, claimSettledEvents[0].Claim)
+	require.Equal(t, sdk.NewCoin("upokt", sdk.NewInt(5)), claimSettledEvents[0].Reward)
+}
+
+// newStakeSupplierMsg returns a message staking 100upokt for a supplier with
+// the given owner and operator addresses.
+func newStakeSupplierMsg(ownerAddr, operatorAddr string) *types.MsgStakeSupplier {
+	return types.NewMsgStakeSupplier(
+		ownerAddr,
+		operatorAddr,
+		sdk.NewCoin("upokt", sdk.NewInt(100)),
+		[]*sharedtypes.SupplierServiceConfig{
+			{
+				Service: &sharedtypes.Service{Id: "svcId"},
+				Endpoints: []*sharedtypes.SupplierEndpoint{
+					{
+						Url:     "http://localhost:8080",
+						RpcType: sharedtypes.RPCType_JSON_RPC,
+						Configs: make([]*sharedtypes.ConfigOption, 0),
+					},
+				},
+			},
+		},
+	)
+}
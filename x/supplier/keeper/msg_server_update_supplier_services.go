@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"context"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	sharedhelpers "github.com/pokt-network/poktroll/x/shared/helpers"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+	"github.com/pokt-network/poktroll/x/supplier/types"
+)
+
+// UpdateSupplierServices updates the service configs of a staked supplier
+// without restaking it. The updated service configs are pending until the start
+// of the next session, such that the session membership doesn't change within
+// the current one.
+func (k msgServer) UpdateSupplierServices(
+	goCtx context.Context,
+	msg *types.MsgUpdateSupplierServices,
+) (*types.MsgUpdateSupplierServicesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	logger := k.Logger(ctx).With("method", "UpdateSupplierServices")
+	logger.Info("About to update supplier services with msg: %v", msg)
+
+	if err := msg.ValidateBasic(); err != nil {
+		logger.Error("invalid MsgUpdateSupplierServices: %v", msg)
+		return nil, err
+	}
+
+	operatorAddress := msg.GetOperatorAddressOrOwner()
+	supplier, err := k.getOwnedSupplier(ctx, msg.OwnerAddress, operatorAddress)
+	if err != nil {
+		logger.Info("Cannot update the services of supplier with operator address %s: %v", operatorAddress, err)
+		return nil, err
+	}
+
+	// Updates made within the same session build on top of each other.
+	serviceConfigs := supplier.Services
+	if supplier.PendingServiceConfigUpdate != nil {
+		serviceConfigs = supplier.PendingServiceConfigUpdate.Services
+	}
+
+	updatedServiceConfigs, err := types.ApplySupplierServiceUpdates(serviceConfigs, msg.Updates)
+	if err != nil {
+		logger.Error("could not apply service updates for supplier %s: %v", operatorAddress, err)
+		return nil, err
+	}
+
+	// Validate that the resulting service configs maintain at least one service.
+	if err := sharedhelpers.ValidateSupplierServiceConfigs(updatedServiceConfigs); err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrSupplierInvalidServiceConfig, err.Error())
+	}
+
+	supplier.PendingServiceConfigUpdate = &sharedtypes.SupplierServiceConfigUpdate{
+		Services:               updatedServiceConfigs,
+		EffectiveSessionNumber: sharedhelpers.GetSessionNumber(ctx.BlockHeight()) + 1,
+	}
+
+	k.SetSupplier(ctx, supplier)
+	logger.Info("Successfully updated pending services for supplier: %+v", supplier)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventSupplierServicesUpdated{
+		Supplier: supplier,
+	}); err != nil {
+		logger.Error("failed to emit EventSupplierServicesUpdated: %v", err)
+		return nil, err
+	}
+
+	return &types.MsgUpdateSupplierServicesResponse{}, nil
+}
@@ -0,0 +1,128 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/testutil/events"
+	keepertest "github.com/pokt-network/poktroll/testutil/keeper"
+	"github.com/pokt-network/poktroll/testutil/sample"
+	sharedhelpers "github.com/pokt-network/poktroll/x/shared/helpers"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+	"github.com/pokt-network/poktroll/x/supplier/keeper"
+	"github.com/pokt-network/poktroll/x/supplier/types"
+)
+
+func TestMsgServer_UpdateSupplierServices_TakesEffectAtNextSession(t *testing.T) {
+	k, ctx := keepertest.SupplierKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+
+	// Stake a supplier in the middle of a session
+	addr := sample.AccAddress()
+	ctx = ctx.WithBlockHeight(sharedhelpers.NumBlocksPerSession + 1)
+	_, err := srv.StakeSupplier(sdk.WrapSDKContext(ctx), &types.MsgStakeSupplier{
+		OwnerAddress: addr,
+		Stake:        &sdk.Coin{Denom: "upokt", Amount: sdk.NewInt(100)},
+		Services: []*sharedtypes.SupplierServiceConfig{
+			{
+				Service: &sharedtypes.Service{Id: "svcId"},
+				Endpoints: []*sharedtypes.SupplierEndpoint{
+					{
+						Url:     "http://localhost:8080",
+						RpcType: sharedtypes.RPCType_JSON_RPC,
+						Configs: make([]*sharedtypes.ConfigOption, 0),
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	// Add a service
+	updateMsg := types.NewMsgUpdateSupplierServices(addr, "", []*types.SupplierServiceUpdate{
+		{
+			Action: types.SupplierServiceUpdateAction_ADD,
+			ServiceConfig: &sharedtypes.SupplierServiceConfig{
+				Service: &sharedtypes.Service{Id: "svcId2"},
+				Endpoints: []*sharedtypes.SupplierEndpoint{
+					{
+						Url:     "http://localhost:8082",
+						RpcType: sharedtypes.RPCType_JSON_RPC,
+						Configs: make([]*sharedtypes.ConfigOption, 0),
+					},
+				},
+			},
+		},
+	})
+	_, err = srv.UpdateSupplierServices(sdk.WrapSDKContext(ctx), updateMsg)
+	require.NoError(t, err)
+
+	// The service is pending for the rest of the session
+	supplierFound, isSupplierFound := k.GetSupplier(ctx, addr)
+	require.True(t, isSupplierFound)
+	require.Len(t, supplierFound.Services, 1)
+	require.NotNil(t, supplierFound.PendingServiceConfigUpdate)
+	require.Len(t, supplierFound.PendingServiceConfigUpdate.Services, 2)
+	require.Equal(t, int64(2), supplierFound.PendingServiceConfigUpdate.EffectiveSessionNumber)
+
+	// Pending updates are not applied in the middle of a session
+	ctx = ctx.WithBlockHeight(sharedhelpers.NumBlocksPerSession + 2)
+	k.ApplyPendingSupplierServiceConfigUpdates(ctx)
+	supplierFound, _ = k.GetSupplier(ctx, addr)
+	require.Len(t, supplierFound.Services, 1)
+
+	// The service is added at the start of the next session
+	ctx = ctx.WithBlockHeight(2 * sharedhelpers.NumBlocksPerSession)
+	k.ApplyPendingSupplierServiceConfigUpdates(ctx)
+	supplierFound, _ = k.GetSupplier(ctx, addr)
+	require.Nil(t, supplierFound.PendingServiceConfigUpdate)
+	require.Len(t, supplierFound.Services, 2)
+	require.Equal(t, "svcId2", supplierFound.Services[1].Service.Id)
+
+	// Verify that the scheduled update was emitted
+	servicesUpdatedEvents := events.FilterTypedEvents[*types.EventSupplierServicesUpdated](t, ctx.EventManager().Events())
+	require.Len(t, servicesUpdatedEvents, 1)
+	require.Equal(t, addr, servicesUpdatedEvents[0].Supplier.OperatorAddress)
+	require.Len(t, servicesUpdatedEvents[0].Supplier.PendingServiceConfigUpdate.Services, 2)
+	require.Equal(t, int64(2), servicesUpdatedEvents[0].Supplier.PendingServiceConfigUpdate.EffectiveSessionNumber)
+}
+
+func TestMsgServer_UpdateSupplierServices_FailRemoveAllServices(t *testing.T) {
+	k, ctx := keepertest.SupplierKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	serviceConfig := &sharedtypes.SupplierServiceConfig{
+		Service: &sharedtypes.Service{Id: "svcId"},
+		Endpoints: []*sharedtypes.SupplierEndpoint{
+			{
+				Url:     "http://localhost:8080",
+				RpcType: sharedtypes.RPCType_JSON_RPC,
+				Configs: make([]*sharedtypes.ConfigOption, 0),
+			},
+		},
+	}
+
+	addr := sample.AccAddress()
+	_, err := srv.StakeSupplier(wctx, &types.MsgStakeSupplier{
+		OwnerAddress: addr,
+		Stake:        &sdk.Coin{Denom: "upokt", Amount: sdk.NewInt(100)},
+		Services:     []*sharedtypes.SupplierServiceConfig{serviceConfig},
+	})
+	require.NoError(t, err)
+
+	// Removing the only endpoint of the only service leaves the supplier without services
+	updateMsg := types.NewMsgUpdateSupplierServices(addr, "", types.NewSupplierServiceUpdates(
+		types.SupplierServiceUpdateAction_REMOVE,
+		[]*sharedtypes.SupplierServiceConfig{serviceConfig},
+	))
+	_, err = srv.UpdateSupplierServices(wctx, updateMsg)
+	require.ErrorIs(t, err, types.ErrSupplierInvalidServiceConfig)
+
+	// Updating the services of a supplier which isn't staked fails
+	updateMsg.OwnerAddress = sample.AccAddress()
+	_, err = srv.UpdateSupplierServices(wctx, updateMsg)
+	require.ErrorIs(t, err, types.ErrSupplierNotFound)
+}
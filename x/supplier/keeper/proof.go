@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/pokt-network/poktroll/x/supplier/types"
+)
+
+// UpsertProof adds a proof to the store, replacing the existing one for the
+// same session and supplier, if any.
+// TODO_TECHDEBT: Prune the proofs of old sessions, which are only kept as a
+// record of the claims which were settled.
+func (k Keeper) UpsertProof(ctx sdk.Context, proof types.Proof) {
+	logger := k.Logger(ctx).With("method", "UpsertProof")
+
+	proofBz := k.cdc.MustMarshal(&proof)
+	primaryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProofPrimaryKeyPrefix))
+	primaryKey := types.ProofPrimaryKey(proof.GetSessionHeader().GetSessionId(), proof.SupplierAddress)
+	primaryStore.Set(primaryKey, proofBz)
+
+	logger.Info("upserted proof for supplier %s with primaryKey %s", proof.SupplierAddress, primaryKey)
+}
+
+// GetProof returns a Proof given a SessionId & SupplierAddr
+func (k Keeper) GetProof(ctx sdk.Context, sessionId, supplierAddr string) (val types.Proof, found bool) {
+	primaryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProofPrimaryKeyPrefix))
+	b := primaryStore.Get(types.ProofPrimaryKey(sessionId, supplierAddr))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllProofs returns all proof
+func (k Keeper) GetAllProofs(ctx sdk.Context) (proofs []types.Proof) {
+	primaryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProofPrimaryKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(primaryStore, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Proof
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		proofs = append(proofs, val)
+	}
+
+	return
+}
@@ -0,0 +1,59 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/pokt-network/poktroll/testutil/keeper"
+	"github.com/pokt-network/poktroll/testutil/nullify"
+	"github.com/pokt-network/poktroll/testutil/sample"
+	sessiontypes "github.com/pokt-network/poktroll/x/session/types"
+	"github.com/pokt-network/poktroll/x/supplier/keeper"
+	"github.com/pokt-network/poktroll/x/supplier/types"
+)
+
+func createNProofs(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.Proof {
+	proofs := make([]types.Proof, n)
+	for i := range proofs {
+		proofs[i].SupplierAddress = sample.AccAddress()
+		proofs[i].SessionHeader = &sessiontypes.SessionHeader{SessionId: fmt.Sprintf("session-%d", i)}
+		proofs[i].ClosestMerkleProof = []byte(fmt.Sprintf("proof-%d", i))
+		keeper.UpsertProof(ctx, proofs[i])
+	}
+	return proofs
+}
+
+func TestProof_Get(t *testing.T) {
+	keeper, ctx := keepertest.SupplierKeeper(t)
+	proofs := createNProofs(keeper, ctx, 10)
+	for _, proof := range proofs {
+		foundProof, isProofFound := keeper.GetProof(ctx,
+			proof.SessionHeader.SessionId,
+			proof.SupplierAddress,
+		)
+		require.True(t, isProofFound)
+		require.Equal(t,
+			nullify.Fill(&proof),
+			nullify.Fill(&foundProof),
+		)
+	}
+
+	// Proofs of other suppliers of the same session aren't found
+	_, isProofFound := keeper.GetProof(ctx, proofs[0].SessionHeader.SessionId, sample.AccAddress())
+	require.False(t, isProofFound)
+}
+
+func TestProof_GetAll(t *testing.T) {
+	keeper, ctx := keepertest.SupplierKeeper(t)
+	proofs := createNProofs(keeper, ctx, 10)
+
+	// Get all the proofs and check if they match
+	allFoundProofs := keeper.GetAllProofs(ctx)
+	require.ElementsMatch(t,
+		nullify.Fill(proofs),
+		nullify.Fill(allFoundProofs),
+	)
+}
@@ -42,6 +42,38 @@ func (k Keeper) SupplierAll(goCtx context.Context, req *types.QueryAllSupplierRe
 	return &types.QueryAllSupplierResponse{Supplier: suppliers, Pagination: pageRes}, nil
 }
 
+func (k Keeper) SuppliersByService(goCtx context.Context, req *types.QuerySuppliersByServiceRequest) (*types.QuerySuppliersByServiceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.ServiceId == "" {
+		return nil, status.Error(codes.InvalidArgument, "service id is required")
+	}
+
+	var suppliers []sharedtypes.Supplier
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// The service index points to the primary keys of the suppliers, which
+	// need to be retrieved before being decoded.
+	keyPrefix := types.KeyPrefix(types.SupplierServicePrefix)
+	keyPrefix = append(keyPrefix, types.SupplierServiceKeyPrefix(req.ServiceId)...)
+	serviceStoreIndex := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+
+	pageRes, err := query.Paginate(serviceStoreIndex, req.Pagination, func(key []byte, value []byte) error {
+		supplier, isSupplierFound := k.getSupplierByPrimaryKey(ctx, value)
+		if isSupplierFound {
+			suppliers = append(suppliers, supplier)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySuppliersByServiceResponse{Supplier: suppliers, Pagination: pageRes}, nil
+}
+
 func (k Keeper) Supplier(goCtx context.Context, req *types.QueryGetSupplierRequest) (*types.QueryGetSupplierResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
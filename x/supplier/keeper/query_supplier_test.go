@@ -31,14 +31,14 @@ func TestSupplierQuerySingle(t *testing.T) {
 		{
 			desc: "First",
 			request: &types.QueryGetSupplierRequest{
-				Address: msgs[0].Address,
+				Address: msgs[0].OperatorAddress,
 			},
 			response: &types.QueryGetSupplierResponse{Supplier: msgs[0]},
 		},
 		{
 			desc: "Second",
 			request: &types.QueryGetSupplierRequest{
-				Address: msgs[1].Address,
+				Address: msgs[1].OperatorAddress,
 			},
 			response: &types.QueryGetSupplierResponse{Supplier: msgs[1]},
 		},
@@ -125,3 +125,60 @@ func TestSupplierQueryPaginated(t *testing.T) {
 		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
 	})
 }
+
+func TestSuppliersByServiceQueryPaginated(t *testing.T) {
+	keeper, ctx := keepertest.SupplierKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNSupplier(keeper, ctx, 6)
+
+	// Stake all but the last supplier for the same service
+	serviceId := "svc0"
+	for i := range msgs[:5] {
+		msgs[i].Services = msgs[0].Services
+		keeper.SetSupplier(ctx, msgs[i])
+	}
+	msgs = msgs[:5]
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QuerySuppliersByServiceRequest {
+		return &types.QuerySuppliersByServiceRequest{
+			ServiceId: serviceId,
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.SuppliersByService(wctx, request(next, 0, uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.Supplier), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.Supplier),
+			)
+			next = resp.Pagination.NextKey
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := keeper.SuppliersByService(wctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+		require.ElementsMatch(t,
+			nullify.Fill(msgs),
+			nullify.Fill(resp.Supplier),
+		)
+	})
+	t.Run("MissingServiceId", func(t *testing.T) {
+		_, err := keeper.SuppliersByService(wctx, &types.QuerySuppliersByServiceRequest{})
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "service id is required"))
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.SuppliersByService(wctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	sdkerrors "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -8,13 +9,27 @@ import (
 	"github.com/pokt-network/poktroll/x/supplier/types"
 )
 
-// SetSupplier set a specific supplier in the store from its index
+// SetSupplier set a specific supplier in the store from its index, and updates
+// the service index to match its current services.
 func (k Keeper) SetSupplier(ctx sdk.Context, supplier sharedtypes.Supplier) {
+	// Drop the service index entries of the supplier being overwritten, if any,
+	// since its services may have changed.
+	if prevSupplier, isSupplierFound := k.GetSupplier(ctx, supplier.OperatorAddress); isSupplierFound {
+		k.removeSupplierServiceIndex(ctx, prevSupplier)
+	}
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SupplierKeyPrefix))
 	b := k.cdc.MustMarshal(&supplier)
-	store.Set(types.SupplierKey(
-		supplier.Address,
-	), b)
+	primaryKey := types.SupplierKey(
+		supplier.OperatorAddress,
+	)
+	store.Set(primaryKey, b)
+
+	// Update the service index: serviceId -> [SupplierKey]
+	serviceStoreIndex := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SupplierServicePrefix))
+	for _, serviceConfig := range supplier.Services {
+		serviceStoreIndex.Set(types.SupplierServiceKey(serviceConfig.GetService().GetId(), primaryKey), primaryKey)
+	}
 }
 
 // GetSupplier returns a supplier from its index
@@ -36,18 +51,66 @@ func (k Keeper) GetSupplier(
 	return supplier, true
 }
 
+// getOwnedSupplier returns the supplier with the given operator address, making
+// sure that it is owned by the given owner address.
+func (k Keeper) getOwnedSupplier(
+	ctx sdk.Context,
+	ownerAddress string,
+	operatorAddress string,
+) (sharedtypes.Supplier, error) {
+	supplier, isSupplierFound := k.GetSupplier(ctx, operatorAddress)
+	if !isSupplierFound {
+		return supplier, sdkerrors.Wrapf(types.ErrSupplierNotFound, "supplier not found with operator address: %s", operatorAddress)
+	}
+	if supplier.OwnerAddress != ownerAddress {
+		return supplier, sdkerrors.Wrapf(types.ErrSupplierUnauthorized, "owner address (%s) != supplier owner address (%s)", ownerAddress, supplier.OwnerAddress)
+	}
+	return supplier, nil
+}
+
+// isOperatorAddressInUse returns true if the given operator address is used by
+// a supplier, or will be once the pending operator update of one takes effect.
+// TODO_OPTIMIZE: Index the pending operator updates by their new operator
+// address instead of iterating over all the suppliers.
+func (k Keeper) isOperatorAddressInUse(ctx sdk.Context, operatorAddress string) bool {
+	if _, isSupplierFound := k.GetSupplier(ctx, operatorAddress); isSupplierFound {
+		return true
+	}
+	for _, supplier := range k.GetAllSupplier(ctx) {
+		if supplier.GetPendingOperatorUpdate().GetNewOperatorAddress() == operatorAddress {
+			return true
+		}
+	}
+	return false
+}
+
 // RemoveSupplier removes a supplier from the store
 func (k Keeper) RemoveSupplier(
 	ctx sdk.Context,
 	supplierAddr string,
 
 ) {
+	supplier, isSupplierFound := k.GetSupplier(ctx, supplierAddr)
+	if !isSupplierFound {
+		return
+	}
+	k.removeSupplierServiceIndex(ctx, supplier)
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SupplierKeyPrefix))
 	store.Delete(types.SupplierKey(
 		supplierAddr,
 	))
 }
 
+// removeSupplierServiceIndex removes the service index entries of the given supplier
+func (k Keeper) removeSupplierServiceIndex(ctx sdk.Context, supplier sharedtypes.Supplier) {
+	serviceStoreIndex := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SupplierServicePrefix))
+	primaryKey := types.SupplierKey(supplier.OperatorAddress)
+	for _, serviceConfig := range supplier.Services {
+		serviceStoreIndex.Delete(types.SupplierServiceKey(serviceConfig.GetService().GetId(), primaryKey))
+	}
+}
+
 // GetAllSupplier returns all supplier
 func (k Keeper) GetAllSupplier(ctx sdk.Context) (suppliers []sharedtypes.Supplier) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SupplierKeyPrefix))
@@ -64,5 +127,31 @@ func (k Keeper) GetAllSupplier(ctx sdk.Context) (suppliers []sharedtypes.Supplie
 	return
 }
 
-// TODO_OPTIMIZE: Index suppliers by service so we can easily query `k.GetAllSupplier(ctx, Service)`
-// func (k Keeper) GetAllSupplier(ctx, sdkContext, serviceId string) (suppliers []sharedtypes.Supplier) {}
+// GetSuppliersByService returns all suppliers currently staked for the given service
+func (k Keeper) GetSuppliersByService(ctx sdk.Context, serviceId string) (suppliers []sharedtypes.Supplier) {
+	serviceStoreIndex := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SupplierServicePrefix))
+
+	iterator := sdk.KVStorePrefixIterator(serviceStoreIndex, types.SupplierServiceKeyPrefix(serviceId))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		primaryKey := iterator.Value()
+		supplier, isSupplierFound := k.getSupplierByPrimaryKey(ctx, primaryKey)
+		if isSupplierFound {
+			suppliers = append(suppliers, supplier)
+		}
+	}
+
+	return suppliers
+}
+
+// getSupplierByPrimaryKey is a helper that retrieves, if exists, the Supplier associated with the key provided
+func (k Keeper) getSupplierByPrimaryKey(ctx sdk.Context, primaryKey []byte) (supplier sharedtypes.Supplier, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SupplierKeyPrefix))
+	b := store.Get(primaryKey)
+	if b == nil {
+		return supplier, false
+	}
+	k.cdc.MustUnmarshal(b, &supplier)
+	return supplier, true
+}
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	sharedhelpers "github.com/pokt-network/poktroll/x/shared/helpers"
+	"github.com/pokt-network/poktroll/x/supplier/types"
+)
+
+// ApplyPendingSupplierOperatorUpdates moves the suppliers whose pending operator
+// updates take effect in the session of the current block to their new operator
+// address. It only does so at the first block of a session.
+// TODO_OPTIMIZE: Index the suppliers with pending updates by their effective
+// session number instead of iterating over all of them.
+func (k Keeper) ApplyPendingSupplierOperatorUpdates(ctx sdk.Context) {
+	blockHeight := ctx.BlockHeight()
+	if sharedhelpers.GetSessionStartBlockHeight(blockHeight) != blockHeight {
+		return
+	}
+
+	logger := k.Logger(ctx).With("method", "ApplyPendingSupplierOperatorUpdates")
+
+	sessionNumber := sharedhelpers.GetSessionNumber(blockHeight)
+	for _, supplier := range k.GetAllSupplier(ctx) {
+		pendingUpdate := supplier.PendingOperatorUpdate
+		if pendingUpdate == nil || pendingUpdate.EffectiveSessionNumber > sessionNumber {
+			continue
+		}
+
+		// Update the Supplier in the store under its new operator address
+		previousOperatorAddress := supplier.OperatorAddress
+		k.RemoveSupplier(ctx, previousOperatorAddress)
+		supplier.OperatorAddress = pendingUpdate.NewOperatorAddress
+		supplier.PendingOperatorUpdate = nil
+		k.SetSupplier(ctx, supplier)
+
+		logger.Info("Applied pending operator %s for supplier previously operated by %s", supplier.OperatorAddress, previousOperatorAddress)
+
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventSupplierOperatorUpdated{
+			Supplier:                supplier,
+			PreviousOperatorAddress: previousOperatorAddress,
+		}); err != nil {
+			logger.Error("failed to emit EventSupplierOperatorUpdated: %v", err)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	sharedhelpers "github.com/pokt-network/poktroll/x/shared/helpers"
+)
+
+// ApplyPendingSupplierServiceConfigUpdates replaces the service configs of the
+// suppliers whose pending service config updates take effect in the session of
+// the current block. It only does so at the first block of a session.
+// TODO_OPTIMIZE: Index the suppliers with pending updates by their effective
+// session number instead of iterating over all of them.
+func (k Keeper) ApplyPendingSupplierServiceConfigUpdates(ctx sdk.Context) {
+	blockHeight := ctx.BlockHeight()
+	if sharedhelpers.GetSessionStartBlockHeight(blockHeight) != blockHeight {
+		return
+	}
+
+	logger := k.Logger(ctx).With("method", "ApplyPendingSupplierServiceConfigUpdates")
+
+	sessionNumber := sharedhelpers.GetSessionNumber(blockHeight)
+	for _, supplier := range k.GetAllSupplier(ctx) {
+		pendingUpdate := supplier.PendingServiceConfigUpdate
+		if pendingUpdate == nil || pendingUpdate.EffectiveSessionNumber > sessionNumber {
+			continue
+		}
+
+		supplier.Services = pendingUpdate.Services
+		supplier.PendingServiceConfigUpdate = nil
+		k.SetSupplier(ctx, supplier)
+
+		logger.Info("Applied pending service configs for supplier %s", supplier.OperatorAddress)
+	}
+}
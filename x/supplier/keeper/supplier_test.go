@@ -29,7 +29,8 @@ func createNSupplier(keeper *keeper.Keeper, ctx sdk.Context, n int) []sharedtype
 	suppliers := make([]sharedtypes.Supplier, n)
 	for i := range suppliers {
 		supplier := &suppliers[i]
-		supplier.Address = sample.AccAddress()
+		supplier.OwnerAddress = sample.AccAddress()
+		supplier.OperatorAddress = supplier.OwnerAddress
 		supplier.Stake = &sdk.Coin{Denom: "upokt", Amount: sdk.NewInt(int64(i))}
 		supplier.Services = []*sharedtypes.SupplierServiceConfig{
 			{
@@ -54,7 +55,7 @@ func TestSupplierGet(t *testing.T) {
 	suppliers := createNSupplier(keeper, ctx, 10)
 	for _, supplier := range suppliers {
 		supplierFound, isSupplierFound := keeper.GetSupplier(ctx,
-			supplier.Address,
+			supplier.OperatorAddress,
 		)
 		require.True(t, isSupplierFound)
 		require.Equal(t,
@@ -68,10 +69,10 @@ func TestSupplierRemove(t *testing.T) {
 	suppliers := createNSupplier(keeper, ctx, 10)
 	for _, supplier := range suppliers {
 		keeper.RemoveSupplier(ctx,
-			supplier.Address,
+			supplier.OperatorAddress,
 		)
 		_, isSupplierFound := keeper.GetSupplier(ctx,
-			supplier.Address,
+			supplier.OperatorAddress,
 		)
 		require.False(t, isSupplierFound)
 	}
@@ -86,6 +87,32 @@ func TestSupplierGetAll(t *testing.T) {
 	)
 }
 
+func TestSupplierGetByService(t *testing.T) {
+	keeper, ctx := keepertest.SupplierKeeper(t)
+	// Staking 11 suppliers makes sure that "svc1" doesn't match "svc10"
+	suppliers := createNSupplier(keeper, ctx, 11)
+
+	suppliersFound := keeper.GetSuppliersByService(ctx, "svc1")
+	require.Len(t, suppliersFound, 1)
+	require.Equal(t, nullify.Fill(&suppliers[1]), nullify.Fill(&suppliersFound[0]))
+
+	// Replacing the services of a supplier updates the index
+	supplier := suppliers[1]
+	supplier.Services = suppliers[2].Services
+	keeper.SetSupplier(ctx, supplier)
+	require.Empty(t, keeper.GetSuppliersByService(ctx, "svc1"))
+	require.ElementsMatch(t,
+		nullify.Fill([]sharedtypes.Supplier{suppliers[2], supplier}),
+		nullify.Fill(keeper.GetSuppliersByService(ctx, "svc2")),
+	)
+
+	// Removing a supplier removes it from the index
+	keeper.RemoveSupplier(ctx, supplier.OperatorAddress)
+	suppliersFound = keeper.GetSuppliersByService(ctx, "svc2")
+	require.Len(t, suppliersFound, 1)
+	require.Equal(t, nullify.Fill(&suppliers[2]), nullify.Fill(&suppliersFound[0]))
+}
+
 // The application module address is derived off of its semantic name.
 // This test is a helper for us to easily identify the underlying address.
 func TestApplicationModuleAddress(t *testing.T) {
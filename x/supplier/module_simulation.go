@@ -40,6 +40,18 @@ const (
 	// TODO: Determine the simulation weight value
 	defaultWeightMsgSubmitProof int = 100
 
+	opWeightMsgUpdateSupplierServices = "op_weight_msg_update_supplier_services"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgUpdateSupplierServices int = 100
+
+	opWeightMsgDecreaseSupplierStake = "op_weight_msg_decrease_supplier_stake"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgDecreaseSupplierStake int = 100
+
+	opWeightMsgUpdateSupplierOperator = "op_weight_msg_update_supplier_operator"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgUpdateSupplierOperator int = 100
+
 	// this line is used by starport scaffolding # simapp/module/const
 )
 
@@ -98,7 +110,7 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 	)
 	operations = append(operations, simulation.NewWeightedOperation(
 		weightMsgCreateClaim,
-		suppliersimulation.SimulateMsgCreateClaim(am.accountKeeper, am.bankKeeper, am.keeper),
+		suppliersimulation.SimulateMsgCreateClaim(am.accountKeeper, am.bankKeeper, am.keeper, am.serviceKeeper, am.sessionKeeper),
 	))
 
 	var weightMsgSubmitProof int
@@ -112,6 +124,39 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		suppliersimulation.SimulateMsgSubmitProof(am.accountKeeper, am.bankKeeper, am.keeper),
 	))
 
+	var weightMsgUpdateSupplierServices int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgUpdateSupplierServices, &weightMsgUpdateSupplierServices, nil,
+		func(_ *rand.Rand) {
+			weightMsgUpdateSupplierServices = defaultWeightMsgUpdateSupplierServices
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgUpdateSupplierServices,
+		suppliersimulation.SimulateMsgUpdateSupplierServices(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgDecreaseSupplierStake int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgDecreaseSupplierStake, &weightMsgDecreaseSupplierStake, nil,
+		func(_ *rand.Rand) {
+			weightMsgDecreaseSupplierStake = defaultWeightMsgDecreaseSupplierStake
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgDecreaseSupplierStake,
+		suppliersimulation.SimulateMsgDecreaseSupplierStake(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgUpdateSupplierOperator int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgUpdateSupplierOperator, &weightMsgUpdateSupplierOperator, nil,
+		func(_ *rand.Rand) {
+			weightMsgUpdateSupplierOperator = defaultWeightMsgUpdateSupplierOperator
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgUpdateSupplierOperator,
+		suppliersimulation.SimulateMsgUpdateSupplierOperator(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
 	// this line is used by starport scaffolding # simapp/module/operation
 
 	return operations
@@ -140,7 +185,7 @@ func (am AppModule) ProposalMsgs(simState module.SimulationState) []simtypes.Wei
 			opWeightMsgCreateClaim,
 			defaultWeightMsgCreateClaim,
 			func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
-				suppliersimulation.SimulateMsgCreateClaim(am.accountKeeper, am.bankKeeper, am.keeper)
+				suppliersimulation.SimulateMsgCreateClaim(am.accountKeeper, am.bankKeeper, am.keeper, am.serviceKeeper, am.sessionKeeper)
 				return nil
 			},
 		),
@@ -152,6 +197,30 @@ func (am AppModule) ProposalMsgs(simState module.SimulationState) []simtypes.Wei
 				return nil
 			},
 		),
+		simulation.NewWeightedProposalMsg(
+			opWeightMsgUpdateSupplierServices,
+			defaultWeightMsgUpdateSupplierServices,
+			func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
+				suppliersimulation.SimulateMsgUpdateSupplierServices(am.accountKeeper, am.bankKeeper, am.keeper)
+				return nil
+			},
+		),
+		simulation.NewWeightedProposalMsg(
+			opWeightMsgDecreaseSupplierStake,
+			defaultWeightMsgDecreaseSupplierStake,
+			func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
+				suppliersimulation.SimulateMsgDecreaseSupplierStake(am.accountKeeper, am.bankKeeper, am.keeper)
+				return nil
+			},
+		),
+		simulation.NewWeightedProposalMsg(
+			opWeightMsgUpdateSupplierOperator,
+			defaultWeightMsgUpdateSupplierOperator,
+			func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
+				suppliersimulation.SimulateMsgUpdateSupplierOperator(am.accountKeeper, am.bankKeeper, am.keeper)
+				return nil
+			},
+		),
 		// this line is used by starport scaffolding # simapp/module/OpMsg
 	}
 }
@@ -5,26 +5,172 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
 
+	sessiontypes "github.com/pokt-network/poktroll/x/session/types"
+	sharedhelpers "github.com/pokt-network/poktroll/x/shared/helpers"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
 	"github.com/pokt-network/poktroll/x/supplier/keeper"
 	"github.com/pokt-network/poktroll/x/supplier/types"
 )
 
+// SimulateMsgCreateClaim claims the relays which a random staked supplier mined
+// for one of its services in the latest session which ended, and which it is
+// part of. The matching proof is submitted as a future operation in the next block.
 func SimulateMsgCreateClaim(
 	ak types.AccountKeeper,
 	bk types.BankKeeper,
 	k keeper.Keeper,
+	sk types.ServiceKeeper,
+	sesk types.SessionKeeper,
 ) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		simAccount, _ := simtypes.RandomAcc(r, accs)
-		msg := &types.MsgCreateClaim{
-			SupplierAddress: simAccount.Address.String(),
+		sessionStartHeight := sharedhelpers.GetSessionStartBlockHeight(ctx.BlockHeight()) - sharedhelpers.NumBlocksPerSession
+		if sessionStartHeight < 1 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreateClaim, "no session has ended yet"), nil, nil
 		}
 
-		// TODO: Handling the CreateClaim simulation
+		suppliers := k.GetAllSupplier(ctx)
+		if len(suppliers) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreateClaim, "no staked suppliers"), nil, nil
+		}
+		supplier := suppliers[r.Intn(len(suppliers))]
+
+		simSupplierAccount, found := FindAccount(accs, supplier.OperatorAddress)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreateClaim, "supplier operator account not found"), nil, nil
+		}
+
+		service := supplier.Services[r.Intn(len(supplier.Services))].Service
+		session, found := findSupplierSession(ctx, r, sesk, accs, supplier.OperatorAddress, service, sessionStartHeight)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreateClaim, "no session of the supplier found"), nil, nil
+		}
+		sessionHeader := session.GetHeader()
+		if _, isClaimFound := k.GetClaim(ctx, sessionHeader.SessionId, supplier.OperatorAddress); isClaimFound {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreateClaim, "session already claimed"), nil, nil
+		}
+
+		maxComputeUnits, err := sharedhelpers.MaxSessionComputeUnits(
+			session.GetApplication().GetStake(),
+			len(session.GetSuppliers()),
+		)
+		if err != nil || maxComputeUnits == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreateClaim, "application cannot be billed for any relays"), nil, nil
+		}
+
+		difficultyBits, err := sk.SessionRelayDifficultyBits(ctx, service.Id, sessionHeader.SessionStartBlockHeight)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreateClaim, "relay difficulty of session not recorded"), nil, nil
+		}
+		if difficultyBits > maxRelayDifficultyBits {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreateClaim, "relay difficulty too high to mine relays"), nil, nil
+		}
+
+		rootHash, proofBz, err := mineSessionTree(r, supplier.OperatorAddress, sessionHeader, difficultyBits, maxComputeUnits)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreateClaim, "unable to mine session tree"), nil, err
+		}
+
+		msg := types.NewMsgCreateClaim(supplier.OperatorAddress, sessionHeader, rootHash)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           moduletestutil.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         types.TypeMsgCreateClaim,
+			Context:         ctx,
+			SimAccount:      simSupplierAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+
+		opMsg, _, err := simulation.GenAndDeliverTxWithRandFees(txCtx)
+		if err != nil || !opMsg.OK {
+			return opMsg, nil, err
+		}
+
+		submitProofOp := simtypes.FutureOperation{
+			BlockHeight: int(ctx.BlockHeight()) + 1,
+			Op: simulateMsgSubmitMatchingProof(
+				ak, bk,
+				simSupplierAccount,
+				sessionHeader,
+				proofBz,
+			),
+		}
 
-		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "CreateClaim simulation not implemented"), nil, nil
+		return opMsg, []simtypes.FutureOperation{submitProofOp}, nil
 	}
 }
+
+// simulateMsgSubmitMatchingProof submits the given proof of a claimed session,
+// which is expected to be accepted.
+func simulateMsgSubmitMatchingProof(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	simSupplierAccount simtypes.Account,
+	sessionHeader *sessiontypes.SessionHeader,
+	proofBz []byte,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := types.NewMsgSubmitProof(simSupplierAccount.Address.String(), sessionHeader, proofBz)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           moduletestutil.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         types.TypeMsgSubmitProof,
+			Context:         ctx,
+			SimAccount:      simSupplierAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// findSupplierSession returns the on-chain session of a random account, for the
+// given service and start height, which the supplier with the given operator
+// address is part of. It returns false if no such session exists.
+func findSupplierSession(
+	ctx sdk.Context,
+	r *rand.Rand,
+	sesk types.SessionKeeper,
+	accs []simtypes.Account,
+	operatorAddress string,
+	service *sharedtypes.Service,
+	sessionStartHeight int64,
+) (*sessiontypes.Session, bool) {
+	for _, i := range r.Perm(len(accs)) {
+		sessionRes, err := sesk.GetSession(sdk.WrapSDKContext(ctx), &sessiontypes.QueryGetSessionRequest{
+			ApplicationAddress: accs[i].Address.String(),
+			Service:            service,
+			BlockHeight:        sessionStartHeight,
+		})
+		if err != nil {
+			continue
+		}
+
+		for _, supplier := range sessionRes.GetSession().GetSuppliers() {
+			if supplier.GetOperatorAddress() == operatorAddress {
+				return sessionRes.GetSession(), true
+			}
+		}
+	}
+
+	return nil, false
+}
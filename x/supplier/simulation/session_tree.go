@@ -0,0 +1,88 @@
+package simulation
+
+import (
+	"crypto/sha256"
+	"math/rand"
+
+	"github.com/pokt-network/smt"
+
+	servicetypes "github.com/pokt-network/poktroll/x/service/types"
+	sessiontypes "github.com/pokt-network/poktroll/x/session/types"
+)
+
+const (
+	// maxRelayDifficultyBits is the highest relay mining difficulty which relays
+	// are mined at in simulations, beyond which mining them would slow them down.
+	maxRelayDifficultyBits = 16
+
+	// maxNumMinedRelays is the highest number of relays which are mined in each
+	// simulated session.
+	maxNumMinedRelays = 10
+)
+
+// mineSessionTree builds the session SMST of a random number of relays, no more
+// than maxComputeUnits, which are mined at the given difficulty and serviced by
+// the given supplier in the given session. It returns the root of the tree and the marshaled closest
+// proof of a random path.
+func mineSessionTree(
+	r *rand.Rand,
+	supplierAddress string,
+	sessionHeader *sessiontypes.SessionHeader,
+	difficultyBits uint64,
+	maxComputeUnits uint64,
+) (rootHash, proofBz []byte, err error) {
+	treeStore, err := smt.NewKVStore("")
+	if err != nil {
+		return nil, nil, err
+	}
+	defer treeStore.Stop()
+
+	tree := servicetypes.NewRelaySMST(treeStore)
+	numMinedRelays := 1 + r.Intn(maxNumMinedRelays)
+	if uint64(numMinedRelays) > maxComputeUnits {
+		numMinedRelays = int(maxComputeUnits)
+	}
+	for numMinedRelays > 0 {
+		payload := make([]byte, 32)
+		r.Read(payload)
+
+		relay := &servicetypes.Relay{
+			Req: &servicetypes.RelayRequest{
+				Meta: &servicetypes.RelayRequestMetadata{
+					SessionHeader:   sessionHeader,
+					SupplierAddress: supplierAddress,
+				},
+				Payload: payload,
+			},
+			Res: &servicetypes.RelayResponse{},
+		}
+		relayBz, err := relay.GetCanonicalBytes()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		key, value, weight := servicetypes.RelaySMSTLeaf(relayBz)
+		if !servicetypes.IsRelayMined(key, difficultyBits) {
+			continue
+		}
+		if err := tree.Update(key, value, weight); err != nil {
+			return nil, nil, err
+		}
+		numMinedRelays--
+	}
+	if err := tree.Commit(); err != nil {
+		return nil, nil, err
+	}
+
+	path := make([]byte, sha256.Size)
+	r.Read(path)
+	proof, err := tree.ProveClosest(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	if proofBz, err = proof.Marshal(); err != nil {
+		return nil, nil, err
+	}
+
+	return tree.Root(), proofBz, nil
+}
@@ -1,16 +1,25 @@
 package simulation
 
 import (
+	"fmt"
 	"math/rand"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
 
+	sharedsimulation "github.com/pokt-network/poktroll/x/shared/simulation"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
 	"github.com/pokt-network/poktroll/x/supplier/keeper"
 	"github.com/pokt-network/poktroll/x/supplier/types"
 )
 
+// SimulateMsgStakeSupplier stakes a random account as a supplier, which it is
+// both the owner and the operator of, for a random set of the simulation
+// services. It increases its stake and replaces its services if it is already
+// staked.
 func SimulateMsgStakeSupplier(
 	ak types.AccountKeeper,
 	bk types.BankKeeper,
@@ -19,13 +28,56 @@ func SimulateMsgStakeSupplier(
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
 		simAccount, _ := simtypes.RandomAcc(r, accs)
-		stakeMsg := &types.MsgStakeSupplier{
-			Address: simAccount.Address.String(),
-			// TODO: Update all stake message fields
+		ownerAddress := simAccount.Address.String()
+
+		var currStake *sdk.Coin
+		if supplier, isSupplierFound := k.GetSupplier(ctx, ownerAddress); isSupplierFound {
+			if supplier.OwnerAddress != ownerAddress {
+				return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgStakeSupplier, "account operates a supplier which it doesn't own"), nil, nil
+			}
+			currStake = supplier.Stake
+		}
+
+		spendable := bk.SpendableCoins(ctx, simAccount.Address)
+		stake, ok := sharedsimulation.RandomStake(r, spendable, currStake, k.GetParams(ctx).MinStake)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgStakeSupplier, "insufficient funds to stake supplier"), nil, nil
+		}
+
+		var serviceConfigs []*sharedtypes.SupplierServiceConfig
+		for _, i := range r.Perm(len(sharedsimulation.Services))[:1+r.Intn(len(sharedsimulation.Services))] {
+			serviceConfigs = append(serviceConfigs, &sharedtypes.SupplierServiceConfig{
+				Service: sharedsimulation.Services[i],
+				Endpoints: []*sharedtypes.SupplierEndpoint{
+					{
+						Url:     fmt.Sprintf("http://%s.localhost:8545", sharedsimulation.Services[i].Id),
+						RpcType: sharedtypes.RPCType_JSON_RPC,
+					},
+				},
+			})
+		}
+
+		stakeMsg := types.NewMsgStakeSupplier(ownerAddress, "", stake, serviceConfigs)
+		coinsToDelegate := sdk.NewCoins(stake)
+		if currStake != nil {
+			coinsToDelegate = coinsToDelegate.Sub(*currStake)
 		}
 
-		// TODO: Handling the StakeSupplier simulation
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           moduletestutil.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             stakeMsg,
+			MsgType:         types.TypeMsgStakeSupplier,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: coinsToDelegate,
+		}
 
-		return simtypes.NoOpMsg(types.ModuleName, stakeMsg.Type(), "StakeSupplier simulation not implemented"), nil, nil
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
 	}
 }
@@ -5,12 +5,22 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
 
+	sessiontypes "github.com/pokt-network/poktroll/x/session/types"
+	sharedhelpers "github.com/pokt-network/poktroll/x/shared/helpers"
+	sharedsimulation "github.com/pokt-network/poktroll/x/shared/simulation"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
 	"github.com/pokt-network/poktroll/x/supplier/keeper"
 	"github.com/pokt-network/poktroll/x/supplier/types"
 )
 
+// SimulateMsgSubmitProof submits a proof of a random claim which doesn't match
+// it; i.e. it proves a session tree other than the claimed one, which is
+// expected to be rejected. Matching proofs are submitted by the future
+// operations of SimulateMsgCreateClaim.
 func SimulateMsgSubmitProof(
 	ak types.AccountKeeper,
 	bk types.BankKeeper,
@@ -18,13 +28,53 @@ func SimulateMsgSubmitProof(
 ) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		simAccount, _ := simtypes.RandomAcc(r, accs)
-		msg := &types.MsgSubmitProof{
-			SupplierAddress: simAccount.Address.String(),
+		claims := k.GetAllClaims(ctx)
+		if len(claims) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSubmitProof, "no claims to prove"), nil, nil
 		}
+		claim := claims[r.Intn(len(claims))]
 
-		// TODO: Handling the SubmitProof simulation
+		simSupplierAccount, found := FindAccount(accs, claim.SupplierAddress)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSubmitProof, "supplier account not found"), nil, nil
+		}
+
+		if claim.RelayDifficultyBits > maxRelayDifficultyBits {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSubmitProof, "relay difficulty too high to mine relays"), nil, nil
+		}
+
+		sessionHeader := &sessiontypes.SessionHeader{
+			ApplicationAddress:      claim.ApplicationAddress,
+			Service:                 &sharedtypes.Service{Id: claim.ServiceId},
+			SessionId:               claim.SessionId,
+			SessionStartBlockHeight: int64(claim.SessionEndBlockHeight) - sharedhelpers.NumBlocksPerSession,
+			SessionEndBlockHeight:   int64(claim.SessionEndBlockHeight),
+		}
+
+		// Mine a session tree of other relays than the claimed ones, such that the
+		// proof doesn't match the claimed root.
+		_, proofBz, err := mineSessionTree(r, claim.SupplierAddress, sessionHeader, claim.RelayDifficultyBits, maxNumMinedRelays)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSubmitProof, "unable to mine session tree"), nil, err
+		}
+
+		msg := types.NewMsgSubmitProof(claim.SupplierAddress, sessionHeader, proofBz)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           moduletestutil.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         types.TypeMsgSubmitProof,
+			Context:         ctx,
+			SimAccount:      simSupplierAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
 
-		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "SubmitProof simulation not implemented"), nil, nil
+		return sharedsimulation.GenAndDeliverTxWithExpectedError(txCtx, types.ErrSupplierInvalidProof)
 	}
 }
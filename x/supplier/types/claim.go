@@ -0,0 +1,69 @@
+package types
+
+import (
+	"math/big"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	servicetypes "github.com/pokt-network/poktroll/x/service/types"
+	"github.com/pokt-network/poktroll/x/shared/helpers"
+)
+
+// NumMinedRelaysFromRootHash returns the number of mined relays in the session
+// SMST with the given root hash (see: servicetypes.RelaySMSTRootSum).
+func NumMinedRelaysFromRootHash(rootHash []byte) (uint64, error) {
+	numMinedRelays, err := servicetypes.RelaySMSTRootSum(rootHash)
+	if err != nil {
+		return 0, sdkerrors.Wrapf(ErrSupplierInvalidClaimRootHash, "%s", err)
+	}
+	return numMinedRelays, nil
+}
+
+// EstimatedNumRelays returns the estimated number of relays which the supplier
+// serviced in the claim's session, given the number of them which it mined and
+// the relay mining difficulty in effect for the session. Claims are settled by
+// it rather than by the number of mined relays (see: Keeper#settleClaim).
+func (claim *Claim) EstimatedNumRelays() *big.Int {
+	return helpers.EstimatedNumRelays(claim.GetNumMinedRelays(), claim.GetRelayDifficultyBits())
+}
+
+// validate performs basic validation of a claim, as stored on-chain, such as
+// the claims imported from genesis.
+func (claim *Claim) validate() error {
+	if _, err := sdk.AccAddressFromBech32(claim.SupplierAddress); err != nil {
+		return sdkerrors.Wrapf(ErrSupplierInvalidAddress, "invalid claim supplier address %s; (%v)", claim.SupplierAddress, err)
+	}
+	if len(claim.SessionId) == 0 {
+		return sdkerrors.Wrapf(ErrSupplierInvalidSessionId, "empty session ID in claim for supplier %s", claim.SupplierAddress)
+	}
+	if claim.SessionEndBlockHeight == 0 {
+		return sdkerrors.Wrapf(ErrSupplierInvalidSessionEndHeight, "zero session end height in claim for session %s", claim.SessionId)
+	}
+	if !helpers.IsValidServiceId(claim.ServiceId) {
+		return sdkerrors.Wrapf(ErrSupplierInvalidService, "invalid service ID %q in claim for session %s", claim.ServiceId, claim.SessionId)
+	}
+
+	// The claim records what it's settled by, as the supplier may no longer be
+	// part of its session by then.
+	if _, err := sdk.AccAddressFromBech32(claim.ApplicationAddress); err != nil {
+		return sdkerrors.Wrapf(ErrSupplierInvalidApplication, "invalid application address %s in claim for session %s; (%v)", claim.ApplicationAddress, claim.SessionId, err)
+	}
+	if claim.NumSessionSuppliers == 0 {
+		return sdkerrors.Wrapf(ErrSupplierNotFound, "zero session suppliers in claim for session %s", claim.SessionId)
+	}
+	if _, err := sdk.AccAddressFromBech32(claim.SupplierOwnerAddress); err != nil {
+		return sdkerrors.Wrapf(ErrSupplierInvalidAddress, "invalid supplier owner address %s in claim for session %s; (%v)", claim.SupplierOwnerAddress, claim.SessionId, err)
+	}
+
+	// The number of mined relays is derived from the root hash when the claim
+	// is created, so both must agree.
+	numMinedRelays, err := NumMinedRelaysFromRootHash(claim.RootHash)
+	if err != nil {
+		return err
+	}
+	if numMinedRelays != claim.NumMinedRelays {
+		return sdkerrors.Wrapf(ErrSupplierInvalidClaimRootHash, "claim for session %s has %d mined relays but its root hash has %d", claim.SessionId, claim.NumMinedRelays, numMinedRelays)
+	}
+	return nil
+}
@@ -12,6 +12,9 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUnstakeSupplier{}, "supplier/UnstakeSupplier", nil)
 	cdc.RegisterConcrete(&MsgCreateClaim{}, "supplier/CreateClaim", nil)
 	cdc.RegisterConcrete(&MsgSubmitProof{}, "supplier/SubmitProof", nil)
+	cdc.RegisterConcrete(&MsgUpdateSupplierServices{}, "supplier/UpdateSupplierServices", nil)
+	cdc.RegisterConcrete(&MsgDecreaseSupplierStake{}, "supplier/DecreaseSupplierStake", nil)
+	cdc.RegisterConcrete(&MsgUpdateSupplierOperator{}, "supplier/UpdateSupplierOperator", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -26,6 +29,15 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSubmitProof{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgUpdateSupplierServices{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgDecreaseSupplierStake{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgUpdateSupplierOperator{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
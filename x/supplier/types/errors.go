@@ -18,4 +18,11 @@ var (
 	ErrSupplierInvalidService            = sdkerrors.Register(ModuleName, 8, "invalid service in supplier")
 	ErrSupplierInvalidClaimRootHash      = sdkerrors.Register(ModuleName, 9, "invalid root hash")
 	ErrSupplierInvalidSessionEndHeight   = sdkerrors.Register(ModuleName, 10, "invalid session ending height")
+	ErrSupplierInvalidProof              = sdkerrors.Register(ModuleName, 11, "invalid proof")
+	ErrSupplierClaimNotFound             = sdkerrors.Register(ModuleName, 12, "claim not found")
+	ErrSupplierInvalidServiceUpdate      = sdkerrors.Register(ModuleName, 13, "invalid supplier service update")
+	ErrSupplierInvalidMinStake           = sdkerrors.Register(ModuleName, 14, "invalid MinStake parameter")
+	ErrSupplierOperatorInUse             = sdkerrors.Register(ModuleName, 15, "supplier operator address already in use")
+	ErrSupplierRelayQuotaExceeded        = sdkerrors.Register(ModuleName, 16, "unable to determine the application's relay quota")
+	ErrSupplierInvalidApplication        = sdkerrors.Register(ModuleName, 17, "invalid session application")
 )
@@ -1,10 +1,15 @@
 package types
 
-//go:generate mockgen -destination ../../../testutil/supplier/mocks/expected_keepers_mock.go -package mocks . AccountKeeper,BankKeeper
+//go:generate mockgen -destination ../../../testutil/supplier/mocks/expected_keepers_mock.go -package mocks . AccountKeeper,BankKeeper,ServiceKeeper,SessionKeeper,ApplicationKeeper
 
 import (
+	"context"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	apptypes "github.com/pokt-network/poktroll/x/application/types"
+	sessiontypes "github.com/pokt-network/poktroll/x/session/types"
 )
 
 // AccountKeeper defines the expected account keeper used for simulations (noalias)
@@ -17,4 +22,28 @@ type AccountKeeper interface {
 type BankKeeper interface {
 	DelegateCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	UndelegateCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// ServiceKeeper defines the expected interface needed to retrieve and retarget
+// the relay mining difficulties of services.
+type ServiceKeeper interface {
+	SessionRelayDifficultyBits(ctx sdk.Context, serviceId string, sessionStartHeight int64) (uint64, error)
+	AddProvenMinedRelays(ctx sdk.Context, serviceId string, numMinedRelays uint64)
+}
+
+// ApplicationKeeper defines the expected interface needed to bill applications
+// for the relays which claims are settled for.
+type ApplicationKeeper interface {
+	GetApplication(ctx sdk.Context, appAddr string) (app apptypes.Application, found bool)
+	SetApplication(ctx sdk.Context, app apptypes.Application)
+	RemoveApplication(ctx sdk.Context, appAddr string)
+}
+
+// SessionKeeper defines the expected interface needed to retrieve the on-chain
+// sessions which claims are validated against.
+type SessionKeeper interface {
+	GetSession(goCtx context.Context, req *sessiontypes.QueryGetSessionRequest) (*sessiontypes.QueryGetSessionResponse, error)
 }
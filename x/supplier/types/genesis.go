@@ -17,6 +17,8 @@ const DefaultIndex uint64 = 1
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		SupplierList: []sharedtypes.Supplier{},
+		ClaimList:    []Claim{},
+		ProofList:    []Proof{},
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -28,7 +30,7 @@ func (gs GenesisState) Validate() error {
 	// Check for duplicated index in supplier
 	supplierIndexMap := make(map[string]struct{})
 	for _, supplier := range gs.SupplierList {
-		index := string(SupplierKey(supplier.Address))
+		index := string(SupplierKey(supplier.OperatorAddress))
 		if _, ok := supplierIndexMap[index]; ok {
 			return fmt.Errorf("duplicated index for supplier")
 		}
@@ -37,6 +39,14 @@ func (gs GenesisState) Validate() error {
 
 	// Check that the stake value for the suppliers is valid
 	for _, supplier := range gs.SupplierList {
+		// Validate the owner and operator addresses
+		if _, err := sdk.AccAddressFromBech32(supplier.OwnerAddress); err != nil {
+			return sdkerrors.Wrapf(ErrSupplierInvalidAddress, "invalid supplier owner address %s; (%v)", supplier.OwnerAddress, err)
+		}
+		if _, err := sdk.AccAddressFromBech32(supplier.OperatorAddress); err != nil {
+			return sdkerrors.Wrapf(ErrSupplierInvalidAddress, "invalid supplier operator address %s; (%v)", supplier.OperatorAddress, err)
+		}
+
 		// TODO_TECHDEBT: Consider creating shared helpers across the board for stake validation,
 		// similar to how we have `ValidateAppServiceConfigs` below
 		if supplier.Stake == nil {
@@ -60,6 +70,65 @@ func (gs GenesisState) Validate() error {
 		if err := servicehelpers.ValidateSupplierServiceConfigs(supplier.Services); err != nil {
 			return sdkerrors.Wrapf(ErrSupplierInvalidServiceConfig, err.Error())
 		}
+
+		// Validate the pending service configs, if any
+		if supplier.PendingServiceConfigUpdate != nil {
+			if err := servicehelpers.ValidateSupplierServiceConfigs(supplier.PendingServiceConfigUpdate.Services); err != nil {
+				return sdkerrors.Wrapf(ErrSupplierInvalidServiceConfig, "pending service configs: %s", err)
+			}
+		}
+	}
+
+	// Check that the pending operators, if any, are valid and not used by any other supplier
+	pendingOperatorIndexMap := make(map[string]struct{})
+	for _, supplier := range gs.SupplierList {
+		if supplier.PendingOperatorUpdate == nil {
+			continue
+		}
+
+		newOperatorAddress := supplier.PendingOperatorUpdate.NewOperatorAddress
+		if _, err := sdk.AccAddressFromBech32(newOperatorAddress); err != nil {
+			return sdkerrors.Wrapf(ErrSupplierInvalidAddress, "invalid supplier pending operator address %s; (%v)", newOperatorAddress, err)
+		}
+
+		index := string(SupplierKey(newOperatorAddress))
+		_, isOperatorInUse := supplierIndexMap[index]
+		_, isPendingOperatorInUse := pendingOperatorIndexMap[index]
+		if isOperatorInUse || isPendingOperatorInUse {
+			return sdkerrors.Wrapf(ErrSupplierOperatorInUse, "pending operator address %s is already used by a supplier", newOperatorAddress)
+		}
+		pendingOperatorIndexMap[index] = struct{}{}
+	}
+
+	// Check that the claims are valid and unique. They may be made by suppliers
+	// which are no longer staked, or are operated by another address, since they
+	// remain pending until they're proven.
+	claimIndexMap := make(map[string]struct{})
+	for _, claim := range gs.ClaimList {
+		if err := claim.validate(); err != nil {
+			return err
+		}
+
+		index := string(ClaimPrimaryKey(claim.SessionId, claim.SupplierAddress))
+		if _, ok := claimIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for claim")
+		}
+		claimIndexMap[index] = struct{}{}
+	}
+
+	// Check that the proofs are valid and unique. Like claims, they may be made
+	// by suppliers which are no longer staked, since they are kept once settled.
+	proofIndexMap := make(map[string]struct{})
+	for _, proof := range gs.ProofList {
+		if err := proof.validate(); err != nil {
+			return err
+		}
+
+		index := string(ProofPrimaryKey(proof.GetSessionHeader().GetSessionId(), proof.SupplierAddress))
+		if _, ok := proofIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for proof")
+		}
+		proofIndexMap[index] = struct{}{}
 	}
 
 	// this line is used by starport scaffolding # genesis/types/validate
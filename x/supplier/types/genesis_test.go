@@ -1,12 +1,14 @@
 package types_test
 
 import (
+	"encoding/binary"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/pokt-network/poktroll/testutil/sample"
+	sessiontypes "github.com/pokt-network/poktroll/x/session/types"
 	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
 	"github.com/pokt-network/poktroll/x/supplier/types"
 )
@@ -44,6 +46,78 @@ func TestGenesisState_Validate(t *testing.T) {
 	}
 	serviceList2 := []*sharedtypes.SupplierServiceConfig{serviceConfig2}
 
+	// The relay SMST root is a hash followed by the sum of the tree's leaves.
+	rootHash := make([]byte, 40)
+	binary.BigEndian.PutUint64(rootHash[32:], 10)
+	claim1 := types.Claim{
+		SupplierAddress:       addr1,
+		SessionId:             "session_id_1",
+		SessionEndBlockHeight: 8,
+		RootHash:              rootHash,
+		ServiceId:             "svcId1",
+		NumMinedRelays:        10,
+		ApplicationAddress:    sample.AccAddress(),
+		NumSessionSuppliers:   1,
+		SupplierOwnerAddress:  addr1,
+	}
+	claim2 := claim1
+	claim2.SessionId = "session_id_2"
+
+	// genesisWithClaims returns a valid genesis state with the given claims
+	genesisWithClaims := func(claims ...types.Claim) *types.GenesisState {
+		return &types.GenesisState{
+			SupplierList: []sharedtypes.Supplier{
+				{
+					OwnerAddress:    addr1,
+					OperatorAddress: addr1,
+					Stake:           &stake1,
+					Services:        serviceList1,
+				},
+			},
+			ClaimList: claims,
+		}
+	}
+
+	proof1 := types.Proof{
+		SupplierAddress:    addr1,
+		SessionHeader:      &sessiontypes.SessionHeader{SessionId: "session_id_1"},
+		ClosestMerkleProof: []byte("closest_merkle_proof"),
+	}
+	proof2 := proof1
+	proof2.SessionHeader = &sessiontypes.SessionHeader{SessionId: "session_id_2"}
+
+	// genesisWithProofs returns a valid genesis state with the given proofs
+	genesisWithProofs := func(proofs ...types.Proof) *types.GenesisState {
+		genState := genesisWithClaims()
+		genState.ProofList = proofs
+		return genState
+	}
+
+	// genesisWithPendingOperator returns a genesis state with two suppliers, the
+	// first of which is about to be operated by the given address
+	genesisWithPendingOperator := func(newOperatorAddress string) *types.GenesisState {
+		return &types.GenesisState{
+			SupplierList: []sharedtypes.Supplier{
+				{
+					OwnerAddress:    addr1,
+					OperatorAddress: addr1,
+					Stake:           &stake1,
+					Services:        serviceList1,
+					PendingOperatorUpdate: &sharedtypes.SupplierOperatorUpdate{
+						NewOperatorAddress:     newOperatorAddress,
+						EffectiveSessionNumber: 2,
+					},
+				},
+				{
+					OwnerAddress:    addr2,
+					OperatorAddress: addr2,
+					Stake:           &stake2,
+					Services:        serviceList2,
+				},
+			},
+		}
+	}
+
 	tests := []struct {
 		desc     string
 		genState *types.GenesisState
@@ -60,33 +134,111 @@ func TestGenesisState_Validate(t *testing.T) {
 
 				SupplierList: []sharedtypes.Supplier{
 					{
-						Address:  addr1,
-						Stake:    &stake1,
-						Services: serviceList1,
+						OwnerAddress:    addr1,
+						OperatorAddress: addr1,
+						Stake:           &stake1,
+						Services:        serviceList1,
 					},
 					{
-						Address:  addr2,
-						Stake:    &stake2,
-						Services: serviceList2,
+						OwnerAddress:    addr2,
+						OperatorAddress: addr2,
+						Stake:           &stake2,
+						Services:        serviceList2,
 					},
 				},
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
 			valid: true,
 		},
+		{
+			desc:     "valid genesis state with claims",
+			genState: genesisWithClaims(claim1, claim2),
+			valid:    true,
+		},
+		{
+			desc:     "invalid - due to duplicated claim",
+			genState: genesisWithClaims(claim1, claim1),
+			valid:    false,
+		},
+		{
+			desc:     "valid genesis state with proofs",
+			genState: genesisWithProofs(proof1, proof2),
+			valid:    true,
+		},
+		{
+			desc: "valid genesis state with a proof of a supplier not in genesis",
+			genState: genesisWithProofs(func() types.Proof {
+				proof := proof1
+				proof.SupplierAddress = addr2
+				return proof
+			}()),
+			valid: true,
+		},
+		{
+			desc:     "invalid - due to duplicated proof",
+			genState: genesisWithProofs(proof1, proof1),
+			valid:    false,
+		},
+		{
+			desc: "invalid - empty proof",
+			genState: genesisWithProofs(func() types.Proof {
+				proof := proof1
+				proof.ClosestMerkleProof = nil
+				return proof
+			}()),
+			valid: false,
+		},
+		{
+			desc: "valid - claim of a supplier which has since unstaked",
+			genState: genesisWithClaims(func() types.Claim {
+				claim := claim1
+				claim.SupplierAddress = addr2
+				return claim
+			}()),
+			valid: true,
+		},
+		{
+			desc: "invalid - claim without a supplier owner",
+			genState: genesisWithClaims(func() types.Claim {
+				claim := claim1
+				claim.SupplierOwnerAddress = ""
+				return claim
+			}()),
+			valid: false,
+		},
+		{
+			desc: "invalid - claim with an empty session id",
+			genState: genesisWithClaims(func() types.Claim {
+				claim := claim1
+				claim.SessionId = ""
+				return claim
+			}()),
+			valid: false,
+		},
+		{
+			desc: "invalid - claim with a number of mined relays which doesn't match its root hash",
+			genState: genesisWithClaims(func() types.Claim {
+				claim := claim1
+				claim.NumMinedRelays = 11
+				return claim
+			}()),
+			valid: false,
+		},
 		{
 			desc: "invalid - zero supplier stake",
 			genState: &types.GenesisState{
 				SupplierList: []sharedtypes.Supplier{
 					{
-						Address:  addr1,
-						Stake:    &stake1,
-						Services: serviceList1,
+						OwnerAddress:    addr1,
+						OperatorAddress: addr1,
+						Stake:           &stake1,
+						Services:        serviceList1,
 					},
 					{
-						Address:  addr2,
-						Stake:    &sdk.Coin{Denom: "upokt", Amount: sdk.NewInt(0)},
-						Services: serviceList2,
+						OwnerAddress:    addr2,
+						OperatorAddress: addr2,
+						Stake:           &sdk.Coin{Denom: "upokt", Amount: sdk.NewInt(0)},
+						Services:        serviceList2,
 					},
 				},
 			},
@@ -97,14 +249,16 @@ func TestGenesisState_Validate(t *testing.T) {
 			genState: &types.GenesisState{
 				SupplierList: []sharedtypes.Supplier{
 					{
-						Address:  addr1,
-						Stake:    &stake1,
-						Services: serviceList1,
+						OwnerAddress:    addr1,
+						OperatorAddress: addr1,
+						Stake:           &stake1,
+						Services:        serviceList1,
 					},
 					{
-						Address:  addr2,
-						Stake:    &sdk.Coin{Denom: "upokt", Amount: sdk.NewInt(-100)},
-						Services: serviceList2,
+						OwnerAddress:    addr2,
+						OperatorAddress: addr2,
+						Stake:           &sdk.Coin{Denom: "upokt", Amount: sdk.NewInt(-100)},
+						Services:        serviceList2,
 					},
 				},
 			},
@@ -115,14 +269,16 @@ func TestGenesisState_Validate(t *testing.T) {
 			genState: &types.GenesisState{
 				SupplierList: []sharedtypes.Supplier{
 					{
-						Address:  addr1,
-						Stake:    &stake1,
-						Services: serviceList1,
+						OwnerAddress:    addr1,
+						OperatorAddress: addr1,
+						Stake:           &stake1,
+						Services:        serviceList1,
 					},
 					{
-						Address:  addr2,
-						Stake:    &sdk.Coin{Denom: "invalid", Amount: sdk.NewInt(100)},
-						Services: serviceList2,
+						OwnerAddress:    addr2,
+						OperatorAddress: addr2,
+						Stake:           &sdk.Coin{Denom: "invalid", Amount: sdk.NewInt(100)},
+						Services:        serviceList2,
 					},
 				},
 			},
@@ -133,14 +289,16 @@ func TestGenesisState_Validate(t *testing.T) {
 			genState: &types.GenesisState{
 				SupplierList: []sharedtypes.Supplier{
 					{
-						Address:  addr1,
-						Stake:    &stake1,
-						Services: serviceList1,
+						OwnerAddress:    addr1,
+						OperatorAddress: addr1,
+						Stake:           &stake1,
+						Services:        serviceList1,
 					},
 					{
-						Address:  addr2,
-						Stake:    &sdk.Coin{Denom: "", Amount: sdk.NewInt(100)},
-						Services: serviceList2,
+						OwnerAddress:    addr2,
+						OperatorAddress: addr2,
+						Stake:           &sdk.Coin{Denom: "", Amount: sdk.NewInt(100)},
+						Services:        serviceList2,
 					},
 				},
 			},
@@ -151,14 +309,16 @@ func TestGenesisState_Validate(t *testing.T) {
 			genState: &types.GenesisState{
 				SupplierList: []sharedtypes.Supplier{
 					{
-						Address:  addr1,
-						Stake:    &stake1,
-						Services: serviceList1,
+						OwnerAddress:    addr1,
+						OperatorAddress: addr1,
+						Stake:           &stake1,
+						Services:        serviceList1,
 					},
 					{
-						Address:  addr1,
-						Stake:    &stake2,
-						Services: serviceList2,
+						OwnerAddress:    addr1,
+						OperatorAddress: addr1,
+						Stake:           &stake2,
+						Services:        serviceList2,
 					},
 				},
 			},
@@ -169,14 +329,16 @@ func TestGenesisState_Validate(t *testing.T) {
 			genState: &types.GenesisState{
 				SupplierList: []sharedtypes.Supplier{
 					{
-						Address:  addr1,
-						Stake:    &stake1,
-						Services: serviceList1,
+						OwnerAddress:    addr1,
+						OperatorAddress: addr1,
+						Stake:           &stake1,
+						Services:        serviceList1,
 					},
 					{
-						Address:  addr2,
-						Stake:    nil,
-						Services: serviceList2,
+						OwnerAddress:    addr2,
+						OperatorAddress: addr2,
+						Stake:           nil,
+						Services:        serviceList2,
 					},
 				},
 			},
@@ -187,12 +349,14 @@ func TestGenesisState_Validate(t *testing.T) {
 			genState: &types.GenesisState{
 				SupplierList: []sharedtypes.Supplier{
 					{
-						Address:  addr1,
-						Stake:    &stake1,
-						Services: serviceList1,
+						OwnerAddress:    addr1,
+						OperatorAddress: addr1,
+						Stake:           &stake1,
+						Services:        serviceList1,
 					},
 					{
-						Address: addr2,
+						OwnerAddress:    addr2,
+						OperatorAddress: addr2,
 						// Explicitly missing stake
 						Services: serviceList2,
 					},
@@ -205,13 +369,15 @@ func TestGenesisState_Validate(t *testing.T) {
 			genState: &types.GenesisState{
 				SupplierList: []sharedtypes.Supplier{
 					{
-						Address:  addr1,
-						Stake:    &stake1,
-						Services: serviceList1,
+						OwnerAddress:    addr1,
+						OperatorAddress: addr1,
+						Stake:           &stake1,
+						Services:        serviceList1,
 					},
 					{
-						Address: addr2,
-						Stake:   &stake2,
+						OwnerAddress:    addr2,
+						OperatorAddress: addr2,
+						Stake:           &stake2,
 						// Services: intentionally omitted
 					},
 				},
@@ -223,14 +389,16 @@ func TestGenesisState_Validate(t *testing.T) {
 			genState: &types.GenesisState{
 				SupplierList: []sharedtypes.Supplier{
 					{
-						Address:  addr1,
-						Stake:    &stake1,
-						Services: serviceList1,
+						OwnerAddress:    addr1,
+						OperatorAddress: addr1,
+						Stake:           &stake1,
+						Services:        serviceList1,
 					},
 					{
-						Address:  addr2,
-						Stake:    &stake2,
-						Services: []*sharedtypes.SupplierServiceConfig{},
+						OwnerAddress:    addr2,
+						OperatorAddress: addr2,
+						Stake:           &stake2,
+						Services:        []*sharedtypes.SupplierServiceConfig{},
 					},
 				},
 			},
@@ -241,13 +409,15 @@ func TestGenesisState_Validate(t *testing.T) {
 			genState: &types.GenesisState{
 				SupplierList: []sharedtypes.Supplier{
 					{
-						Address:  addr1,
-						Stake:    &stake1,
-						Services: serviceList1,
+						OwnerAddress:    addr1,
+						OperatorAddress: addr1,
+						Stake:           &stake1,
+						Services:        serviceList1,
 					},
 					{
-						Address: addr2,
-						Stake:   &stake2,
+						OwnerAddress:    addr2,
+						OperatorAddress: addr2,
+						Stake:           &stake2,
 						Services: []*sharedtypes.SupplierServiceConfig{
 							{
 								Service: &sharedtypes.Service{
@@ -272,13 +442,15 @@ func TestGenesisState_Validate(t *testing.T) {
 			genState: &types.GenesisState{
 				SupplierList: []sharedtypes.Supplier{
 					{
-						Address:  addr1,
-						Stake:    &stake1,
-						Services: serviceList1,
+						OwnerAddress:    addr1,
+						OperatorAddress: addr1,
+						Stake:           &stake1,
+						Services:        serviceList1,
 					},
 					{
-						Address: addr2,
-						Stake:   &stake2,
+						OwnerAddress:    addr2,
+						OperatorAddress: addr2,
+						Stake:           &stake2,
 						Services: []*sharedtypes.SupplierServiceConfig{
 							{
 								Service: &sharedtypes.Service{
@@ -298,6 +470,21 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc:     "valid genesis state with a pending operator",
+			genState: genesisWithPendingOperator(sample.AccAddress()),
+			valid:    true,
+		},
+		{
+			desc:     "invalid - pending operator used by another supplier",
+			genState: genesisWithPendingOperator(addr2),
+			valid:    false,
+		},
+		{
+			desc:     "invalid - pending operator address",
+			genState: genesisWithPendingOperator("invalid_address"),
+			valid:    false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	}
 	for _, tc := range tests {
@@ -0,0 +1,13 @@
+package types
+
+const (
+	// ProofPrimaryKeyPrefix is the prefix to retrieve the entire Proof object (the primary store)
+	ProofPrimaryKeyPrefix = "Proof/value/"
+)
+
+// ProofPrimaryKey returns the primary store key to retrieve a Proof by creating a composite key of the sessionId and supplierAddr
+func ProofPrimaryKey(sessionId, supplierAddr string) []byte {
+	// Proofs are unique for the same reason as claims: every supplier can only
+	// prove its one claim per session.
+	return ClaimPrimaryKey(sessionId, supplierAddr)
+}
@@ -7,6 +7,9 @@ var _ binary.ByteOrder
 const (
 	// SupplierKeyPrefix is the prefix to retrieve all Supplier
 	SupplierKeyPrefix = "Supplier/value/"
+
+	// SupplierServicePrefix is the prefix to retrieve a Supplier's key from the Service index
+	SupplierServicePrefix = "Supplier/service/"
 )
 
 // SupplierKey returns the store key to retrieve a Supplier from the index fields
@@ -21,3 +24,23 @@ func SupplierKey(
 
 	return key
 }
+
+// SupplierServiceKeyPrefix returns the key prefix to iterate through the
+// suppliers staked for the given service.
+func SupplierServiceKeyPrefix(serviceId string) []byte {
+	var key []byte
+
+	key = append(key, []byte(serviceId)...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// SupplierServiceKey returns the service index key of the supplier with the
+// given primary key for the given service.
+func SupplierServiceKey(serviceId string, primaryKey []byte) []byte {
+	key := SupplierServiceKeyPrefix(serviceId)
+	key = append(key, primaryKey...)
+
+	return key
+}
@@ -0,0 +1,75 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgDecreaseSupplierStake = "decrease_supplier_stake"
+
+var _ sdk.Msg = (*MsgDecreaseSupplierStake)(nil)
+
+func NewMsgDecreaseSupplierStake(ownerAddress, operatorAddress string, stake sdk.Coin) *MsgDecreaseSupplierStake {
+	return &MsgDecreaseSupplierStake{
+		OwnerAddress:    ownerAddress,
+		OperatorAddress: operatorAddress,
+		Stake:           &stake,
+	}
+}
+
+func (msg *MsgDecreaseSupplierStake) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgDecreaseSupplierStake) Type() string {
+	return TypeMsgDecreaseSupplierStake
+}
+
+func (msg *MsgDecreaseSupplierStake) GetSigners() []sdk.AccAddress {
+	ownerAddress, err := sdk.AccAddressFromBech32(msg.OwnerAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{ownerAddress}
+}
+
+func (msg *MsgDecreaseSupplierStake) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgDecreaseSupplierStake) ValidateBasic() error {
+	// Validate the owner and operator addresses
+	_, err := sdk.AccAddressFromBech32(msg.OwnerAddress)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrSupplierInvalidAddress, "invalid supplier owner address %s; (%v)", msg.OwnerAddress, err)
+	}
+	if err := validateOptionalOperatorAddress(msg.OperatorAddress); err != nil {
+		return err
+	}
+
+	// Validate the stake amount which remains staked
+	if msg.Stake == nil {
+		return sdkerrors.Wrapf(ErrSupplierInvalidStake, "nil supplier stake")
+	}
+	stake, err := sdk.ParseCoinNormalized(msg.Stake.String())
+	if err != nil {
+		return sdkerrors.Wrapf(ErrSupplierInvalidStake, "cannot parse supplier stake %v; (%v)", msg.Stake, err)
+	}
+	if !stake.IsValid() {
+		return sdkerrors.Wrapf(ErrSupplierInvalidStake, "invalid supplier stake %v; (%v)", msg.Stake, stake.Validate())
+	}
+	if stake.IsZero() || stake.IsNegative() {
+		return sdkerrors.Wrapf(ErrSupplierInvalidStake, "invalid stake amount for supplier: %v <= 0; use unstake to withdraw the whole stake", msg.Stake)
+	}
+	if stake.Denom != "upokt" {
+		return sdkerrors.Wrapf(ErrSupplierInvalidStake, "invalid stake amount denom for supplier %v", msg.Stake)
+	}
+	return nil
+}
+
+// GetOperatorAddressOrOwner returns the operator address of the supplier whose
+// stake is decreased, which defaults to its owner address.
+func (msg *MsgDecreaseSupplierStake) GetOperatorAddressOrOwner() string {
+	return operatorAddressOrOwner(msg.OperatorAddress, msg.OwnerAddress)
+}
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/testutil/sample"
+)
+
+func TestMsgDecreaseSupplierStake_ValidateBasic(t *testing.T) {
+	coins := sdk.NewCoin("upokt", sdk.NewInt(100))
+	tests := []struct {
+		name string
+		msg  MsgDecreaseSupplierStake
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgDecreaseSupplierStake{
+				OwnerAddress: "invalid_address",
+				Stake:        &coins,
+			},
+			err: ErrSupplierInvalidAddress,
+		}, {
+			name: "valid address - nil stake",
+			msg: MsgDecreaseSupplierStake{
+				OwnerAddress: sample.AccAddress(),
+				// Stake explicitly nil
+			},
+			err: ErrSupplierInvalidStake,
+		}, {
+			name: "valid address - zero stake",
+			msg: MsgDecreaseSupplierStake{
+				OwnerAddress: sample.AccAddress(),
+				Stake:        &sdk.Coin{Denom: "upokt", Amount: sdk.NewInt(0)},
+			},
+			err: ErrSupplierInvalidStake,
+		}, {
+			name: "valid address - invalid stake denom",
+			msg: MsgDecreaseSupplierStake{
+				OwnerAddress: sample.AccAddress(),
+				Stake:        &sdk.Coin{Denom: "invalid", Amount: sdk.NewInt(100)},
+			},
+			err: ErrSupplierInvalidStake,
+		}, {
+			name: "valid address - valid stake",
+			msg: MsgDecreaseSupplierStake{
+				OwnerAddress: sample.AccAddress(),
+				Stake:        &coins,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -14,14 +14,16 @@ const TypeMsgStakeSupplier = "stake_supplier"
 var _ sdk.Msg = (*MsgStakeSupplier)(nil)
 
 func NewMsgStakeSupplier(
-	address string,
+	ownerAddress string,
+	operatorAddress string,
 	stake types.Coin,
 	services []*sharedtypes.SupplierServiceConfig,
 ) *MsgStakeSupplier {
 	return &MsgStakeSupplier{
-		Address:  address,
-		Stake:    &stake,
-		Services: services,
+		OwnerAddress:    ownerAddress,
+		OperatorAddress: operatorAddress,
+		Stake:           &stake,
+		Services:        services,
 	}
 }
 
@@ -33,12 +35,25 @@ func (msg *MsgStakeSupplier) Type() string {
 	return TypeMsgStakeSupplier
 }
 
+// GetSigners returns the owner of the supplier, as well as its operator if it
+// is a different account, such that a supplier cannot be staked with an
+// operator address which the operator didn't agree to.
 func (msg *MsgStakeSupplier) GetSigners() []sdk.AccAddress {
-	address, err := sdk.AccAddressFromBech32(msg.Address)
+	ownerAddress, err := sdk.AccAddressFromBech32(msg.OwnerAddress)
 	if err != nil {
 		panic(err)
 	}
-	return []sdk.AccAddress{address}
+
+	operatorAddress := msg.GetOperatorAddressOrOwner()
+	if operatorAddress == msg.OwnerAddress {
+		return []sdk.AccAddress{ownerAddress}
+	}
+
+	operatorAccAddress, err := sdk.AccAddressFromBech32(operatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{ownerAddress, operatorAccAddress}
 }
 
 func (msg *MsgStakeSupplier) GetSignBytes() []byte {
@@ -47,10 +62,13 @@ func (msg *MsgStakeSupplier) GetSignBytes() []byte {
 }
 
 func (msg *MsgStakeSupplier) ValidateBasic() error {
-	// Validate the address
-	_, err := sdk.AccAddressFromBech32(msg.Address)
+	// Validate the owner and operator addresses
+	_, err := sdk.AccAddressFromBech32(msg.OwnerAddress)
 	if err != nil {
-		return sdkerrors.Wrapf(ErrSupplierInvalidAddress, "invalid supplier address %s; (%v)", msg.Address, err)
+		return sdkerrors.Wrapf(ErrSupplierInvalidAddress, "invalid supplier owner address %s; (%v)", msg.OwnerAddress, err)
+	}
+	if err := validateOptionalOperatorAddress(msg.OperatorAddress); err != nil {
+		return err
 	}
 
 	// TODO_TECHDEBT: Centralize stake related verification and share across different parts of the source code
@@ -79,3 +97,9 @@ func (msg *MsgStakeSupplier) ValidateBasic() error {
 
 	return nil
 }
+
+// GetOperatorAddressOrOwner returns the operator address of the supplier being
+// staked, which defaults to its owner address.
+func (msg *MsgStakeSupplier) GetOperatorAddressOrOwner() string {
+	return operatorAddressOrOwner(msg.OperatorAddress, msg.OwnerAddress)
+}
@@ -38,7 +38,7 @@ func TestMsgStakeSupplier_ValidateBasic(t *testing.T) {
 		{
 			name: "invalid address - nil stake",
 			msg: MsgStakeSupplier{
-				Address: "invalid_address",
+				OwnerAddress: "invalid_address",
 				// Stake explicitly nil
 				Services: defaultServicesList,
 			},
@@ -49,7 +49,7 @@ func TestMsgStakeSupplier_ValidateBasic(t *testing.T) {
 		{
 			name: "valid address - nil stake",
 			msg: MsgStakeSupplier{
-				Address: sample.AccAddress(),
+				OwnerAddress: sample.AccAddress(),
 				// Stake explicitly nil
 				Services: defaultServicesList,
 			},
@@ -57,40 +57,40 @@ func TestMsgStakeSupplier_ValidateBasic(t *testing.T) {
 		}, {
 			name: "valid address - valid stake",
 			msg: MsgStakeSupplier{
-				Address:  sample.AccAddress(),
-				Stake:    &sdk.Coin{Denom: "upokt", Amount: sdk.NewInt(100)},
-				Services: defaultServicesList,
+				OwnerAddress: sample.AccAddress(),
+				Stake:        &sdk.Coin{Denom: "upokt", Amount: sdk.NewInt(100)},
+				Services:     defaultServicesList,
 			},
 		}, {
 			name: "valid address - zero stake",
 			msg: MsgStakeSupplier{
-				Address:  sample.AccAddress(),
-				Stake:    &sdk.Coin{Denom: "upokt", Amount: sdk.NewInt(0)},
-				Services: defaultServicesList,
+				OwnerAddress: sample.AccAddress(),
+				Stake:        &sdk.Coin{Denom: "upokt", Amount: sdk.NewInt(0)},
+				Services:     defaultServicesList,
 			},
 			err: ErrSupplierInvalidStake,
 		}, {
 			name: "valid address - negative stake",
 			msg: MsgStakeSupplier{
-				Address:  sample.AccAddress(),
-				Stake:    &sdk.Coin{Denom: "upokt", Amount: sdk.NewInt(-100)},
-				Services: defaultServicesList,
+				OwnerAddress: sample.AccAddress(),
+				Stake:        &sdk.Coin{Denom: "upokt", Amount: sdk.NewInt(-100)},
+				Services:     defaultServicesList,
 			},
 			err: ErrSupplierInvalidStake,
 		}, {
 			name: "valid address - invalid stake denom",
 			msg: MsgStakeSupplier{
-				Address:  sample.AccAddress(),
-				Stake:    &sdk.Coin{Denom: "invalid", Amount: sdk.NewInt(100)},
-				Services: defaultServicesList,
+				OwnerAddress: sample.AccAddress(),
+				Stake:        &sdk.Coin{Denom: "invalid", Amount: sdk.NewInt(100)},
+				Services:     defaultServicesList,
 			},
 			err: ErrSupplierInvalidStake,
 		}, {
 			name: "valid address - invalid stake missing denom",
 			msg: MsgStakeSupplier{
-				Address:  sample.AccAddress(),
-				Stake:    &sdk.Coin{Denom: "", Amount: sdk.NewInt(100)},
-				Services: defaultServicesList,
+				OwnerAddress: sample.AccAddress(),
+				Stake:        &sdk.Coin{Denom: "", Amount: sdk.NewInt(100)},
+				Services:     defaultServicesList,
 			},
 			err: ErrSupplierInvalidStake,
 		},
@@ -99,8 +99,8 @@ func TestMsgStakeSupplier_ValidateBasic(t *testing.T) {
 		{
 			name: "valid service configs - multiple services",
 			msg: MsgStakeSupplier{
-				Address: sample.AccAddress(),
-				Stake:   &sdk.Coin{Denom: "upokt", Amount: sdk.NewInt(100)},
+				OwnerAddress: sample.AccAddress(),
+				Stake:        &sdk.Coin{Denom: "upokt", Amount: sdk.NewInt(100)},
 				Services: []*sharedtypes.SupplierServiceConfig{
 					{
 						Service: &sharedtypes.Service{
@@ -132,8 +132,8 @@ func TestMsgStakeSupplier_ValidateBasic(t *testing.T) {
 		{
 			name: "invalid service configs - omitted",
 			msg: MsgStakeSupplier{
-				Address: sample.AccAddress(),
-				Stake:   &sdk.Coin{Denom: "upokt", Amount: sdk.NewInt(100)},
+				OwnerAddress: sample.AccAddress(),
+				Stake:        &sdk.Coin{Denom: "upokt", Amount: sdk.NewInt(100)},
 				// Services: intentionally omitted
 			},
 			err: ErrSupplierInvalidServiceConfig,
@@ -141,17 +141,17 @@ func TestMsgStakeSupplier_ValidateBasic(t *testing.T) {
 		{
 			name: "invalid service configs - empty",
 			msg: MsgStakeSupplier{
-				Address:  sample.AccAddress(),
-				Stake:    &sdk.Coin{Denom: "upokt", Amount: sdk.NewInt(100)},
-				Services: []*sharedtypes.SupplierServiceConfig{},
+				OwnerAddress: sample.AccAddress(),
+				Stake:        &sdk.Coin{Denom: "upokt", Amount: sdk.NewInt(100)},
+				Services:     []*sharedtypes.SupplierServiceConfig{},
 			},
 			err: ErrSupplierInvalidServiceConfig,
 		},
 		{
 			name: "invalid service configs - invalid service ID that's too long",
 			msg: MsgStakeSupplier{
-				Address: sample.AccAddress(),
-				Stake:   &sdk.Coin{Denom: "upokt", Amount: sdk.NewInt(100)},
+				OwnerAddress: sample.AccAddress(),
+				Stake:        &sdk.Coin{Denom: "upokt", Amount: sdk.NewInt(100)},
 				Services: []*sharedtypes.SupplierServiceConfig{
 					{
 						Service: &sharedtypes.Service{
@@ -172,8 +172,8 @@ func TestMsgStakeSupplier_ValidateBasic(t *testing.T) {
 		{
 			name: "invalid service configs - invalid service Name that's too long",
 			msg: MsgStakeSupplier{
-				Address: sample.AccAddress(),
-				Stake:   &sdk.Coin{Denom: "upokt", Amount: sdk.NewInt(100)},
+				OwnerAddress: sample.AccAddress(),
+				Stake:        &sdk.Coin{Denom: "upokt", Amount: sdk.NewInt(100)},
 				Services: []*sharedtypes.SupplierServiceConfig{
 					{
 						Service: &sharedtypes.Service{
@@ -195,8 +195,8 @@ func TestMsgStakeSupplier_ValidateBasic(t *testing.T) {
 		{
 			name: "invalid service configs - invalid service ID that contains invalid characters",
 			msg: MsgStakeSupplier{
-				Address: sample.AccAddress(),
-				Stake:   &sdk.Coin{Denom: "upokt", Amount: sdk.NewInt(100)},
+				OwnerAddress: sample.AccAddress(),
+				Stake:        &sdk.Coin{Denom: "upokt", Amount: sdk.NewInt(100)},
 				Services: []*sharedtypes.SupplierServiceConfig{
 					{
 						Service: &sharedtypes.Service{
@@ -217,8 +217,8 @@ func TestMsgStakeSupplier_ValidateBasic(t *testing.T) {
 		{
 			name: "invalid service configs - missing url",
 			msg: MsgStakeSupplier{
-				Address: sample.AccAddress(),
-				Stake:   &sdk.Coin{Denom: "upokt", Amount: sdk.NewInt(100)},
+				OwnerAddress: sample.AccAddress(),
+				Stake:        &sdk.Coin{Denom: "upokt", Amount: sdk.NewInt(100)},
 				Services: []*sharedtypes.SupplierServiceConfig{
 					{
 						Service: &sharedtypes.Service{
@@ -240,8 +240,8 @@ func TestMsgStakeSupplier_ValidateBasic(t *testing.T) {
 		{
 			name: "invalid service configs - invalid url",
 			msg: MsgStakeSupplier{
-				Address: sample.AccAddress(),
-				Stake:   &sdk.Coin{Denom: "upokt", Amount: sdk.NewInt(100)},
+				OwnerAddress: sample.AccAddress(),
+				Stake:        &sdk.Coin{Denom: "upokt", Amount: sdk.NewInt(100)},
 				Services: []*sharedtypes.SupplierServiceConfig{
 					{
 						Service: &sharedtypes.Service{
@@ -263,8 +263,8 @@ func TestMsgStakeSupplier_ValidateBasic(t *testing.T) {
 		{
 			name: "invalid service configs - missing rpc type",
 			msg: MsgStakeSupplier{
-				Address: sample.AccAddress(),
-				Stake:   &sdk.Coin{Denom: "upokt", Amount: sdk.NewInt(100)},
+				OwnerAddress: sample.AccAddress(),
+				Stake:        &sdk.Coin{Denom: "upokt", Amount: sdk.NewInt(100)},
 				Services: []*sharedtypes.SupplierServiceConfig{
 					{
 						Service: &sharedtypes.Service{
@@ -297,3 +297,22 @@ func TestMsgStakeSupplier_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgStakeSupplier_GetSigners(t *testing.T) {
+	ownerAddr := sample.AccAddress()
+	operatorAddr := sample.AccAddress()
+
+	// The owner is the only signer if it also operates the supplier
+	msg := MsgStakeSupplier{OwnerAddress: ownerAddr}
+	require.Equal(t, []sdk.AccAddress{sdk.MustAccAddressFromBech32(ownerAddr)}, msg.GetSigners())
+
+	msg.OperatorAddress = ownerAddr
+	require.Equal(t, []sdk.AccAddress{sdk.MustAccAddressFromBech32(ownerAddr)}, msg.GetSigners())
+
+	// The operator must co-sign otherwise
+	msg.OperatorAddress = operatorAddr
+	require.Equal(t, []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(ownerAddr),
+		sdk.MustAccAddressFromBech32(operatorAddr),
+	}, msg.GetSigners())
+}
@@ -9,9 +9,10 @@ const TypeMsgUnstakeSupplier = "unstake_supplier"
 
 var _ sdk.Msg = (*MsgUnstakeSupplier)(nil)
 
-func NewMsgUnstakeSupplier(address string) *MsgUnstakeSupplier {
+func NewMsgUnstakeSupplier(ownerAddress, operatorAddress string) *MsgUnstakeSupplier {
 	return &MsgUnstakeSupplier{
-		Address: address,
+		OwnerAddress:    ownerAddress,
+		OperatorAddress: operatorAddress,
 	}
 }
 
@@ -24,11 +25,11 @@ func (msg *MsgUnstakeSupplier) Type() string {
 }
 
 func (msg *MsgUnstakeSupplier) GetSigners() []sdk.AccAddress {
-	address, err := sdk.AccAddressFromBech32(msg.Address)
+	ownerAddress, err := sdk.AccAddressFromBech32(msg.OwnerAddress)
 	if err != nil {
 		panic(err)
 	}
-	return []sdk.AccAddress{address}
+	return []sdk.AccAddress{ownerAddress}
 }
 
 func (msg *MsgUnstakeSupplier) GetSignBytes() []byte {
@@ -37,9 +38,15 @@ func (msg *MsgUnstakeSupplier) GetSignBytes() []byte {
 }
 
 func (msg *MsgUnstakeSupplier) ValidateBasic() error {
-	_, err := sdk.AccAddressFromBech32(msg.Address)
+	_, err := sdk.AccAddressFromBech32(msg.OwnerAddress)
 	if err != nil {
-		return sdkerrors.Wrapf(ErrSupplierInvalidAddress, "invalid address address (%s)", err)
+		return sdkerrors.Wrapf(ErrSupplierInvalidAddress, "invalid owner address (%s)", err)
 	}
-	return nil
+	return validateOptionalOperatorAddress(msg.OperatorAddress)
+}
+
+// GetOperatorAddressOrOwner returns the operator address of the supplier being
+// unstaked, which defaults to its owner address.
+func (msg *MsgUnstakeSupplier) GetOperatorAddressOrOwner() string {
+	return operatorAddressOrOwner(msg.OperatorAddress, msg.OwnerAddress)
 }
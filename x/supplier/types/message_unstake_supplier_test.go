@@ -17,7 +17,7 @@ func TestMsgUnstakeSupplier_ValidateBasic(t *testing.T) {
 		{
 			name: "invalid address",
 			msg: MsgUnstakeSupplier{
-				Address: "invalid_address",
+				OwnerAddress: "invalid_address",
 			},
 			err: ErrSupplierInvalidAddress,
 		}, {
@@ -27,7 +27,7 @@ func TestMsgUnstakeSupplier_ValidateBasic(t *testing.T) {
 		}, {
 			name: "valid address",
 			msg: MsgUnstakeSupplier{
-				Address: sample.AccAddress(),
+				OwnerAddress: sample.AccAddress(),
 			},
 		},
 	}
@@ -0,0 +1,71 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgUpdateSupplierOperator = "update_supplier_operator"
+
+var _ sdk.Msg = (*MsgUpdateSupplierOperator)(nil)
+
+func NewMsgUpdateSupplierOperator(
+	ownerAddress string,
+	operatorAddress string,
+	newOperatorAddress string,
+) *MsgUpdateSupplierOperator {
+	return &MsgUpdateSupplierOperator{
+		OwnerAddress:       ownerAddress,
+		OperatorAddress:    operatorAddress,
+		NewOperatorAddress: newOperatorAddress,
+	}
+}
+
+func (msg *MsgUpdateSupplierOperator) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateSupplierOperator) Type() string {
+	return TypeMsgUpdateSupplierOperator
+}
+
+// GetSigners returns the owner of the supplier, as well as its new operator if
+// it is a different account, such that an account cannot be made the operator
+// of a supplier, and thus reserved, without agreeing to it.
+func (msg *MsgUpdateSupplierOperator) GetSigners() []sdk.AccAddress {
+	ownerAddress, err := sdk.AccAddressFromBech32(msg.OwnerAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	if msg.NewOperatorAddress == msg.OwnerAddress {
+		return []sdk.AccAddress{ownerAddress}
+	}
+
+	newOperatorAddress, err := sdk.AccAddressFromBech32(msg.NewOperatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{ownerAddress, newOperatorAddress}
+}
+
+func (msg *MsgUpdateSupplierOperator) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateSupplierOperator) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.OwnerAddress); err != nil {
+		return sdkerrors.Wrapf(ErrSupplierInvalidAddress, "invalid supplier owner address %s; (%v)", msg.OwnerAddress, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.OperatorAddress); err != nil {
+		return sdkerrors.Wrapf(ErrSupplierInvalidAddress, "invalid supplier operator address %s; (%v)", msg.OperatorAddress, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.NewOperatorAddress); err != nil {
+		return sdkerrors.Wrapf(ErrSupplierInvalidAddress, "invalid supplier new operator address %s; (%v)", msg.NewOperatorAddress, err)
+	}
+	if msg.NewOperatorAddress == msg.OperatorAddress {
+		return sdkerrors.Wrapf(ErrSupplierInvalidAddress, "new operator address %s is the current operator address", msg.NewOperatorAddress)
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/testutil/sample"
+)
+
+func TestMsgUpdateSupplierOperator_ValidateBasic(t *testing.T) {
+	operatorAddr := sample.AccAddress()
+	tests := []struct {
+		name string
+		msg  MsgUpdateSupplierOperator
+		err  error
+	}{
+		{
+			name: "invalid owner address",
+			msg: MsgUpdateSupplierOperator{
+				OwnerAddress:       "invalid_address",
+				OperatorAddress:    operatorAddr,
+				NewOperatorAddress: sample.AccAddress(),
+			},
+			err: ErrSupplierInvalidAddress,
+		}, {
+			name: "invalid operator address",
+			msg: MsgUpdateSupplierOperator{
+				OwnerAddress:       sample.AccAddress(),
+				OperatorAddress:    "invalid_address",
+				NewOperatorAddress: sample.AccAddress(),
+			},
+			err: ErrSupplierInvalidAddress,
+		}, {
+			name: "missing new operator address",
+			msg: MsgUpdateSupplierOperator{
+				OwnerAddress:    sample.AccAddress(),
+				OperatorAddress: operatorAddr,
+			},
+			err: ErrSupplierInvalidAddress,
+		}, {
+			name: "unchanged operator address",
+			msg: MsgUpdateSupplierOperator{
+				OwnerAddress:       sample.AccAddress(),
+				OperatorAddress:    operatorAddr,
+				NewOperatorAddress: operatorAddr,
+			},
+			err: ErrSupplierInvalidAddress,
+		}, {
+			name: "valid addresses",
+			msg: MsgUpdateSupplierOperator{
+				OwnerAddress:       sample.AccAddress(),
+				OperatorAddress:    operatorAddr,
+				NewOperatorAddress: sample.AccAddress(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgUpdateSupplierOperator_GetSigners(t *testing.T) {
+	ownerAddr := sample.AccAddress()
+	newOperatorAddr := sample.AccAddress()
+
+	// The owner is the only signer if it becomes the operator of the supplier
+	msg := MsgUpdateSupplierOperator{
+		OwnerAddress:       ownerAddr,
+		OperatorAddress:    sample.AccAddress(),
+		NewOperatorAddress: ownerAddr,
+	}
+	require.Equal(t, []sdk.AccAddress{sdk.MustAccAddressFromBech32(ownerAddr)}, msg.GetSigners())
+
+	// The new operator must co-sign otherwise
+	msg.NewOperatorAddress = newOperatorAddr
+	require.Equal(t, []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(ownerAddr),
+		sdk.MustAccAddressFromBech32(newOperatorAddr),
+	}, msg.GetSigners())
+}
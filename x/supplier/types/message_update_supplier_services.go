@@ -0,0 +1,102 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	sharedhelpers "github.com/pokt-network/poktroll/x/shared/helpers"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+)
+
+const TypeMsgUpdateSupplierServices = "update_supplier_services"
+
+var _ sdk.Msg = (*MsgUpdateSupplierServices)(nil)
+
+func NewMsgUpdateSupplierServices(
+	ownerAddress string,
+	operatorAddress string,
+	updates []*SupplierServiceUpdate,
+) *MsgUpdateSupplierServices {
+	return &MsgUpdateSupplierServices{
+		OwnerAddress:    ownerAddress,
+		OperatorAddress: operatorAddress,
+		Updates:         updates,
+	}
+}
+
+// NewSupplierServiceUpdates returns the updates which apply the given action to
+// each of the given service configs.
+func NewSupplierServiceUpdates(
+	action SupplierServiceUpdateAction,
+	serviceConfigs []*sharedtypes.SupplierServiceConfig,
+) []*SupplierServiceUpdate {
+	updates := make([]*SupplierServiceUpdate, 0, len(serviceConfigs))
+	for _, serviceConfig := range serviceConfigs {
+		updates = append(updates, &SupplierServiceUpdate{
+			Action:        action,
+			ServiceConfig: serviceConfig,
+		})
+	}
+	return updates
+}
+
+func (msg *MsgUpdateSupplierServices) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateSupplierServices) Type() string {
+	return TypeMsgUpdateSupplierServices
+}
+
+func (msg *MsgUpdateSupplierServices) GetSigners() []sdk.AccAddress {
+	ownerAddress, err := sdk.AccAddressFromBech32(msg.OwnerAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{ownerAddress}
+}
+
+func (msg *MsgUpdateSupplierServices) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateSupplierServices) ValidateBasic() error {
+	// Validate the owner and operator addresses
+	if _, err := sdk.AccAddressFromBech32(msg.OwnerAddress); err != nil {
+		return sdkerrors.Wrapf(ErrSupplierInvalidAddress, "invalid supplier owner address %s; (%v)", msg.OwnerAddress, err)
+	}
+	if err := validateOptionalOperatorAddress(msg.OperatorAddress); err != nil {
+		return err
+	}
+
+	if len(msg.Updates) == 0 {
+		return sdkerrors.Wrapf(ErrSupplierInvalidServiceUpdate, "no service updates provided")
+	}
+
+	for _, update := range msg.Updates {
+		if update == nil {
+			return sdkerrors.Wrapf(ErrSupplierInvalidServiceUpdate, "service update cannot be nil")
+		}
+
+		// Validate the action
+		if _, ok := SupplierServiceUpdateAction_name[int32(update.Action)]; !ok ||
+			update.Action == SupplierServiceUpdateAction_UNKNOWN_ACTION {
+			return sdkerrors.Wrapf(ErrSupplierInvalidServiceUpdate, "invalid service update action %v", update.Action)
+		}
+
+		// Validate the service config whose endpoints are added, removed or replaced
+		serviceConfigs := []*sharedtypes.SupplierServiceConfig{update.ServiceConfig}
+		if err := sharedhelpers.ValidateSupplierServiceConfigs(serviceConfigs); err != nil {
+			return sdkerrors.Wrapf(ErrSupplierInvalidServiceConfig, err.Error())
+		}
+	}
+
+	return nil
+}
+
+// GetOperatorAddressOrOwner returns the operator address of the supplier whose
+// services are updated, which defaults to its owner address.
+func (msg *MsgUpdateSupplierServices) GetOperatorAddressOrOwner() string {
+	return operatorAddressOrOwner(msg.OperatorAddress, msg.OwnerAddress)
+}
@@ -0,0 +1,91 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/testutil/sample"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+)
+
+func TestMsgUpdateSupplierServices_ValidateBasic(t *testing.T) {
+	serviceConfig := &sharedtypes.SupplierServiceConfig{
+		Service: &sharedtypes.Service{
+			Id: "svcId1",
+		},
+		Endpoints: []*sharedtypes.SupplierEndpoint{
+			{
+				Url:     "http://localhost:8081",
+				RpcType: sharedtypes.RPCType_JSON_RPC,
+				Configs: make([]*sharedtypes.ConfigOption, 0),
+			},
+		},
+	}
+
+	tests := []struct {
+		name string
+		msg  MsgUpdateSupplierServices
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgUpdateSupplierServices{
+				OwnerAddress: "invalid_address",
+				Updates: []*SupplierServiceUpdate{
+					{Action: SupplierServiceUpdateAction_ADD, ServiceConfig: serviceConfig},
+				},
+			},
+			err: ErrSupplierInvalidAddress,
+		}, {
+			name: "no updates",
+			msg: MsgUpdateSupplierServices{
+				OwnerAddress: sample.AccAddress(),
+			},
+			err: ErrSupplierInvalidServiceUpdate,
+		}, {
+			name: "unknown action",
+			msg: MsgUpdateSupplierServices{
+				OwnerAddress: sample.AccAddress(),
+				Updates: []*SupplierServiceUpdate{
+					{Action: SupplierServiceUpdateAction_UNKNOWN_ACTION, ServiceConfig: serviceConfig},
+				},
+			},
+			err: ErrSupplierInvalidServiceUpdate,
+		}, {
+			name: "invalid service config",
+			msg: MsgUpdateSupplierServices{
+				OwnerAddress: sample.AccAddress(),
+				Updates: []*SupplierServiceUpdate{
+					{
+						Action: SupplierServiceUpdateAction_REMOVE,
+						ServiceConfig: &sharedtypes.SupplierServiceConfig{
+							Service:   &sharedtypes.Service{Id: "svcId1"},
+							Endpoints: []*sharedtypes.SupplierEndpoint{},
+						},
+					},
+				},
+			},
+			err: ErrSupplierInvalidServiceConfig,
+		}, {
+			name: "valid updates",
+			msg: MsgUpdateSupplierServices{
+				OwnerAddress: sample.AccAddress(),
+				Updates: NewSupplierServiceUpdates(
+					SupplierServiceUpdateAction_REPLACE,
+					[]*sharedtypes.SupplierServiceConfig{serviceConfig},
+				),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -1,12 +1,21 @@
 package types
 
 import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"gopkg.in/yaml.v2"
+
+	sharedhelpers "github.com/pokt-network/poktroll/x/shared/helpers"
 )
 
+// TODO: Revisit default param values
+var DefaultMinStake = sdk.NewCoin("upokt", sdk.NewInt(1))
+
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+var KeyMinStake = []byte("MinStake")
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
@@ -14,7 +23,8 @@ func ParamKeyTable() paramtypes.KeyTable {
 
 // NewParams creates a new Params instance
 func NewParams() Params {
-	return Params{}
+	minStake := DefaultMinStake
+	return Params{MinStake: &minStake}
 }
 
 // DefaultParams returns a default set of parameters
@@ -24,11 +34,22 @@ func DefaultParams() Params {
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMinStake, &p.MinStake, validateMinStake),
+	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
+	return validateMinStake(p.MinStake)
+}
+
+// ValidateStake returns an error if the given stake is lower than the MinStake
+// param. There is no minimum, other than a positive stake, if it isn't set.
+func (p Params) ValidateStake(stake sdk.Coin) error {
+	if p.MinStake != nil && stake.IsLT(*p.MinStake) {
+		return sdkerrors.Wrapf(ErrSupplierInvalidStake, "stake amount %v must be at least the minimum stake %v", stake, p.MinStake)
+	}
 	return nil
 }
 
@@ -37,3 +58,10 @@ func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
 	return string(out)
 }
+
+func validateMinStake(i interface{}) error {
+	if err := sharedhelpers.ValidateMinStake(i); err != nil {
+		return ErrSupplierInvalidMinStake.Wrap(err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,21 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// validate performs basic validation of a proof, as stored on-chain, such as
+// the proofs imported from genesis.
+func (proof *Proof) validate() error {
+	if _, err := sdk.AccAddressFromBech32(proof.SupplierAddress); err != nil {
+		return sdkerrors.Wrapf(ErrSupplierInvalidAddress, "invalid proof supplier address %s; (%v)", proof.SupplierAddress, err)
+	}
+	if len(proof.GetSessionHeader().GetSessionId()) == 0 {
+		return sdkerrors.Wrapf(ErrSupplierInvalidSessionId, "empty session ID in proof for supplier %s", proof.SupplierAddress)
+	}
+	if len(proof.ClosestMerkleProof) == 0 {
+		return sdkerrors.Wrapf(ErrSupplierInvalidProof, "empty proof for session %s", proof.GetSessionHeader().GetSessionId())
+	}
+	return nil
+}
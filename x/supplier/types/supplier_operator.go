@@ -0,0 +1,28 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// operatorAddressOrOwner returns the given operator address, or the given owner
+// address if the operator address is empty. Messages signed by a supplier's
+// owner may omit the operator address when the owner also operates it.
+func operatorAddressOrOwner(operatorAddress, ownerAddress string) string {
+	if operatorAddress == "" {
+		return ownerAddress
+	}
+	return operatorAddress
+}
+
+// validateOptionalOperatorAddress returns an error if the given operator
+// address is set but is not a valid Bech32 address.
+func validateOptionalOperatorAddress(operatorAddress string) error {
+	if operatorAddress == "" {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(operatorAddress); err != nil {
+		return sdkerrors.Wrapf(ErrSupplierInvalidAddress, "invalid supplier operator address %s; (%v)", operatorAddress, err)
+	}
+	return nil
+}
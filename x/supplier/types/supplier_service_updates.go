@@ -0,0 +1,100 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+)
+
+// ApplySupplierServiceUpdates returns the service configs which result from
+// applying the given updates, in order, to the given service configs. The given
+// service configs are not modified.
+func ApplySupplierServiceUpdates(
+	serviceConfigs []*sharedtypes.SupplierServiceConfig,
+	updates []*SupplierServiceUpdate,
+) ([]*sharedtypes.SupplierServiceConfig, error) {
+	// Copy the service configs such that their endpoints can be updated in place.
+	updatedConfigs := make([]*sharedtypes.SupplierServiceConfig, 0, len(serviceConfigs))
+	for _, serviceConfig := range serviceConfigs {
+		updatedConfigs = append(updatedConfigs, &sharedtypes.SupplierServiceConfig{
+			Service:   serviceConfig.Service,
+			Endpoints: append([]*sharedtypes.SupplierEndpoint{}, serviceConfig.Endpoints...),
+		})
+	}
+
+	for _, update := range updates {
+		serviceId := update.ServiceConfig.Service.Id
+		configIdx := -1
+		for i, serviceConfig := range updatedConfigs {
+			if serviceConfig.Service.Id == serviceId {
+				configIdx = i
+				break
+			}
+		}
+
+		switch update.Action {
+		case SupplierServiceUpdateAction_ADD:
+			if configIdx == -1 {
+				updatedConfigs = append(updatedConfigs, &sharedtypes.SupplierServiceConfig{
+					Service:   update.ServiceConfig.Service,
+					Endpoints: append([]*sharedtypes.SupplierEndpoint{}, update.ServiceConfig.Endpoints...),
+				})
+				continue
+			}
+
+			serviceConfig := updatedConfigs[configIdx]
+			for _, endpoint := range update.ServiceConfig.Endpoints {
+				if endpointIndex(serviceConfig.Endpoints, endpoint.Url) != -1 {
+					return nil, sdkerrors.Wrapf(ErrSupplierInvalidServiceUpdate, "endpoint %s of service %s already exists", endpoint.Url, serviceId)
+				}
+				serviceConfig.Endpoints = append(serviceConfig.Endpoints, endpoint)
+			}
+
+		case SupplierServiceUpdateAction_REMOVE:
+			if configIdx == -1 {
+				return nil, sdkerrors.Wrapf(ErrSupplierInvalidServiceUpdate, "service %s is not provided by the supplier", serviceId)
+			}
+
+			serviceConfig := updatedConfigs[configIdx]
+			for _, endpoint := range update.ServiceConfig.Endpoints {
+				endpointIdx := endpointIndex(serviceConfig.Endpoints, endpoint.Url)
+				if endpointIdx == -1 {
+					return nil, sdkerrors.Wrapf(ErrSupplierInvalidServiceUpdate, "endpoint %s of service %s does not exist", endpoint.Url, serviceId)
+				}
+				serviceConfig.Endpoints = append(serviceConfig.Endpoints[:endpointIdx], serviceConfig.Endpoints[endpointIdx+1:]...)
+			}
+
+			// Remove the service altogether once it has no endpoints left.
+			if len(serviceConfig.Endpoints) == 0 {
+				updatedConfigs = append(updatedConfigs[:configIdx], updatedConfigs[configIdx+1:]...)
+			}
+
+		case SupplierServiceUpdateAction_REPLACE:
+			replacedConfig := &sharedtypes.SupplierServiceConfig{
+				Service:   update.ServiceConfig.Service,
+				Endpoints: append([]*sharedtypes.SupplierEndpoint{}, update.ServiceConfig.Endpoints...),
+			}
+			if configIdx == -1 {
+				updatedConfigs = append(updatedConfigs, replacedConfig)
+				continue
+			}
+			updatedConfigs[configIdx] = replacedConfig
+
+		default:
+			return nil, sdkerrors.Wrapf(ErrSupplierInvalidServiceUpdate, "invalid service update action %v", update.Action)
+		}
+	}
+
+	return updatedConfigs, nil
+}
+
+// endpointIndex returns the index of the endpoint with the given URL in the
+// given endpoints, or -1 if there is none.
+func endpointIndex(endpoints []*sharedtypes.SupplierEndpoint, url string) int {
+	for i, endpoint := range endpoints {
+		if endpoint.Url == url {
+			return i
+		}
+	}
+	return -1
+}
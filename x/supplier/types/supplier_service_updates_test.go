@@ -0,0 +1,119 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+)
+
+func TestApplySupplierServiceUpdates(t *testing.T) {
+	newServiceConfig := func(serviceId string, urls ...string) *sharedtypes.SupplierServiceConfig {
+		serviceConfig := &sharedtypes.SupplierServiceConfig{
+			Service:   &sharedtypes.Service{Id: serviceId},
+			Endpoints: []*sharedtypes.SupplierEndpoint{},
+		}
+		for _, url := range urls {
+			serviceConfig.Endpoints = append(serviceConfig.Endpoints, &sharedtypes.SupplierEndpoint{
+				Url:     url,
+				RpcType: sharedtypes.RPCType_JSON_RPC,
+			})
+		}
+		return serviceConfig
+	}
+
+	serviceConfigs := []*sharedtypes.SupplierServiceConfig{
+		newServiceConfig("svc1", "http://localhost:8081"),
+		newServiceConfig("svc2", "http://localhost:8082"),
+	}
+
+	tests := []struct {
+		desc    string
+		updates []*SupplierServiceUpdate
+
+		expectedServiceConfigs []*sharedtypes.SupplierServiceConfig
+		expectedErr            error
+	}{
+		{
+			desc: "add an endpoint to an existing service",
+			updates: []*SupplierServiceUpdate{
+				{Action: SupplierServiceUpdateAction_ADD, ServiceConfig: newServiceConfig("svc1", "http://localhost:9091")},
+			},
+			expectedServiceConfigs: []*sharedtypes.SupplierServiceConfig{
+				newServiceConfig("svc1", "http://localhost:8081", "http://localhost:9091"),
+				newServiceConfig("svc2", "http://localhost:8082"),
+			},
+		},
+		{
+			desc: "add a new service",
+			updates: []*SupplierServiceUpdate{
+				{Action: SupplierServiceUpdateAction_ADD, ServiceConfig: newServiceConfig("svc3", "http://localhost:8083")},
+			},
+			expectedServiceConfigs: []*sharedtypes.SupplierServiceConfig{
+				newServiceConfig("svc1", "http://localhost:8081"),
+				newServiceConfig("svc2", "http://localhost:8082"),
+				newServiceConfig("svc3", "http://localhost:8083"),
+			},
+		},
+		{
+			desc: "add an existing endpoint",
+			updates: []*SupplierServiceUpdate{
+				{Action: SupplierServiceUpdateAction_ADD, ServiceConfig: newServiceConfig("svc1", "http://localhost:8081")},
+			},
+			expectedErr: ErrSupplierInvalidServiceUpdate,
+		},
+		{
+			desc: "remove the last endpoint of a service",
+			updates: []*SupplierServiceUpdate{
+				{Action: SupplierServiceUpdateAction_REMOVE, ServiceConfig: newServiceConfig("svc1", "http://localhost:8081")},
+			},
+			expectedServiceConfigs: []*sharedtypes.SupplierServiceConfig{
+				newServiceConfig("svc2", "http://localhost:8082"),
+			},
+		},
+		{
+			desc: "remove a non-existent endpoint",
+			updates: []*SupplierServiceUpdate{
+				{Action: SupplierServiceUpdateAction_REMOVE, ServiceConfig: newServiceConfig("svc1", "http://localhost:9091")},
+			},
+			expectedErr: ErrSupplierInvalidServiceUpdate,
+		},
+		{
+			desc: "remove an endpoint of a service which isn't provided",
+			updates: []*SupplierServiceUpdate{
+				{Action: SupplierServiceUpdateAction_REMOVE, ServiceConfig: newServiceConfig("svc3", "http://localhost:8083")},
+			},
+			expectedErr: ErrSupplierInvalidServiceUpdate,
+		},
+		{
+			desc: "replace the endpoints of a service",
+			updates: []*SupplierServiceUpdate{
+				{Action: SupplierServiceUpdateAction_REPLACE, ServiceConfig: newServiceConfig("svc2", "http://localhost:9092", "http://localhost:9093")},
+			},
+			expectedServiceConfigs: []*sharedtypes.SupplierServiceConfig{
+				newServiceConfig("svc1", "http://localhost:8081"),
+				newServiceConfig("svc2", "http://localhost:9092", "http://localhost:9093"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			updatedServiceConfigs, err := ApplySupplierServiceUpdates(serviceConfigs, tt.updates)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedServiceConfigs, updatedServiceConfigs)
+
+			// The given service configs are not modified.
+			require.Equal(t, []*sharedtypes.SupplierServiceConfig{
+				newServiceConfig("svc1", "http://localhost:8081"),
+				newServiceConfig("svc2", "http://localhost:8082"),
+			}, serviceConfigs)
+		})
+	}
+}